@@ -0,0 +1,65 @@
+package adapter
+
+import (
+	"fmt"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
+	"strings"
+)
+
+// EmailNotifier sends the search alerts, price drops, reviews and listing expiries by email through the configured mailer
+type EmailNotifier struct {
+	mailer users.Mailer
+}
+
+// NewEmailNotifier creates a new EmailNotifier
+func NewEmailNotifier(mailer users.Mailer) *EmailNotifier {
+	return &EmailNotifier{
+		mailer: mailer,
+	}
+}
+
+func (notifier *EmailNotifier) Notify(alert *model.SearchAlert) error {
+	subject := fmt.Sprintf("%d new properties for %s", len(alert.Properties), alert.SavedSearch.Name)
+	return notifier.mailer.Send(alert.Email, subject, buildAlertBody(alert))
+}
+
+func buildAlertBody(alert *model.SearchAlert) string {
+	lines := []string{fmt.Sprintf("These properties match your saved search %q:", alert.SavedSearch.Name), ""}
+	for _, property := range alert.Properties {
+		lines = append(lines, fmt.Sprintf("- #%d %s, %d bedrooms, %d m2, %s", property.ID, property.Title, property.Bedrooms,
+			property.Area, model.FormatAmount(property.Pricing.SalePrice, property.Pricing.Currency)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (notifier *EmailNotifier) NotifyPriceDrop(alert *model.PriceDropAlert) error {
+	subject := fmt.Sprintf("Price drop: %s", alert.Property.Title)
+	body := fmt.Sprintf("The price of #%d %s dropped from %s to %s.", alert.Property.ID, alert.Property.Title,
+		model.FormatAmount(alert.PreviousPrice, alert.Property.Pricing.Currency), model.FormatAmount(alert.NewPrice, alert.Property.Pricing.Currency))
+	return notifier.mailer.Send(alert.Email, subject, body)
+}
+
+func (notifier *EmailNotifier) NotifyReview(alert *model.ReviewAlert) error {
+	if alert.Review.Decision == model.REJECT {
+		subject := fmt.Sprintf("Your property was rejected: %s", alert.Property.Title)
+		body := fmt.Sprintf("#%d %s was rejected by a moderator.\nReason: %s\nEdit the property to send it to review again.",
+			alert.Property.ID, alert.Property.Title, *alert.Review.Reason)
+		return notifier.mailer.Send(alert.Email, subject, body)
+	}
+	subject := fmt.Sprintf("Your property was approved: %s", alert.Property.Title)
+	body := fmt.Sprintf("#%d %s was approved by a moderator, its status is %s.", alert.Property.ID, alert.Property.Title, alert.Review.Status)
+	return notifier.mailer.Send(alert.Email, subject, body)
+}
+
+func (notifier *EmailNotifier) NotifyExpiry(alert *model.ExpiryAlert) error {
+	if alert.Expired {
+		subject := fmt.Sprintf("Your property expired: %s", alert.Property.Title)
+		body := fmt.Sprintf("#%d %s expired on %s and is no longer listed.\nRenew it to list it again.",
+			alert.Property.ID, alert.Property.Title, alert.ExpiresAt.Format("2006-01-02"))
+		return notifier.mailer.Send(alert.Email, subject, body)
+	}
+	subject := fmt.Sprintf("Your property expires soon: %s", alert.Property.Title)
+	body := fmt.Sprintf("#%d %s expires on %s.\nRenew it to keep it listed.", alert.Property.ID, alert.Property.Title, alert.ExpiresAt.Format("2006-01-02"))
+	return notifier.mailer.Send(alert.Email, subject, body)
+}
@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"io/ioutil"
+	"lahaus/config"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalBlobStore writes the files to a directory, the app serves them under the path of the public URL
+type LocalBlobStore struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalBlobStore creates a new LocalBlobStore
+func NewLocalBlobStore(config *config.Photos) *LocalBlobStore {
+	return &LocalBlobStore{
+		dir:       config.LocalDir,
+		publicURL: strings.TrimRight(config.PublicURL, "/"),
+	}
+}
+
+func (store *LocalBlobStore) Put(key string, contentType string, data []byte) error {
+	file := store.file(key)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0644)
+}
+
+// Delete does not fail when the file does not exist
+func (store *LocalBlobStore) Delete(key string) error {
+	err := os.Remove(store.file(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (store *LocalBlobStore) URL(key string) string {
+	return store.publicURL + "/" + key
+}
+
+// Path is where the handler is mounted, the path of the public URL
+func (store *LocalBlobStore) Path() string {
+	publicURL, err := url.Parse(store.publicURL)
+	if err != nil {
+		return ""
+	}
+	return publicURL.Path
+}
+
+// Handler serves the files under Path, the directories are not listed
+func (store *LocalBlobStore) Handler() http.Handler {
+	files := http.StripPrefix(store.Path(), http.FileServer(http.Dir(store.dir)))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info, err := os.Stat(store.file(strings.TrimPrefix(r.URL.Path, store.Path())))
+		if err != nil || info.IsDir() {
+			http.NotFound(w, r)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+}
+
+// file cleans the key, so it cannot point outside of the directory
+func (store *LocalBlobStore) file(key string) string {
+	return filepath.Join(store.dir, filepath.FromSlash(path.Clean("/"+key)))
+}
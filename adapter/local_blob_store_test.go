@@ -0,0 +1,59 @@
+package adapter
+
+import (
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"lahaus/config"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type LocalBlobStoreSuite struct {
+	suite.Suite
+	dir   string
+	store *LocalBlobStore
+}
+
+func TestLocalBlobStoreSuite(t *testing.T) {
+	suite.Run(t, new(LocalBlobStoreSuite))
+}
+
+func (suite *LocalBlobStoreSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "photos")
+	suite.Require().NoError(err)
+	suite.dir = dir
+	suite.store = NewLocalBlobStore(&config.Photos{LocalDir: dir, PublicURL: "http://localhost:8080/photos/"})
+}
+
+func (suite *LocalBlobStoreSuite) TearDownTest() {
+	_ = os.RemoveAll(suite.dir)
+}
+
+func (suite *LocalBlobStoreSuite) TestLocalBlobStore_PutServeDelete() {
+	suite.NoError(suite.store.Put("properties/1/abc/web.jpg", "image/jpeg", []byte("jpeg")))
+	suite.Equal("http://localhost:8080/photos/properties/1/abc/web.jpg", suite.store.URL("properties/1/abc/web.jpg"))
+	suite.Equal("/photos", suite.store.Path())
+
+	recorder := httptest.NewRecorder()
+	suite.store.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/photos/properties/1/abc/web.jpg", nil))
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("jpeg", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	suite.store.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/photos/properties/1/", nil))
+	suite.Equal(http.StatusNotFound, recorder.Code)
+
+	suite.NoError(suite.store.Delete("properties/1/abc/web.jpg"))
+	suite.NoError(suite.store.Delete("properties/1/abc/web.jpg"))
+	_, err := os.Stat(filepath.Join(suite.dir, "properties", "1", "abc", "web.jpg"))
+	suite.True(os.IsNotExist(err))
+}
+
+func (suite *LocalBlobStoreSuite) TestLocalBlobStore_KeyStaysInDir() {
+	suite.NoError(suite.store.Put("../../outside.jpg", "image/jpeg", []byte("jpeg")))
+	_, err := os.Stat(filepath.Join(suite.dir, "outside.jpg"))
+	suite.NoError(err)
+}
@@ -0,0 +1,52 @@
+package adapter
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/logger"
+	"os"
+	"sync"
+	"time"
+)
+
+// LogMailer appends the emails to a file or writes them to the logger instead of delivering them
+type LogMailer struct {
+	from  string
+	file  string
+	mutex sync.Mutex
+}
+
+// NewLogMailer creates a new LogMailer
+func NewLogMailer(config *config.Mailer) *LogMailer {
+	return &LogMailer{
+		from: config.From,
+		file: config.File,
+	}
+}
+
+func (mailer *LogMailer) Send(to, subject, body string) error {
+	if mailer.file == "" {
+		logger.GetInstance().Info("email sent", zap.String("from", mailer.from), zap.String("to", to),
+			zap.String("subject", subject), zap.String("body", body))
+		return nil
+	}
+
+	mailer.mutex.Lock()
+	defer mailer.mutex.Unlock()
+
+	//#nosec
+	file, err := os.OpenFile(mailer.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		logger.GetInstance().Error("error opening mail file", zap.Error(err))
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "Date: %s\nFrom: %s\nTo: %s\nSubject: %s\n\n%s\n\n", time.Now().Format(time.RFC1123Z), mailer.from, to, subject, body)
+	if err != nil {
+		logger.GetInstance().Error("error writing mail file", zap.Error(err))
+		return err
+	}
+	return nil
+}
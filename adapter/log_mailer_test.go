@@ -0,0 +1,48 @@
+package adapter
+
+import (
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"lahaus/config"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type LogMailerSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestLogMailerSuite(t *testing.T) {
+	suite.Run(t, new(LogMailerSuite))
+}
+
+func (suite *LogMailerSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "mailer")
+	suite.Require().NoError(err)
+	suite.dir = dir
+}
+
+func (suite *LogMailerSuite) TearDownTest() {
+	_ = os.RemoveAll(suite.dir)
+}
+
+func (suite *LogMailerSuite) TestLogMailer_SendToFile() {
+	file := filepath.Join(suite.dir, "mails.log")
+	mailer := NewLogMailer(&config.Mailer{From: "no-reply@lahaus.com", File: file})
+
+	suite.NoError(mailer.Send("d@d.com", "subject 1", "body 1"))
+	suite.NoError(mailer.Send("d@d.com", "subject 2", "body 2"))
+
+	content, err := ioutil.ReadFile(file)
+	suite.NoError(err)
+	suite.Contains(string(content), "To: d@d.com")
+	suite.Contains(string(content), "Subject: subject 1")
+	suite.Contains(string(content), "body 2")
+}
+
+func (suite *LogMailerSuite) TestLogMailer_SendToLogger() {
+	mailer := NewLogMailer(&config.Mailer{From: "no-reply@lahaus.com"})
+	suite.NoError(mailer.Send("d@d.com", "subject", "body"))
+}
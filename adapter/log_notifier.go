@@ -0,0 +1,43 @@
+package adapter
+
+import (
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+)
+
+// LogNotifier writes the search alerts, price drops, reviews and listing expiries to the logger, meant for local development
+type LogNotifier struct{}
+
+// NewLogNotifier creates a new LogNotifier
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (notifier *LogNotifier) Notify(alert *model.SearchAlert) error {
+	propertyIDs := make([]int64, 0, len(alert.Properties))
+	for _, property := range alert.Properties {
+		propertyIDs = append(propertyIDs, property.ID)
+	}
+	logger.GetInstance().Info("search alert", zap.String("to", alert.Email), zap.Int64("savedSearchId", alert.SavedSearch.ID),
+		zap.String("frequency", string(alert.SavedSearch.Frequency)), zap.Int64s("propertyIds", propertyIDs))
+	return nil
+}
+
+func (notifier *LogNotifier) NotifyPriceDrop(alert *model.PriceDropAlert) error {
+	logger.GetInstance().Info("price drop", zap.String("to", alert.Email), zap.Int64("propertyId", alert.Property.ID),
+		zap.Int64("previousPrice", int64(alert.PreviousPrice)), zap.Int64("newPrice", int64(alert.NewPrice)))
+	return nil
+}
+
+func (notifier *LogNotifier) NotifyReview(alert *model.ReviewAlert) error {
+	logger.GetInstance().Info("property review", zap.String("to", alert.Email), zap.Int64("propertyId", alert.Property.ID),
+		zap.String("decision", string(alert.Review.Decision)), zap.String("status", string(alert.Review.Status)))
+	return nil
+}
+
+func (notifier *LogNotifier) NotifyExpiry(alert *model.ExpiryAlert) error {
+	logger.GetInstance().Info("listing expiry", zap.String("to", alert.Email), zap.Int64("propertyId", alert.Property.ID),
+		zap.Time("expiresAt", alert.ExpiresAt), zap.Bool("expired", alert.Expired))
+	return nil
+}
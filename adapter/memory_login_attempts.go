@@ -0,0 +1,77 @@
+package adapter
+
+import (
+	"lahaus/domain/model"
+	"sync"
+	"time"
+)
+
+// MemoryLoginAttemptStore keeps the login attempts in memory, only valid when the api runs as a single instance
+type MemoryLoginAttemptStore struct {
+	attempts map[string]model.LoginAttempts
+	mutex    sync.Mutex
+}
+
+// NewMemoryLoginAttemptStore creates a new MemoryLoginAttemptStore
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{
+		attempts: map[string]model.LoginAttempts{},
+	}
+}
+
+func (store *MemoryLoginAttemptStore) GetLoginAttempts(key string) (*model.LoginAttempts, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	attempts, found := store.attempts[key]
+	if !found {
+		return nil, nil
+	}
+	return &attempts, nil
+}
+
+// RegisterLoginFailure adds a failure to the key, stale entries are swept on every call so the map does not grow without limit
+func (store *MemoryLoginAttemptStore) RegisterLoginFailure(key string, now, windowStart time.Time) (*model.LoginAttempts, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.sweep(now, windowStart)
+
+	attempts, found := store.attempts[key]
+	if !found || attempts.LastFailureAt.Before(windowStart) {
+		attempts = model.LoginAttempts{Key: key, LockedUntil: attempts.LockedUntil}
+	}
+	attempts.Failures++
+	attempts.LastFailureAt = now
+	store.attempts[key] = attempts
+	return &attempts, nil
+}
+
+func (store *MemoryLoginAttemptStore) LockLogin(key string, until time.Time) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	attempts, found := store.attempts[key]
+	if !found {
+		return nil
+	}
+	attempts.LockedUntil = &until
+	store.attempts[key] = attempts
+	return nil
+}
+
+func (store *MemoryLoginAttemptStore) ResetLoginAttempts(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delete(store.attempts, key)
+	return nil
+}
+
+func (store *MemoryLoginAttemptStore) sweep(now, windowStart time.Time) {
+	for key, attempts := range store.attempts {
+		if attempts.LastFailureAt.Before(windowStart) && (attempts.LockedUntil == nil || attempts.LockedUntil.Before(now)) {
+			delete(store.attempts, key)
+		}
+	}
+}
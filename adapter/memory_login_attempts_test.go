@@ -0,0 +1,72 @@
+package adapter
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type MemoryLoginAttemptStoreSuite struct {
+	suite.Suite
+	store *MemoryLoginAttemptStore
+}
+
+func TestMemoryLoginAttemptStoreSuite(t *testing.T) {
+	suite.Run(t, new(MemoryLoginAttemptStoreSuite))
+}
+
+func (suite *MemoryLoginAttemptStoreSuite) SetupTest() {
+	suite.store = NewMemoryLoginAttemptStore()
+}
+
+func (suite *MemoryLoginAttemptStoreSuite) TestRegisterLoginFailure_Increments() {
+	now := time.Now().UTC()
+	_, err := suite.store.RegisterLoginFailure("email:d@d.com", now, now.Add(-time.Minute))
+	suite.NoError(err)
+	attempts, err := suite.store.RegisterLoginFailure("email:d@d.com", now, now.Add(-time.Minute))
+	suite.NoError(err)
+	suite.Equal(2, attempts.Failures)
+
+	stored, err := suite.store.GetLoginAttempts("email:d@d.com")
+	suite.NoError(err)
+	suite.Equal(2, stored.Failures)
+}
+
+func (suite *MemoryLoginAttemptStoreSuite) TestRegisterLoginFailure_RestartsOutsideWindow() {
+	now := time.Now().UTC()
+	_, err := suite.store.RegisterLoginFailure("email:d@d.com", now.Add(-time.Hour), now.Add(-2*time.Hour))
+	suite.NoError(err)
+
+	attempts, err := suite.store.RegisterLoginFailure("email:d@d.com", now, now.Add(-time.Minute))
+	suite.NoError(err)
+	suite.Equal(1, attempts.Failures)
+}
+
+func (suite *MemoryLoginAttemptStoreSuite) TestLockAndReset() {
+	now := time.Now().UTC()
+	_, err := suite.store.RegisterLoginFailure("ip:127.0.0.1", now, now.Add(-time.Minute))
+	suite.NoError(err)
+	suite.NoError(suite.store.LockLogin("ip:127.0.0.1", now.Add(time.Minute)))
+
+	attempts, err := suite.store.GetLoginAttempts("ip:127.0.0.1")
+	suite.NoError(err)
+	suite.NotNil(attempts.LockedUntil)
+
+	suite.NoError(suite.store.ResetLoginAttempts("ip:127.0.0.1"))
+	attempts, err = suite.store.GetLoginAttempts("ip:127.0.0.1")
+	suite.NoError(err)
+	suite.Nil(attempts)
+}
+
+func (suite *MemoryLoginAttemptStoreSuite) TestSweep_RemovesStaleEntries() {
+	now := time.Now().UTC()
+	_, err := suite.store.RegisterLoginFailure("email:old@d.com", now.Add(-time.Hour), now.Add(-2*time.Hour))
+	suite.NoError(err)
+
+	_, err = suite.store.RegisterLoginFailure("email:d@d.com", now, now.Add(-time.Minute))
+	suite.NoError(err)
+
+	attempts, err := suite.store.GetLoginAttempts("email:old@d.com")
+	suite.NoError(err)
+	suite.Nil(attempts)
+}
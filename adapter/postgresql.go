@@ -1,18 +1,28 @@
 package adapter
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/lib/pq"
 	"go.uber.org/zap"
 	"lahaus/domain/model"
+	"lahaus/domain/usecases/audits"
+	"lahaus/domain/usecases/collections"
+	"lahaus/domain/usecases/moderation"
 	"lahaus/domain/usecases/properties"
 
 	"lahaus/domain/usecases/users"
 	"lahaus/infrastructure/storage"
 	"lahaus/logger"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -64,8 +74,13 @@ func (adapter *PostgreSQLAdapter) MigrationsDown(migrationDir *string) error {
 	return nil
 }
 
-func (adapter *PostgreSQLAdapter) SaveUser(user *model.User) error {
-	_, err := adapter.postgres.Conn.Exec(`INSERT INTO users(email, password) VALUES($1, $2)`, user.Email, user.Password)
+func (adapter *PostgreSQLAdapter) SaveUser(user *model.User, audit *model.AuditEntry) error {
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		err := tx.QueryRow(`WITH new_user AS (INSERT INTO users(email, password, role) VALUES($1, $2, $3) RETURNING id),
+			default_collection AS (INSERT INTO collections(user_id, name, is_default) SELECT id, $4, true FROM new_user)
+			SELECT id FROM new_user`, user.Email, user.Password, user.Role, model.DefaultCollectionName).Scan(&user.ID)
+		return user.ID, true, err
+	})
 	if err != nil {
 		logger.GetInstance().Error("fail to save user", zap.Error(err))
 		return err
@@ -74,38 +89,1463 @@ func (adapter *PostgreSQLAdapter) SaveUser(user *model.User) error {
 }
 
 func (adapter *PostgreSQLAdapter) GetUser(email string) (*model.User, bool, error) {
-	row := adapter.postgres.Conn.QueryRow(`SELECT id, email, password FROM users WHERE email = $1 `, email)
+	row := adapter.postgres.Conn.QueryRow(`SELECT id, email, password, role, email_verified_at FROM users WHERE email = $1 `, email)
 	return mapRowsToUser(row)
 }
 
-func (adapter *PostgreSQLAdapter) AddFavourite(userID, propertyID int64) error {
-	_, err := adapter.postgres.Conn.Exec(`INSERT INTO favourites(user_id, property_id) VALUES($1, $2) `, userID, propertyID)
+func (adapter *PostgreSQLAdapter) GetUserByID(userID int64) (*model.User, bool, error) {
+	row := adapter.postgres.Conn.QueryRow(`SELECT id, email, password, role, email_verified_at FROM users WHERE id = $1 `, userID)
+	return mapRowsToUser(row)
+}
+
+// VerifyEmail keeps the first verification date when the email was already verified, returns false when the user does not exist
+func (adapter *PostgreSQLAdapter) VerifyEmail(email string) (bool, error) {
+	result, err := adapter.postgres.Conn.Exec(`UPDATE users SET email_verified_at = COALESCE(email_verified_at, now()) WHERE email = $1`, email)
+	if err != nil {
+		logger.GetInstance().Error("fail to verify email", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// UpdateUserRole changes the role of the user, returns false when the user does not exist
+func (adapter *PostgreSQLAdapter) UpdateUserRole(userID int64, role model.Role, audit *model.AuditEntry) (bool, error) {
+	var found bool
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		result, err := tx.Exec(`UPDATE users SET role = $2 WHERE id = $1`, userID, role)
+		if err != nil {
+			return 0, false, err
+		}
+		affected, err := result.RowsAffected()
+		found = affected > 0
+		return userID, found, err
+	})
+	if err != nil {
+		logger.GetInstance().Error("fail to update user role", zap.Error(err))
+		return false, err
+	}
+	return found, nil
+}
+
+// AddFavourite returns false, and writes no audit entry, when the property was already a favourite of the user
+func (adapter *PostgreSQLAdapter) AddFavourite(userID, propertyID int64, audit *model.AuditEntry) (bool, error) {
+	var created bool
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		result, err := tx.Exec(`INSERT INTO collection_items(collection_id, property_id, saved_price)
+			SELECT c.id, p.id, p.sale_price FROM collections c, properties p WHERE c.user_id = $1 AND c.is_default AND p.id = $2
+			ON CONFLICT (collection_id, property_id) DO NOTHING`, userID, propertyID)
+		if err != nil {
+			return 0, false, err
+		}
+		affected, err := result.RowsAffected()
+		created = affected > 0
+		return userID, created, err
+	})
+	if err != nil {
+		return false, err
+	}
+	return created, nil
+}
+
+// RemoveFavourite returns false, and writes no audit entry, when the property was not a favourite of the user
+func (adapter *PostgreSQLAdapter) RemoveFavourite(userID, propertyID int64, audit *model.AuditEntry) (bool, error) {
+	var removed bool
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		result, err := tx.Exec(`DELETE FROM collection_items i USING collections c
+			WHERE c.id = i.collection_id AND c.user_id = $1 AND c.is_default AND i.property_id = $2`, userID, propertyID)
+		if err != nil {
+			return 0, false, err
+		}
+		affected, err := result.RowsAffected()
+		removed = affected > 0
+		return userID, removed, err
+	})
+	if err != nil {
+		logger.GetInstance().Error("fail to remove favourite", zap.Error(err))
+		return false, err
+	}
+	return removed, nil
+}
+
+func (adapter *PostgreSQLAdapter) ListFavouriteIDs(userID int64, propertyIDs []int64) ([]int64, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT i.property_id FROM collection_items i
+		INNER JOIN collections c ON c.id = i.collection_id
+		WHERE c.user_id = $1 AND c.is_default AND i.property_id = ANY($2) ORDER BY i.property_id`,
+		userID, pq.Array(propertyIDs))
+	if err != nil {
+		logger.GetInstance().Error("fail to list favourite ids", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListFavourites returns the items of the default collection, only the ACTIVE properties unless IncludeUnavailable is set
+func (adapter *PostgreSQLAdapter) ListFavourites(search users.FavouritesSearchParams) (*model.FavouritesPaging, error) {
+
+	pagingResult := &model.FavouritesPaging{
+		Page:     search.Page,
+		PageSize: search.PageSize,
+	}
+
+	offset := search.PageSize * (search.Page - 1)
+
+	rows, err := adapter.postgres.Conn.Query(`SELECT r.*, i.created_at, i.saved_price, count(*) OVER() AS full_count FROM properties r
+	INNER JOIN collection_items i ON i.property_id = r.id
+	INNER JOIN collections c ON c.id = i.collection_id AND c.is_default
+	WHERE c.user_id = $1 AND ($2 OR status = 'ACTIVE')
+	ORDER BY i.created_at DESC, r.id OFFSET $3 LIMIT $4`, search.UserID, search.IncludeUnavailable, offset, search.PageSize)
+	if err != nil {
+		logger.GetInstance().Error("error listing favourites", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var savedAt time.Time
+		var savedPrice sql.NullInt64
+		property, count, err := mapRowsToProperty(rows, &savedAt, &savedPrice)
+		if err != nil {
+			return nil, err
+		}
+		favourite := &model.Favourite{Property: property, SavedAt: savedAt}
+		if savedPrice.Valid {
+			price := model.Amount(savedPrice.Int64)
+			favourite.SavedPrice = &price
+			favourite.PriceChanged = price != property.Pricing.SalePrice
+		}
+		pagingResult.Data = append(pagingResult.Data, favourite)
+		pagingResult.Total = count
+	}
+
+	return pagingResult, rows.Err()
+
+}
+
+func (adapter *PostgreSQLAdapter) SavePasswordResetToken(token *model.PasswordResetToken) error {
+	_, err := adapter.postgres.Conn.Exec(`INSERT INTO password_reset_tokens(user_id, token_hash, expires_at) VALUES($1, $2, $3)`,
+		token.UserID, token.TokenHash, token.ExpiresAt)
+	if err != nil {
+		logger.GetInstance().Error("fail to save password reset token", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (adapter *PostgreSQLAdapter) GetPasswordResetToken(tokenHash string) (*model.PasswordResetToken, bool, error) {
+	row := adapter.postgres.Conn.QueryRow(`SELECT id, user_id, token_hash, expires_at, used_at FROM password_reset_tokens WHERE token_hash = $1`, tokenHash)
+	if row.Err() != nil {
+		return nil, false, row.Err()
+	}
+
+	token := &model.PasswordResetToken{}
+	var usedAt sql.NullTime
+	err := row.Scan(&token.ID, &token.UserID, &token.TokenHash, &token.ExpiresAt, &usedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		logger.GetInstance().Error("error scanning password reset token", zap.Error(err))
+		return nil, false, err
+	}
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+	return token, true, nil
+}
+
+// ResetPassword consumes the token and changes the password, returns false when the token was already used
+func (adapter *PostgreSQLAdapter) ResetPassword(token *model.PasswordResetToken, password string) (bool, error) {
+	tx, err := adapter.postgres.Conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.Exec(`UPDATE password_reset_tokens SET used_at = now() WHERE id = $1 AND used_at IS NULL`, token.ID)
+	if err != nil {
+		logger.GetInstance().Error("fail to consume password reset token", zap.Error(err))
+		_ = tx.Rollback()
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return false, nil
+	}
+
+	_, err = tx.Exec(`UPDATE users SET password = $2 WHERE id = $1`, token.UserID, password)
+	if err != nil {
+		logger.GetInstance().Error("fail to update user password", zap.Error(err))
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (adapter *PostgreSQLAdapter) GetLoginAttempts(key string) (*model.LoginAttempts, error) {
+	row := adapter.postgres.Conn.QueryRow(`SELECT key, failures, last_failure_at, locked_until FROM login_attempts WHERE key = $1`, key)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+	attempts, err := mapRowToLoginAttempts(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		logger.GetInstance().Error("error scanning login attempts", zap.Error(err))
+		return nil, err
+	}
+	return attempts, nil
+}
+
+// RegisterLoginFailure increments the failures, restarting the counter when the last one is older than windowStart
+func (adapter *PostgreSQLAdapter) RegisterLoginFailure(key string, now, windowStart time.Time) (*model.LoginAttempts, error) {
+	row := adapter.postgres.Conn.QueryRow(`INSERT INTO login_attempts(key, failures, last_failure_at) VALUES($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN login_attempts.last_failure_at < $3 THEN 1 ELSE login_attempts.failures + 1 END,
+			last_failure_at = $2
+		RETURNING key, failures, last_failure_at, locked_until`, key, now, windowStart)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+	attempts, err := mapRowToLoginAttempts(row)
+	if err != nil {
+		logger.GetInstance().Error("fail to register login failure", zap.Error(err))
+		return nil, err
+	}
+	return attempts, nil
+}
+
+func (adapter *PostgreSQLAdapter) LockLogin(key string, until time.Time) error {
+	_, err := adapter.postgres.Conn.Exec(`UPDATE login_attempts SET locked_until = $2 WHERE key = $1`, key, until)
+	if err != nil {
+		logger.GetInstance().Error("fail to lock login", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (adapter *PostgreSQLAdapter) ResetLoginAttempts(key string) error {
+	_, err := adapter.postgres.Conn.Exec(`DELETE FROM login_attempts WHERE key = $1`, key)
+	if err != nil {
+		logger.GetInstance().Error("fail to reset login attempts", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func mapRowToLoginAttempts(row *sql.Row) (*model.LoginAttempts, error) {
+	attempts := &model.LoginAttempts{}
+	var lockedUntil sql.NullTime
+	err := row.Scan(&attempts.Key, &attempts.Failures, &attempts.LastFailureAt, &lockedUntil)
+	if err != nil {
+		return nil, err
+	}
+	if lockedUntil.Valid {
+		attempts.LockedUntil = &lockedUntil.Time
+	}
+	return attempts, nil
+}
+
+func mapRowsToUser(row *sql.Row) (*model.User, bool, error) {
+	if row.Err() != nil {
+		return nil, false, row.Err()
+	}
+
+	var email, password, role string
+	var id int64
+	var emailVerifiedAt sql.NullTime
+	err := row.Scan(&id, &email, &password, &role, &emailVerifiedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	var emailVerifiedAtValue *time.Time
+	if emailVerifiedAt.Valid {
+		emailVerifiedAtValue = &emailVerifiedAt.Time
+	}
+	return &model.User{
+		ID:              id,
+		Email:           email,
+		Password:        password,
+		Role:            model.Role(role),
+		EmailVerifiedAt: emailVerifiedAtValue,
+	}, true, nil
+
+}
+
+const collectionColumns = `c.id, c.user_id, c.name, c.is_default, c.created_at, c.updated_at,
+	(SELECT count(*) FROM collection_items i WHERE i.collection_id = c.id) AS items_count`
+
+func (adapter *PostgreSQLAdapter) SaveCollection(collection *model.Collection) (*model.Collection, error) {
+	var collectionID int64
+	err := adapter.postgres.Conn.QueryRow(`INSERT INTO collections(user_id, name) VALUES($1, $2) RETURNING id`,
+		collection.UserID, collection.Name).Scan(&collectionID)
+	if err != nil {
+		logger.GetInstance().Error("fail to save collection", zap.Error(err))
+		return nil, err
+	}
+	collectionStored, found, err := adapter.GetCollection(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("collection not found")
+	}
+	return collectionStored, nil
+}
+
+func (adapter *PostgreSQLAdapter) UpdateCollection(collection *model.Collection) (*model.Collection, error) {
+	_, err := adapter.postgres.Conn.Exec(`UPDATE collections SET name = $2 WHERE id = $1`, collection.ID, collection.Name)
+	if err != nil {
+		logger.GetInstance().Error("fail to update collection", zap.Error(err))
+		return nil, err
+	}
+	collectionStored, found, err := adapter.GetCollection(collection.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("collection not found")
+	}
+	return collectionStored, nil
+}
+
+func (adapter *PostgreSQLAdapter) DeleteCollection(collectionID int64) error {
+	_, err := adapter.postgres.Conn.Exec(`DELETE FROM collections WHERE id = $1`, collectionID)
+	if err != nil {
+		logger.GetInstance().Error("fail to delete collection", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (adapter *PostgreSQLAdapter) GetCollection(collectionID int64) (*model.Collection, bool, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT `+collectionColumns+` FROM collections c WHERE c.id = $1`, collectionID)
+	if err != nil {
+		logger.GetInstance().Error("error getting collection", zap.Error(err))
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, false, rows.Err()
+	}
+	collection, err := mapRowsToCollection(rows)
+	if err != nil {
+		return nil, false, err
+	}
+	return collection, true, nil
+}
+
+func (adapter *PostgreSQLAdapter) GetDefaultCollection(userID int64) (*model.Collection, bool, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT `+collectionColumns+` FROM collections c WHERE c.user_id = $1 AND c.is_default`, userID)
+	if err != nil {
+		logger.GetInstance().Error("error getting default collection", zap.Error(err))
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, false, rows.Err()
+	}
+	collection, err := mapRowsToCollection(rows)
+	if err != nil {
+		return nil, false, err
+	}
+	return collection, true, nil
+}
+
+// ListCollections returns the user collections, the default collection first
+func (adapter *PostgreSQLAdapter) ListCollections(userID int64) ([]*model.Collection, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT `+collectionColumns+` FROM collections c WHERE c.user_id = $1
+		ORDER BY c.is_default DESC, c.created_at, c.id`, userID)
+	if err != nil {
+		logger.GetInstance().Error("error listing collections", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var collections []*model.Collection
+	for rows.Next() {
+		collection, err := mapRowsToCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, collection)
+	}
+	return collections, rows.Err()
+}
+
+// SaveCollectionItem adds the property to the collection or updates its note, returns true when the item was created
+func (adapter *PostgreSQLAdapter) SaveCollectionItem(collectionID, propertyID int64, note *string) (bool, error) {
+	var created bool
+	err := adapter.postgres.Conn.QueryRow(`INSERT INTO collection_items(collection_id, property_id, note, saved_price)
+		SELECT $1, id, $3, sale_price FROM properties WHERE id = $2
+		ON CONFLICT (collection_id, property_id) DO UPDATE SET note = COALESCE(EXCLUDED.note, collection_items.note)
+		RETURNING (xmax = 0)`, collectionID, propertyID, note).Scan(&created)
+	if err != nil {
+		logger.GetInstance().Error("fail to save collection item", zap.Error(err))
+		return false, err
+	}
+	return created, nil
+}
+
+// RemoveCollectionItem returns false when the property was not in the collection
+func (adapter *PostgreSQLAdapter) RemoveCollectionItem(collectionID, propertyID int64) (bool, error) {
+	result, err := adapter.postgres.Conn.Exec(`DELETE FROM collection_items WHERE collection_id = $1 AND property_id = $2`, collectionID, propertyID)
+	if err != nil {
+		logger.GetInstance().Error("fail to remove collection item", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// TransferCollectionItem copies the item to the target collection and, unless keepSource is set, removes it from the source
+func (adapter *PostgreSQLAdapter) TransferCollectionItem(fromCollectionID, toCollectionID, propertyID int64, keepSource bool) (bool, error) {
+	tx, err := adapter.postgres.Conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	err = tx.QueryRow(`SELECT true FROM collection_items WHERE collection_id = $1 AND property_id = $2 FOR UPDATE`,
+		fromCollectionID, propertyID).Scan(&exists)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		logger.GetInstance().Error("fail to get collection item", zap.Error(err))
+		return false, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO collection_items(collection_id, property_id, note, saved_price, created_at)
+		SELECT $1, property_id, note, saved_price, created_at FROM collection_items WHERE collection_id = $2 AND property_id = $3
+		ON CONFLICT (collection_id, property_id) DO NOTHING`, toCollectionID, fromCollectionID, propertyID)
+	if err != nil {
+		logger.GetInstance().Error("fail to copy collection item", zap.Error(err))
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if !keepSource {
+		_, err = tx.Exec(`DELETE FROM collection_items WHERE collection_id = $1 AND property_id = $2`, fromCollectionID, propertyID)
+		if err != nil {
+			logger.GetInstance().Error("fail to remove collection item", zap.Error(err))
+			_ = tx.Rollback()
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (adapter *PostgreSQLAdapter) ListCollectionItems(search collections.CollectionItemsSearchParams) (*model.CollectionItemsPaging, error) {
+	pagingResult := &model.CollectionItemsPaging{
+		Page:     search.Page,
+		PageSize: search.PageSize,
+	}
+
+	offset := search.PageSize * (search.Page - 1)
+
+	rows, err := adapter.postgres.Conn.Query(`SELECT r.*, i.note, i.created_at, count(*) OVER() AS full_count FROM properties r
+		INNER JOIN collection_items i ON i.property_id = r.id
+		WHERE i.collection_id = $1 AND status = 'ACTIVE'
+		ORDER BY i.created_at DESC, r.id OFFSET $2 LIMIT $3`, search.CollectionID, offset, search.PageSize)
+	if err != nil {
+		logger.GetInstance().Error("error listing collection items", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var note sql.NullString
+		var savedAt time.Time
+		property, count, err := mapRowsToProperty(rows, &note, &savedAt)
+		if err != nil {
+			return nil, err
+		}
+		item := &model.CollectionItem{Property: property, SavedAt: savedAt}
+		if note.Valid {
+			item.Note = &note.String
+		}
+		pagingResult.Data = append(pagingResult.Data, item)
+		pagingResult.Total = count
+	}
+
+	return pagingResult, rows.Err()
+}
+
+const shareLinkColumns = `id, collection_id, token_hash, expires_at, revoked_at, created_at`
+
+func (adapter *PostgreSQLAdapter) SaveShareLink(link *model.ShareLink) (*model.ShareLink, error) {
+	row := adapter.postgres.Conn.QueryRow(`INSERT INTO share_links(collection_id, token_hash, expires_at) VALUES($1, $2, $3)
+		RETURNING `+shareLinkColumns, link.CollectionID, link.TokenHash, link.ExpiresAt)
+	linkStored, err := mapRowToShareLink(row)
+	if err != nil {
+		logger.GetInstance().Error("fail to save share link", zap.Error(err))
+		return nil, err
+	}
+	return linkStored, nil
+}
+
+func (adapter *PostgreSQLAdapter) GetShareLink(tokenHash string) (*model.ShareLink, bool, error) {
+	row := adapter.postgres.Conn.QueryRow(`SELECT `+shareLinkColumns+` FROM share_links WHERE token_hash = $1`, tokenHash)
+	link, err := mapRowToShareLink(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		logger.GetInstance().Error("error scanning share link", zap.Error(err))
+		return nil, false, err
+	}
+	return link, true, nil
+}
+
+func (adapter *PostgreSQLAdapter) ListShareLinks(collectionID int64) ([]*model.ShareLink, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT `+shareLinkColumns+` FROM share_links WHERE collection_id = $1 ORDER BY created_at DESC, id DESC`, collectionID)
+	if err != nil {
+		logger.GetInstance().Error("error listing share links", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*model.ShareLink
+	for rows.Next() {
+		link, err := mapRowToShareLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, rows.Err()
+}
+
+// RevokeShareLink returns false when the link does not belong to the collection, revoking twice keeps the first date
+func (adapter *PostgreSQLAdapter) RevokeShareLink(collectionID, shareLinkID int64) (bool, error) {
+	result, err := adapter.postgres.Conn.Exec(`UPDATE share_links SET revoked_at = COALESCE(revoked_at, now())
+		WHERE id = $1 AND collection_id = $2`, shareLinkID, collectionID)
+	if err != nil {
+		logger.GetInstance().Error("fail to revoke share link", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func mapRowToShareLink(row rowScanner) (*model.ShareLink, error) {
+	link := &model.ShareLink{}
+	var expiresAt, revokedAt sql.NullTime
+	err := row.Scan(&link.ID, &link.CollectionID, &link.TokenHash, &expiresAt, &revokedAt, &link.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid {
+		link.ExpiresAt = &expiresAt.Time
+	}
+	if revokedAt.Valid {
+		link.RevokedAt = &revokedAt.Time
+	}
+	return link, nil
+}
+
+func mapRowsToCollection(rows *sql.Rows) (*model.Collection, error) {
+	collection := &model.Collection{}
+	err := rows.Scan(&collection.ID, &collection.UserID, &collection.Name, &collection.IsDefault, &collection.CreatedAt,
+		&collection.UpdatedAt, &collection.ItemsCount)
+	if err != nil {
+		logger.GetInstance().Error("error mapping collection rows", zap.Error(err))
+		return nil, err
+	}
+	return collection, nil
+}
+
+const savedSearchColumns = `id, user_id, name, filters, frequency, last_alerted_at, created_at, updated_at`
+
+func (adapter *PostgreSQLAdapter) SaveSavedSearch(search *model.SavedSearch) (*model.SavedSearch, error) {
+	filters, err := json.Marshal(search.Filters)
+	if err != nil {
+		return nil, err
+	}
+	row := adapter.postgres.Conn.QueryRow(`INSERT INTO saved_searches(user_id, name, filters, frequency) VALUES($1, $2, $3, $4)
+		RETURNING `+savedSearchColumns, search.UserID, search.Name, string(filters), search.Frequency)
+	searchStored, err := mapRowToSavedSearch(row)
+	if err != nil {
+		logger.GetInstance().Error("fail to save saved search", zap.Error(err))
+		return nil, err
+	}
+	return searchStored, nil
+}
+
+func (adapter *PostgreSQLAdapter) UpdateSavedSearch(search *model.SavedSearch) (*model.SavedSearch, error) {
+	filters, err := json.Marshal(search.Filters)
+	if err != nil {
+		return nil, err
+	}
+	row := adapter.postgres.Conn.QueryRow(`UPDATE saved_searches SET name = $2, filters = $3, frequency = $4 WHERE id = $1
+		RETURNING `+savedSearchColumns, search.ID, search.Name, string(filters), search.Frequency)
+	searchStored, err := mapRowToSavedSearch(row)
+	if err != nil {
+		logger.GetInstance().Error("fail to update saved search", zap.Error(err))
+		return nil, err
+	}
+	return searchStored, nil
+}
+
+func (adapter *PostgreSQLAdapter) DeleteSavedSearch(savedSearchID int64) error {
+	_, err := adapter.postgres.Conn.Exec(`DELETE FROM saved_searches WHERE id = $1`, savedSearchID)
+	if err != nil {
+		logger.GetInstance().Error("fail to delete saved search", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (adapter *PostgreSQLAdapter) GetSavedSearch(savedSearchID int64) (*model.SavedSearch, bool, error) {
+	row := adapter.postgres.Conn.QueryRow(`SELECT `+savedSearchColumns+` FROM saved_searches WHERE id = $1`, savedSearchID)
+	search, err := mapRowToSavedSearch(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		logger.GetInstance().Error("error scanning saved search", zap.Error(err))
+		return nil, false, err
+	}
+	return search, true, nil
+}
+
+func (adapter *PostgreSQLAdapter) ListSavedSearches(userID int64) ([]*model.SavedSearch, error) {
+	return adapter.querySavedSearches(`SELECT `+savedSearchColumns+` FROM saved_searches WHERE user_id = $1 ORDER BY created_at, id`, userID)
+}
+
+func (adapter *PostgreSQLAdapter) ListAllSavedSearches() ([]*model.SavedSearch, error) {
+	return adapter.querySavedSearches(`SELECT ` + savedSearchColumns + ` FROM saved_searches ORDER BY id`)
+}
+
+// ListDueSavedSearches returns the searches of the frequency with pending matches that were not alerted after alertedBefore
+func (adapter *PostgreSQLAdapter) ListDueSavedSearches(frequency model.AlertFrequency, alertedBefore time.Time) ([]*model.SavedSearch, error) {
+	return adapter.querySavedSearches(`SELECT `+savedSearchColumns+` FROM saved_searches s
+		WHERE frequency = $1 AND (last_alerted_at IS NULL OR last_alerted_at <= $2)
+		AND EXISTS (SELECT 1 FROM search_matches m WHERE m.saved_search_id = s.id AND m.delivered_at IS NULL)
+		ORDER BY id`, frequency, alertedBefore)
+}
+
+func (adapter *PostgreSQLAdapter) querySavedSearches(query string, args ...interface{}) ([]*model.SavedSearch, error) {
+	rows, err := adapter.postgres.Conn.Query(query, args...)
+	if err != nil {
+		logger.GetInstance().Error("error listing saved searches", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var savedSearches []*model.SavedSearch
+	for rows.Next() {
+		search, err := mapRowToSavedSearch(rows)
+		if err != nil {
+			return nil, err
+		}
+		savedSearches = append(savedSearches, search)
+	}
+	return savedSearches, rows.Err()
+}
+
+// SaveSearchMatch returns false when the property already matched the saved search
+func (adapter *PostgreSQLAdapter) SaveSearchMatch(savedSearchID, propertyID int64, matchedAt time.Time) (bool, error) {
+	result, err := adapter.postgres.Conn.Exec(`INSERT INTO search_matches(saved_search_id, property_id, matched_at) VALUES($1, $2, $3)
+		ON CONFLICT (saved_search_id, property_id) DO NOTHING`, savedSearchID, propertyID, matchedAt)
+	if err != nil {
+		logger.GetInstance().Error("fail to save search match", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// ListActivePropertiesUpdatedSince lists the ACTIVE properties updated at or after since, the oldest first
+func (adapter *PostgreSQLAdapter) ListActivePropertiesUpdatedSince(since time.Time) ([]*model.Property, error) {
+	return adapter.listProperties(`SELECT *, count(*) OVER() AS full_count FROM properties WHERE status = 'ACTIVE' AND updated_at >= $1
+		ORDER BY updated_at, id`, since)
+}
+
+// DeliverSearchMatches hands the locked pending matches of the saved search to deliver and marks them delivered when it succeeds
+func (adapter *PostgreSQLAdapter) DeliverSearchMatches(savedSearchID int64, deliveredAt time.Time, deliver func(matched []*model.Property) error) error {
+	tx, err := adapter.postgres.Conn.Begin()
+	if err != nil {
+		return err
+	}
+	matched, err := lockSearchMatches(tx, savedSearchID)
 	if err != nil {
+		_ = tx.Rollback()
 		return err
 	}
-	return nil
+	if len(matched) > 0 {
+		if err := deliver(matched); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	propertyIDs := make([]int64, 0, len(matched))
+	for _, property := range matched {
+		propertyIDs = append(propertyIDs, property.ID)
+	}
+	// the matches of the properties that left the market are dropped, the ones created meanwhile stay pending
+	_, err = tx.Exec(`UPDATE search_matches m SET delivered_at = $2 FROM properties r
+		WHERE m.saved_search_id = $1 AND m.delivered_at IS NULL AND r.id = m.property_id
+		AND (m.property_id = ANY($3) OR r.status <> 'ACTIVE')`, savedSearchID, deliveredAt, pq.Array(propertyIDs))
+	if err != nil {
+		_ = tx.Rollback()
+		logger.GetInstance().Error("fail to deliver search matches", zap.Error(err))
+		return err
+	}
+	if len(matched) > 0 {
+		_, err = tx.Exec(`UPDATE saved_searches SET last_alerted_at = $2 WHERE id = $1`, savedSearchID, deliveredAt)
+		if err != nil {
+			_ = tx.Rollback()
+			logger.GetInstance().Error("fail to update saved search alert", zap.Error(err))
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func lockSearchMatches(tx *sql.Tx, savedSearchID int64) ([]*model.Property, error) {
+	rows, err := tx.Query(`WITH pending AS (
+			SELECT property_id, matched_at FROM search_matches WHERE saved_search_id = $1 AND delivered_at IS NULL
+			FOR UPDATE SKIP LOCKED
+		)
+		SELECT r.*, count(*) OVER() AS full_count FROM pending c
+		INNER JOIN properties r ON r.id = c.property_id
+		WHERE r.status = 'ACTIVE'
+		ORDER BY c.matched_at, r.id`, savedSearchID)
+	if err != nil {
+		logger.GetInstance().Error("fail to lock search matches", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matched []*model.Property
+	for rows.Next() {
+		property, _, err := mapRowsToProperty(rows)
+		if err != nil {
+			return nil, err
+		}
+		matched = append(matched, property)
+	}
+	return matched, rows.Err()
+}
+
+func mapRowToSavedSearch(row rowScanner) (*model.SavedSearch, error) {
+	search := &model.SavedSearch{}
+	var filters []byte
+	var lastAlertedAt sql.NullTime
+	err := row.Scan(&search.ID, &search.UserID, &search.Name, &filters, &search.Frequency, &lastAlertedAt, &search.CreatedAt, &search.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(filters, &search.Filters); err != nil {
+		return nil, err
+	}
+	if lastAlertedAt.Valid {
+		search.LastAlertedAt = &lastAlertedAt.Time
+	}
+	return search, nil
+}
+
+// ListPriceDropWatchers returns the users that saved the property in any of their collections, with their price drop settings
+func (adapter *PostgreSQLAdapter) ListPriceDropWatchers(propertyID int64) ([]*model.PriceDropWatcher, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT DISTINCT u.id, u.email, COALESCE(s.enabled, true), COALESCE(s.min_drop_percent, 0)
+		FROM collection_items i
+		INNER JOIN collections c ON c.id = i.collection_id
+		INNER JOIN users u ON u.id = c.user_id
+		LEFT JOIN price_drop_settings s ON s.user_id = u.id
+		WHERE i.property_id = $1 ORDER BY u.id`, propertyID)
+	if err != nil {
+		logger.GetInstance().Error("error listing price drop watchers", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watchers []*model.PriceDropWatcher
+	for rows.Next() {
+		watcher := &model.PriceDropWatcher{}
+		if err := rows.Scan(&watcher.UserID, &watcher.Email, &watcher.Enabled, &watcher.MinDropPercent); err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, watcher)
+	}
+	return watchers, rows.Err()
+}
+
+// SavePriceDropNotification returns false when the user was already notified of the price change
+func (adapter *PostgreSQLAdapter) SavePriceDropNotification(userID, priceChangeID int64) (bool, error) {
+	result, err := adapter.postgres.Conn.Exec(`INSERT INTO price_drop_notifications(user_id, price_change_id) VALUES($1, $2)
+		ON CONFLICT (user_id, price_change_id) DO NOTHING`, userID, priceChangeID)
+	if err != nil {
+		logger.GetInstance().Error("fail to save price drop notification", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// GetPriceDropSettings returns the defaults, enabled for any drop, when the user did not change them
+func (adapter *PostgreSQLAdapter) GetPriceDropSettings(userID int64) (*model.PriceDropSettings, error) {
+	settings := &model.PriceDropSettings{UserID: userID, Enabled: true}
+	err := adapter.postgres.Conn.QueryRow(`SELECT enabled, min_drop_percent FROM price_drop_settings WHERE user_id = $1`, userID).
+		Scan(&settings.Enabled, &settings.MinDropPercent)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.GetInstance().Error("error getting price drop settings", zap.Error(err))
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (adapter *PostgreSQLAdapter) SavePriceDropSettings(settings *model.PriceDropSettings) (*model.PriceDropSettings, error) {
+	settingsStored := &model.PriceDropSettings{UserID: settings.UserID}
+	err := adapter.postgres.Conn.QueryRow(`INSERT INTO price_drop_settings(user_id, enabled, min_drop_percent) VALUES($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET enabled = EXCLUDED.enabled, min_drop_percent = EXCLUDED.min_drop_percent
+		RETURNING enabled, min_drop_percent`, settings.UserID, settings.Enabled, settings.MinDropPercent).
+		Scan(&settingsStored.Enabled, &settingsStored.MinDropPercent)
+	if err != nil {
+		logger.GetInstance().Error("fail to save price drop settings", zap.Error(err))
+		return nil, err
+	}
+	return settingsStored, nil
+}
+
+func (adapter *PostgreSQLAdapter) SaveProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+	monthlyRent, depositMonths, minContractMonths := rentPricingToNullInts(property.Pricing.Rent)
+	var propertyStored *model.Property
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		row := tx.QueryRow(`INSERT INTO properties(title, description, longitude, latitude, sale_price, administrative_fee, property_type,  bedrooms, bathrooms, parking_spots, area, photos, status, owner_id, invalid_reason,
+				operation_type, monthly_rent, deposit_months, min_contract_months, currency, amenities, floor, total_floors, year_built, condition, orientation,
+				price_per_square_meter, monthly_cost, suspect_reason) 
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29) RETURNING *`, property.Title, property.Description, property.Location.Longitude, property.Location.Latitude,
+			property.Pricing.SalePrice, property.Pricing.AdministrativeFee, property.PropertyType, property.Bedrooms, property.Bathrooms, property.ParkingSpots, property.Area, pq.Array(property.Photos), property.Status,
+			sql.NullInt64{Int64: property.OwnerID, Valid: property.OwnerID != 0}, property.InvalidReason, operationTypeOrSale(property.OperationType),
+			monthlyRent, depositMonths, minContractMonths, property.Pricing.Currency, pq.Array(amenitiesOrEmpty(property.Amenities)), property.Floor,
+			property.TotalFloors, property.YearBuilt, emptyToNullString(string(property.Condition)), emptyToNullString(string(property.Orientation)),
+			property.PricePerSquareMeter, property.MonthlyCost, property.SuspectReason)
+
+		var found bool
+		var err error
+		propertyStored, found, err = mapRowToProperty(row)
+		if err != nil {
+			return 0, false, err
+		}
+		if !found {
+			return 0, false, errors.New("property not found")
+		}
+		return propertyStored.ID, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return propertyStored, nil
+}
+
+// UpdateProperty writes the property when it still has the given version and records its price change and audit entry
+func (adapter *PostgreSQLAdapter) UpdateProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+	monthlyRent, depositMonths, minContractMonths := rentPricingToNullInts(property.Pricing.Rent)
+	var propertyStored *model.Property
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		row := tx.QueryRow(`WITH previous AS (
+				SELECT id, sale_price, administrative_fee FROM properties WHERE id = $1 FOR UPDATE
+			), updated AS (
+				UPDATE properties SET  
+                      title = $2, 
+                      description = $3, 
+                      longitude = $4, 
+                      latitude = $5, 
+                      sale_price = $6, 
+                      administrative_fee = $7, 
+                      property_type = $8,  
+                      bedrooms = $9, 
+                      bathrooms = $10, 
+                      parking_spots = $11, 
+                      area = $12, 
+                      photos = $13, 
+                      status = $14,
+                      invalid_reason = $16,
+                      operation_type = $17,
+                      monthly_rent = $18,
+                      deposit_months = $19,
+                      min_contract_months = $20,
+                      currency = $21,
+                      amenities = $22,
+                      floor = $23,
+                      total_floors = $24,
+                      year_built = $25,
+                      condition = $26,
+                      orientation = $27,
+                      price_per_square_meter = $28,
+                      monthly_cost = $29,
+                      suspect_reason = $30 WHERE id = $1 AND version = $15
+				RETURNING *
+			), price_change AS (
+				INSERT INTO property_price_history(property_id, previous_sale_price, new_sale_price, previous_administrative_fee, new_administrative_fee)
+				SELECT u.id, p.sale_price, u.sale_price, p.administrative_fee, u.administrative_fee FROM updated u INNER JOIN previous p ON p.id = u.id
+				WHERE p.sale_price <> u.sale_price OR p.administrative_fee IS DISTINCT FROM u.administrative_fee
+				RETURNING id
+			)
+			SELECT u.*, c.id FROM updated u LEFT JOIN price_change c ON true`, property.ID, property.Title, property.Description, property.Location.Longitude, property.Location.Latitude,
+			property.Pricing.SalePrice, property.Pricing.AdministrativeFee, property.PropertyType, property.Bedrooms, property.Bathrooms, property.ParkingSpots, property.Area, pq.Array(property.Photos), property.Status, property.Version,
+			property.InvalidReason, operationTypeOrSale(property.OperationType), monthlyRent, depositMonths, minContractMonths, property.Pricing.Currency,
+			pq.Array(amenitiesOrEmpty(property.Amenities)), property.Floor, property.TotalFloors, property.YearBuilt, emptyToNullString(string(property.Condition)),
+			emptyToNullString(string(property.Orientation)), property.PricePerSquareMeter, property.MonthlyCost, property.SuspectReason)
+
+		var found bool
+		var err error
+		var priceChangeID sql.NullInt64
+		propertyStored, found, err = mapRowToProperty(row, &priceChangeID)
+		if err != nil {
+			return 0, false, err
+		}
+		if !found {
+			return 0, false, model.NewPreconditionFailedError(errors.New("the property was modified by another request"))
+		}
+		propertyStored.PriceChangeID = priceChangeID.Int64
+		return propertyStored.ID, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return propertyStored, nil
+}
+
+func (adapter *PostgreSQLAdapter) GetProperty(propertyID int64) (*model.Property, bool, error) {
+	row := adapter.postgres.Conn.QueryRow(`SELECT * FROM properties WHERE id = $1`, propertyID)
+	return mapRowToProperty(row)
+}
+
+func (adapter *PostgreSQLAdapter) FilterProperties(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+	pagingResult := &model.PropertiesPaging{
+		Page:     search.Page,
+		PageSize: search.PageSize,
+	}
+
+	whereClause := searchWhereClause(search)
+
+	offset := search.PageSize * (search.Page - 1)
+
+	query := fmt.Sprintf(`SELECT p.*, h.changed_at, h.previous_sale_price, count(*) OVER() AS full_count FROM properties p
+		LEFT JOIN LATERAL (
+			SELECT changed_at, previous_sale_price FROM property_price_history
+			WHERE property_id = p.id AND previous_sale_price <> new_sale_price ORDER BY changed_at DESC, id DESC LIMIT 1
+		) h ON true %s ORDER BY %s OFFSET %d LIMIT %d`, whereClause, orderByClause(search), offset, search.PageSize)
+
+	rows, err := adapter.postgres.Conn.Query(query)
+	if err != nil {
+		logger.GetInstance().Error("error executing filtering properties query", zap.Error(err))
+		return nil, err
+	}
+
+	for rows.Next() {
+		var priceChangedAt sql.NullTime
+		var previousPrice sql.NullInt64
+		property, count, err := mapRowsToProperty(rows, &priceChangedAt, &previousPrice)
+		if err != nil {
+			return nil, err
+		}
+		if priceChangedAt.Valid {
+			previousPriceValue := model.Amount(previousPrice.Int64)
+			property.PriceChangedAt = &priceChangedAt.Time
+			property.PreviousPrice = &previousPriceValue
+		}
+		pagingResult.Data = append(pagingResult.Data, property)
+		pagingResult.Total = count
+	}
+
+	return pagingResult, nil
+}
+
+func (adapter *PostgreSQLAdapter) ListPriceHistory(propertyID int64) ([]*model.PriceHistoryEntry, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT id, property_id, previous_sale_price, previous_administrative_fee, new_sale_price, new_administrative_fee, changed_at
+		FROM property_price_history WHERE property_id = $1 ORDER BY changed_at DESC, id DESC`, propertyID)
+	if err != nil {
+		logger.GetInstance().Error("error listing price history", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []*model.PriceHistoryEntry{}
+	for rows.Next() {
+		entry := &model.PriceHistoryEntry{}
+		var previousFee, newFee sql.NullInt64
+		err := rows.Scan(&entry.ID, &entry.PropertyID, &entry.Previous.SalePrice, &previousFee, &entry.Current.SalePrice, &newFee, &entry.ChangedAt)
+		if err != nil {
+			return nil, err
+		}
+		entry.Previous.AdministrativeFee = nullIntToAdministrativeFee(previousFee)
+		entry.Current.AdministrativeFee = nullIntToAdministrativeFee(newFee)
+		history = append(history, entry)
+	}
+	return history, rows.Err()
+}
+
+// ListExchangeRates lists the rates updated by the admins
+func (adapter *PostgreSQLAdapter) ListExchangeRates() ([]*model.ExchangeRate, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT currency, rate, updated_at FROM exchange_rates ORDER BY currency`)
+	if err != nil {
+		logger.GetInstance().Error("error listing exchange rates", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := []*model.ExchangeRate{}
+	for rows.Next() {
+		rate := &model.ExchangeRate{}
+		var updatedAt time.Time
+		if err := rows.Scan(&rate.Currency, &rate.Rate, &updatedAt); err != nil {
+			return nil, err
+		}
+		rate.UpdatedAt = &updatedAt
+		rates = append(rates, rate)
+	}
+	return rates, rows.Err()
+}
+
+// SaveExchangeRate creates or replaces the rate of the currency
+func (adapter *PostgreSQLAdapter) SaveExchangeRate(rate *model.ExchangeRate, audit *model.AuditEntry) error {
+	return adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		var id int64
+		err := tx.QueryRow(`INSERT INTO exchange_rates(currency, rate, updated_at) VALUES($1, $2, $3)
+			ON CONFLICT (currency) DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at RETURNING id`,
+			rate.Currency, rate.Rate, rate.UpdatedAt).Scan(&id)
+		if err != nil {
+			logger.GetInstance().Error("error saving exchange rate", zap.Error(err))
+			return 0, false, err
+		}
+		return id, true, nil
+	})
+}
+
+// PricePerSquareMeterQuartiles computes the quartiles over the ACTIVE properties of the type and currency, leaving out excludedID
+func (adapter *PostgreSQLAdapter) PricePerSquareMeterQuartiles(propertyType model.PropertyType, currency model.Currency, excludedID int64) (*model.PriceQuartiles, error) {
+	var firstQuartile, thirdQuartile sql.NullFloat64
+	var samples int
+	err := adapter.postgres.Conn.QueryRow(`SELECT percentile_cont(0.25) WITHIN GROUP (ORDER BY price_per_square_meter),
+			percentile_cont(0.75) WITHIN GROUP (ORDER BY price_per_square_meter), count(*)
+		FROM properties WHERE status = 'ACTIVE' AND property_type = $1 AND currency = $2 AND id <> $3 AND price_per_square_meter IS NOT NULL`,
+		propertyType, currency, excludedID).Scan(&firstQuartile, &thirdQuartile, &samples)
+	if err != nil {
+		logger.GetInstance().Error("error computing price per square meter quartiles", zap.Error(err))
+		return nil, err
+	}
+	return &model.PriceQuartiles{FirstQuartile: firstQuartile.Float64, ThirdQuartile: thirdQuartile.Float64, Samples: samples}, nil
+}
+
+// MarketStats aggregates the prices of the properties that match the search by group in the currency of the search
+func (adapter *PostgreSQLAdapter) MarketStats(search properties.PropertySearchParams, grouping model.MarketGrouping) ([]*model.MarketStats, error) {
+	price := fmt.Sprintf("CASE WHEN operation_type IN ('%s', '%s') THEN %s END", model.SALE, model.SALE_AND_RENT,
+		convertedAmount("sale_price", search.Rates, search.Currency))
+	pricePerSquareMeter := convertedAmount("price_per_square_meter", search.Rates, search.Currency)
+	if search.Operation == model.RENT {
+		price = convertedAmount("monthly_rent", search.Rates, search.Currency)
+		pricePerSquareMeter = fmt.Sprintf("(%s)::DOUBLE PRECISION / NULLIF(area, 0)", price)
+	}
+
+	query := fmt.Sprintf(`SELECT market_group::TEXT AS group_name, count(*),
+			avg(price), percentile_cont(0.5) WITHIN GROUP (ORDER BY price),
+			percentile_cont(0.1) WITHIN GROUP (ORDER BY price), percentile_cont(0.9) WITHIN GROUP (ORDER BY price),
+			avg(price_per_square_meter), percentile_cont(0.5) WITHIN GROUP (ORDER BY price_per_square_meter),
+			percentile_cont(0.1) WITHIN GROUP (ORDER BY price_per_square_meter), percentile_cont(0.9) WITHIN GROUP (ORDER BY price_per_square_meter),
+			COALESCE(avg(area), 0)
+		FROM (
+			SELECT %s AS market_group, (%s)::DOUBLE PRECISION AS price, (%s)::DOUBLE PRECISION AS price_per_square_meter, area
+			FROM properties p %s
+		) s GROUP BY market_group ORDER BY market_group`, marketGroupExpression(grouping), price, pricePerSquareMeter, searchWhereClause(search))
+
+	rows, err := adapter.postgres.Conn.Query(query)
+	if err != nil {
+		logger.GetInstance().Error("error aggregating market stats", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := []*model.MarketStats{}
+	for rows.Next() {
+		stats := &model.MarketStats{}
+		var price, pricePerSquareMeter [4]sql.NullFloat64
+		err := rows.Scan(&stats.Group, &stats.Count, &price[0], &price[1], &price[2], &price[3],
+			&pricePerSquareMeter[0], &pricePerSquareMeter[1], &pricePerSquareMeter[2], &pricePerSquareMeter[3], &stats.AverageArea)
+		if err != nil {
+			return nil, err
+		}
+		stats.Price = nullFloatsToDistribution(price)
+		stats.PricePerSquareMeter = nullFloatsToDistribution(pricePerSquareMeter)
+		groups = append(groups, stats)
+	}
+	return groups, rows.Err()
+}
+
+// marketGroupExpression is the group of each property, the zone one is the name of the first zone that contains it
+func marketGroupExpression(grouping model.MarketGrouping) string {
+	switch grouping.By {
+	case model.GROUP_BY_ZONE:
+		expression := "CASE"
+		for _, zone := range grouping.Zones {
+			expression += fmt.Sprintf(" WHEN latitude >= %v AND latitude <= %v AND longitude >= %v AND longitude <= %v THEN %s",
+				zone.Box.MinLatitude, zone.Box.MaxLatitude, zone.Box.MinLongitude, zone.Box.MaxLongitude, pq.QuoteLiteral(zone.Name))
+		}
+		return expression + fmt.Sprintf(" ELSE %s END", pq.QuoteLiteral(model.OTHER_ZONE))
+	case model.GROUP_BY_BEDROOMS:
+		return "bedrooms"
+	case model.GROUP_BY_GEOHASH:
+		return fmt.Sprintf("geohash_encode(latitude, longitude, %d)", grouping.GeohashPrecision)
+	default:
+		return "property_type"
+	}
+}
+
+// nullFloatsToDistribution maps the distribution from minor units to units of the currency, nil when no property has the amount
+func nullFloatsToDistribution(values [4]sql.NullFloat64) *model.Distribution {
+	if !values[0].Valid {
+		return nil
+	}
+	units := func(value sql.NullFloat64) float64 {
+		return value.Float64 / model.MinorUnitsPerUnit
+	}
+	return &model.Distribution{Mean: units(values[0]), Median: units(values[1]), P10: units(values[2]), P90: units(values[3])}
+}
+
+// searchWhereClause filters the properties by the params of the search, it is shared by the search and the market statistics
+func searchWhereClause(search properties.PropertySearchParams) string {
+	whereClause := ""
+
+	if search.Status != "ALL" {
+		whereClause += fmt.Sprintf(" WHERE (status = '%s') ", search.Status)
+	} else if !search.IncludeHidden {
+		whereClause += " WHERE (status IN ('ACTIVE', 'INACTIVE')) "
+	}
+
+	if search.OwnerID != nil {
+		ownerClause := fmt.Sprintf(" (owner_id = %d) ", *search.OwnerID)
+
+		if whereClause != "" {
+			whereClause += " AND " + ownerClause
+		} else {
+			whereClause += " WHERE " + ownerClause
+		}
+	}
+
+	if search.Bbox != nil {
+		bboxClause := fmt.Sprintf(" (latitude >= %v AND latitude <= %v AND longitude >= %v AND longitude <= %v) ", search.Bbox.MinLatitude, search.Bbox.MaxLatitude,
+			search.Bbox.MinLongitude, search.Bbox.MaxLongitude)
+
+		if whereClause != "" {
+			whereClause += " AND " + bboxClause
+		} else {
+			whereClause += " WHERE " + bboxClause
+		}
+	}
+
+	if len(search.Polygon) > 0 {
+		vertices := make([]string, 0, len(search.Polygon))
+		for _, vertex := range search.Polygon {
+			vertices = append(vertices, fmt.Sprintf("(%v,%v)", vertex.Longitude, vertex.Latitude))
+		}
+		polygonClause := fmt.Sprintf(" (polygon '(%s)' @> point(longitude, latitude)) ", strings.Join(vertices, ","))
+
+		if whereClause != "" {
+			whereClause += " AND " + polygonClause
+		} else {
+			whereClause += " WHERE " + polygonClause
+		}
+	}
+
+	if search.Operation != "" {
+		operations := []string{}
+		for _, operation := range model.OperationTypes {
+			if operation.Offers(search.Operation) {
+				operations = append(operations, fmt.Sprintf("'%s'", operation))
+			}
+		}
+		operationClause := fmt.Sprintf(" (operation_type IN (%s)) ", strings.Join(operations, ", "))
+
+		if whereClause != "" {
+			whereClause += " AND " + operationClause
+		} else {
+			whereClause += " WHERE " + operationClause
+		}
+	}
+
+	if attributesClause := attributeFiltersClause(search.SearchFilters); attributesClause != "" {
+		if whereClause != "" {
+			whereClause += " AND " + attributesClause
+		} else {
+			whereClause += " WHERE " + attributesClause
+		}
+	}
+
+	if search.MinPrice != nil || search.MaxPrice != nil {
+		priceClause := fmt.Sprintf(" (operation_type IN ('%s', '%s')", model.SALE, model.SALE_AND_RENT)
+		salePrice := convertedAmount("sale_price", search.Rates, search.Currency)
+		if search.MinPrice != nil {
+			priceClause += fmt.Sprintf(" AND %s >= %d", salePrice, *search.MinPrice)
+		}
+		if search.MaxPrice != nil {
+			priceClause += fmt.Sprintf(" AND %s <= %d", salePrice, *search.MaxPrice)
+		}
+		priceClause += ") "
+
+		if whereClause != "" {
+			whereClause += " AND " + priceClause
+		} else {
+			whereClause += " WHERE " + priceClause
+		}
+	}
+
+	if metricsClause := metricFiltersClause(search); metricsClause != "" {
+		if whereClause != "" {
+			whereClause += " AND " + metricsClause
+		} else {
+			whereClause += " WHERE " + metricsClause
+		}
+	}
+
+	return whereClause
+}
+
+// convertedAmount is the expression of the column in the currency, null for the currencies without a rate
+func convertedAmount(column string, rates model.ExchangeRates, currency model.Currency) string {
+	if currency == "" {
+		return column
+	}
+	currencies := make([]string, 0, len(rates))
+	for from := range rates {
+		if from.IsValid() {
+			currencies = append(currencies, string(from))
+		}
+	}
+	sort.Strings(currencies)
+
+	factors := ""
+	for _, from := range currencies {
+		factor := rates[currency] / rates[model.Currency(from)]
+		factors += fmt.Sprintf(" WHEN '%s' THEN %s", from, strconv.FormatFloat(factor, 'f', -1, 64))
+	}
+	return fmt.Sprintf("ROUND(%s * CASE currency%s END)", column, factors)
+}
+
+// rentPricingToNullInts stores the rent pricing in its columns, they are null for the properties that are not for rent
+func rentPricingToNullInts(rent *model.RentPricing) (sql.NullInt64, sql.NullInt64, sql.NullInt64) {
+	if rent == nil {
+		return sql.NullInt64{}, sql.NullInt64{}, sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(rent.MonthlyRent), Valid: true}, sql.NullInt64{Int64: int64(rent.DepositMonths), Valid: true},
+		sql.NullInt64{Int64: int64(rent.MinContractMonths), Valid: true}
+}
+
+func nullIntsToRentPricing(monthlyRent, depositMonths, minContractMonths sql.NullInt64) *model.RentPricing {
+	if !monthlyRent.Valid {
+		return nil
+	}
+	return &model.RentPricing{
+		MonthlyRent:       model.Amount(monthlyRent.Int64),
+		DepositMonths:     int(depositMonths.Int64),
+		MinContractMonths: int(minContractMonths.Int64),
+	}
+}
+
+// attributeFiltersClause filters by the amenities, the floor, the year built, the condition and the orientation
+func attributeFiltersClause(filters model.SearchFilters) string {
+	clauses := []string{}
+	if len(filters.Amenities) > 0 {
+		amenities := make([]string, 0, len(filters.Amenities))
+		for _, amenity := range filters.Amenities {
+			amenities = append(amenities, fmt.Sprintf("'%s'", amenity))
+		}
+		clauses = append(clauses, fmt.Sprintf("amenities @> ARRAY[%s]::TEXT[]", strings.Join(amenities, ", ")))
+	}
+	if filters.MinFloor != nil {
+		clauses = append(clauses, fmt.Sprintf("floor >= %d", *filters.MinFloor))
+	}
+	if filters.MaxFloor != nil {
+		clauses = append(clauses, fmt.Sprintf("floor <= %d", *filters.MaxFloor))
+	}
+	if filters.MinYearBuilt != nil {
+		clauses = append(clauses, fmt.Sprintf("year_built >= %d", *filters.MinYearBuilt))
+	}
+	if filters.MaxYearBuilt != nil {
+		clauses = append(clauses, fmt.Sprintf("year_built <= %d", *filters.MaxYearBuilt))
+	}
+	if filters.Condition != "" {
+		clauses = append(clauses, fmt.Sprintf("condition = '%s'", filters.Condition))
+	}
+	if filters.Orientation != "" {
+		clauses = append(clauses, fmt.Sprintf("orientation = '%s'", filters.Orientation))
+	}
+	if len(clauses) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(clauses, " AND ") + ") "
+}
+
+// metricFiltersClause bounds the price per square meter and the monthly cost in the currency of the search
+func metricFiltersClause(search properties.PropertySearchParams) string {
+	clauses := []string{}
+	pricePerSquareMeter := convertedAmount("price_per_square_meter", search.Rates, search.Currency)
+	if search.MinPricePerSquareMeter != nil {
+		clauses = append(clauses, fmt.Sprintf("%s >= %d", pricePerSquareMeter, *search.MinPricePerSquareMeter))
+	}
+	if search.MaxPricePerSquareMeter != nil {
+		clauses = append(clauses, fmt.Sprintf("%s <= %d", pricePerSquareMeter, *search.MaxPricePerSquareMeter))
+	}
+	monthlyCost := convertedAmount("monthly_cost", search.Rates, search.Currency)
+	if search.MinMonthlyCost != nil {
+		clauses = append(clauses, fmt.Sprintf("%s >= %d", monthlyCost, *search.MinMonthlyCost))
+	}
+	if search.MaxMonthlyCost != nil {
+		clauses = append(clauses, fmt.Sprintf("%s <= %d", monthlyCost, *search.MaxMonthlyCost))
+	}
+	if len(clauses) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(clauses, " AND ") + ") "
 }
 
-func (adapter *PostgreSQLAdapter) ListFavourites(search users.FavouritesSearchParams) (*model.PropertiesPaging, error) {
+// orderByClause sorts by the metric of the search, the last updated properties go first among the ones with the same value
+func orderByClause(search properties.PropertySearchParams) string {
+	column := ""
+	switch search.SortBy {
+	case properties.SortByPricePerSquareMeter:
+		column = "price_per_square_meter"
+	case properties.SortByMonthlyCost:
+		column = "monthly_cost"
+	default:
+		return "p.updated_at DESC"
+	}
+	direction := "ASC"
+	if search.SortDescending {
+		direction = "DESC"
+	}
+	return fmt.Sprintf("%s %s NULLS LAST, p.updated_at DESC", convertedAmount(column, search.Rates, search.Currency), direction)
+}
 
-	pagingResult := &model.PropertiesPaging{
-		Page:     search.Page,
-		PageSize: search.PageSize,
+// operationTypeOrSale stores the properties without an operation type as listed for sale
+func operationTypeOrSale(operationType model.OperationType) model.OperationType {
+	if operationType == "" {
+		return model.SALE
 	}
+	return operationType
+}
 
-	offset := search.PageSize * (search.Page - 1)
+// amenitiesOrEmpty stores the properties without amenities with an empty list, the column does not take nulls
+func amenitiesOrEmpty(amenities []model.Amenity) []string {
+	values := make([]string, 0, len(amenities))
+	for _, amenity := range amenities {
+		values = append(values, string(amenity))
+	}
+	return values
+}
 
-	query := fmt.Sprintf(`SELECT r.*, count(*) OVER() AS full_count FROM properties r 
-	INNER JOIN favourites f ON  f.property_id = r.id 
-	INNER JOIN users u ON u.id = f.user_id 
-	WHERE u.id = %v AND status = 'ACTIVE' 
-	ORDER BY updated_at DESC OFFSET %d LIMIT %d`, search.UserID, offset, search.PageSize)
+func stringsToAmenities(values []string) []model.Amenity {
+	if len(values) == 0 {
+		return nil
+	}
+	amenities := make([]model.Amenity, 0, len(values))
+	for _, value := range values {
+		amenities = append(amenities, model.Amenity(value))
+	}
+	return amenities
+}
 
-	rows, err := adapter.postgres.Conn.Query(query)
+func emptyToNullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func nullIntToPointer(value sql.NullInt64) *int {
+	if !value.Valid {
+		return nil
+	}
+	v := int(value.Int64)
+	return &v
+}
+
+func nullIntToAmount(value sql.NullInt64) *model.Amount {
+	if !value.Valid {
+		return nil
+	}
+	amount := model.Amount(value.Int64)
+	return &amount
+}
+
+func nullStringToPointer(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	return &value.String
+}
+
+func nullIntToAdministrativeFee(value sql.NullInt64) model.AdministrativeFee {
+	if !value.Valid {
+		return nil
+	}
+	v := model.Amount(value.Int64)
+	return &v
+}
+
+// ListModerationQueue lists the properties waiting for review, the ones waiting longer first
+func (adapter *PostgreSQLAdapter) ListModerationQueue(search moderation.ModerationSearchParams) (*model.PropertiesPaging, error) {
+	pagingResult := &model.PropertiesPaging{
+		Page:     search.Page,
+		PageSize: search.PageSize,
+		Data:     []*model.Property{},
+	}
+	rows, err := adapter.postgres.Conn.Query(`SELECT *, count(*) OVER() AS full_count FROM properties
+		WHERE status IN ('PENDING_REVIEW', 'DUPLICATE_SUSPECT') ORDER BY updated_at, id OFFSET $1 LIMIT $2`,
+		search.PageSize*(search.Page-1), search.PageSize)
 	if err != nil {
-		logger.GetInstance().Error("error listing favourites", zap.Error(err))
+		logger.GetInstance().Error("error listing moderation queue", zap.Error(err))
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		property, count, err := mapRowsToProperty(rows)
@@ -115,140 +1555,308 @@ func (adapter *PostgreSQLAdapter) ListFavourites(search users.FavouritesSearchPa
 		pagingResult.Data = append(pagingResult.Data, property)
 		pagingResult.Total = count
 	}
-
-	return pagingResult, nil
-
+	return pagingResult, rows.Err()
 }
 
-func mapRowsToUser(row *sql.Row) (*model.User, bool, error) {
-	if row.Err() != nil {
-		return nil, false, row.Err()
-	}
+// ReviewProperty changes the status of the reviewed version and records the review and the audit entry
+func (adapter *PostgreSQLAdapter) ReviewProperty(property *model.Property, review *model.PropertyReview, audit *model.AuditEntry) (*model.Property, error) {
+	var propertyStored *model.Property
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		var err error
+		propertyStored, err = updatePropertyStatus(tx, property)
+		if err != nil {
+			return 0, false, err
+		}
 
-	var email, password string
-	var id int64
-	err := row.Scan(&id, &email, &password)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, false, nil
+		err = tx.QueryRow(`INSERT INTO property_reviews(property_id, reviewer_id, decision, reason, status) VALUES($1, $2, $3, $4, $5)
+			RETURNING id, created_at`, review.PropertyID, review.ReviewerID, review.Decision, review.Reason, review.Status).Scan(&review.ID, &review.CreatedAt)
+		if err != nil {
+			logger.GetInstance().Error("fail to save property review", zap.Error(err))
+			return 0, false, err
 		}
-		return nil, false, err
+		return propertyStored.ID, true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &model.User{
-		ID:       id,
-		Email:    email,
-		Password: password,
-	}, true, nil
-
+	return propertyStored, nil
 }
 
-func (adapter *PostgreSQLAdapter) SaveProperty(property *model.Property) (*model.Property, error) {
-	row := adapter.postgres.Conn.QueryRow(`INSERT INTO properties(title, description, longitude, latitude, sale_price, administrative_fee, property_type,  bedrooms, bathrooms, parking_spots, area, photos, status) 
-			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING *`, property.Title, property.Description, property.Location.Longitude, property.Location.Latitude,
-		property.Pricing.SalePrice, property.Pricing.AdministrativeFee, property.PropertyType, property.Bedrooms, property.Bathrooms, property.ParkingSpots, property.Area, pq.Array(property.Photos), property.Status)
-
+// updatePropertyStatus moves the property to its status if it still has the version it was read with
+func updatePropertyStatus(tx *sql.Tx, property *model.Property) (*model.Property, error) {
+	row := tx.QueryRow(`UPDATE properties SET status = $2, invalid_reason = $4 WHERE id = $1 AND version = $3 RETURNING *`,
+		property.ID, property.Status, property.Version, property.InvalidReason)
 	propertyStored, found, err := mapRowToProperty(row)
 	if err != nil {
 		return nil, err
 	}
 	if !found {
-		return nil, errors.New("property not found")
+		return nil, model.NewPreconditionFailedError(errors.New("the property was modified by another request"))
 	}
 	return propertyStored, nil
 }
 
-func (adapter *PostgreSQLAdapter) UpdateProperty(property *model.Property) (*model.Property, error) {
-	row := adapter.postgres.Conn.QueryRow(`UPDATE properties SET  
-                      title = $2, 
-                      description = $3, 
-                      longitude = $4, 
-                      latitude = $5, 
-                      sale_price = $6, 
-                      administrative_fee = $7, 
-                      property_type = $8,  
-                      bedrooms = $9, 
-                      bathrooms = $10, 
-                      parking_spots = $11, 
-                      area = $12, 
-                      photos = $13, 
-                      status = $14 WHERE id = $1
-			RETURNING *`, property.ID, property.Title, property.Description, property.Location.Longitude, property.Location.Latitude,
-		property.Pricing.SalePrice, property.Pricing.AdministrativeFee, property.PropertyType, property.Bedrooms, property.Bathrooms, property.ParkingSpots, property.Area, pq.Array(property.Photos), property.Status)
+// expiryBatchSize bounds the listings expired or reminded in a run, the rest are left for the next one
+const expiryBatchSize = 500
 
-	propertyStored, found, err := mapRowToProperty(row)
+// ListExpiredListings lists the ACTIVE properties listed before the time of their type, the types left out never expire
+func (adapter *PostgreSQLAdapter) ListExpiredListings(listedBefore map[model.PropertyType]time.Time) ([]*model.Property, error) {
+	if len(listedBefore) == 0 {
+		return []*model.Property{}, nil
+	}
+	clause, args := listedBeforeClause(listedBefore)
+	return adapter.listProperties(`SELECT *, count(*) OVER() AS full_count FROM properties WHERE status = 'ACTIVE' AND `+clause+
+		fmt.Sprintf(` ORDER BY listed_at, id LIMIT %d`, expiryBatchSize), args...)
+}
+
+// ListListingsToRemind lists the ACTIVE properties listed before the time of their type whose owners were not reminded yet
+func (adapter *PostgreSQLAdapter) ListListingsToRemind(listedBefore map[model.PropertyType]time.Time) ([]*model.Property, error) {
+	if len(listedBefore) == 0 {
+		return []*model.Property{}, nil
+	}
+	clause, args := listedBeforeClause(listedBefore)
+	return adapter.listProperties(`SELECT *, count(*) OVER() AS full_count FROM properties p WHERE status = 'ACTIVE' AND `+clause+`
+		AND NOT EXISTS (SELECT 1 FROM listing_expiry_reminders r WHERE r.property_id = p.id AND r.listed_at = p.listed_at)`+
+		fmt.Sprintf(` ORDER BY listed_at, id LIMIT %d`, expiryBatchSize), args...)
+}
+
+// listedBeforeClause matches the properties listed before the time of their type, in a fixed order of the types
+func listedBeforeClause(listedBefore map[model.PropertyType]time.Time) (string, []interface{}) {
+	propertyTypes := make([]string, 0, len(listedBefore))
+	for propertyType := range listedBefore {
+		propertyTypes = append(propertyTypes, string(propertyType))
+	}
+	sort.Strings(propertyTypes)
+
+	conditions := make([]string, 0, len(propertyTypes))
+	args := make([]interface{}, 0, 2*len(propertyTypes))
+	for _, propertyType := range propertyTypes {
+		conditions = append(conditions, fmt.Sprintf("(property_type = $%d AND listed_at <= $%d)", len(args)+1, len(args)+2))
+		args = append(args, propertyType, listedBefore[model.PropertyType(propertyType)])
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+func (adapter *PostgreSQLAdapter) listProperties(query string, args ...interface{}) ([]*model.Property, error) {
+	rows, err := adapter.postgres.Conn.Query(query, args...)
 	if err != nil {
+		logger.GetInstance().Error("error listing properties", zap.Error(err))
 		return nil, err
 	}
-	if !found {
-		return nil, errors.New("property not found")
+	defer rows.Close()
+
+	result := []*model.Property{}
+	for rows.Next() {
+		property, _, err := mapRowsToProperty(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, property)
+	}
+	return result, rows.Err()
+}
+
+// SaveExpiryReminder returns false when the owner was already reminded about the listing
+func (adapter *PostgreSQLAdapter) SaveExpiryReminder(propertyID int64, listedAt time.Time) (bool, error) {
+	result, err := adapter.postgres.Conn.Exec(`INSERT INTO listing_expiry_reminders(property_id, listed_at) VALUES($1, $2)
+		ON CONFLICT (property_id, listed_at) DO NOTHING`, propertyID, listedAt)
+	if err != nil {
+		logger.GetInstance().Error("fail to save expiry reminder", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// ExpireProperty expires the version of the listing that was read and records the audit entry
+func (adapter *PostgreSQLAdapter) ExpireProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+	var propertyStored *model.Property
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		var err error
+		propertyStored, err = updatePropertyStatus(tx, property)
+		if err != nil {
+			return 0, false, err
+		}
+		return propertyStored.ID, true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return propertyStored, nil
 }
 
-func (adapter *PostgreSQLAdapter) GetProperty(propertyID int64) (*model.Property, bool, error) {
-	row := adapter.postgres.Conn.QueryRow(`SELECT * FROM properties WHERE id = $1`, propertyID)
-	return mapRowToProperty(row)
+// RenewProperty lists the property again from now, the property must still have the version that was read
+func (adapter *PostgreSQLAdapter) RenewProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+	var propertyStored *model.Property
+	err := adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		row := tx.QueryRow(`UPDATE properties SET status = $2, listed_at = now() WHERE id = $1 AND version = $3 RETURNING *`,
+			property.ID, property.Status, property.Version)
+		var found bool
+		var err error
+		propertyStored, found, err = mapRowToProperty(row)
+		if err != nil {
+			return 0, false, err
+		}
+		if !found {
+			return 0, false, model.NewPreconditionFailedError(errors.New("the property was modified by another request"))
+		}
+		return propertyStored.ID, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return propertyStored, nil
 }
 
-func (adapter *PostgreSQLAdapter) FilterProperties(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
-	pagingResult := &model.PropertiesPaging{
-		Page:     search.Page,
-		PageSize: search.PageSize,
+// earthRadiusInMeters is the mean radius used to measure the distance between the properties
+const earthRadiusInMeters = 6371000.0
+
+const photoColumns = `id, property_id, position, is_cover, content_type, width, height, url, thumbnail_url, original_url, keys, created_at`
+
+func (adapter *PostgreSQLAdapter) ListPropertyPhotos(propertyID int64) ([]*model.Photo, error) {
+	rows, err := adapter.postgres.Conn.Query(`SELECT `+photoColumns+` FROM property_photos WHERE property_id = $1 ORDER BY position, id`, propertyID)
+	if err != nil {
+		logger.GetInstance().Error("error listing property photos", zap.Error(err))
+		return nil, err
 	}
+	defer rows.Close()
 
-	whereClause := ""
+	photos := []*model.Photo{}
+	for rows.Next() {
+		photo, err := mapRowToPhoto(rows)
+		if err != nil {
+			return nil, err
+		}
+		photos = append(photos, photo)
+	}
+	return photos, rows.Err()
+}
 
-	if search.Status != "ALL" {
-		whereClause += fmt.Sprintf(" WHERE (status = '%s') ", search.Status)
+// SavePropertyPhoto sets the id and the creation date of the photo
+func (adapter *PostgreSQLAdapter) SavePropertyPhoto(photo *model.Photo) error {
+	err := adapter.postgres.Conn.QueryRow(`INSERT INTO property_photos(property_id, position, is_cover, content_type, width, height, url,
+		thumbnail_url, original_url, keys) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, created_at`,
+		photo.PropertyID, photo.Position, photo.Cover, photo.ContentType, photo.Width, photo.Height, photo.URL, photo.ThumbnailURL,
+		photo.OriginalURL, pq.Array(photo.Keys)).Scan(&photo.ID, &photo.CreatedAt)
+	if err != nil {
+		logger.GetInstance().Error("fail to save property photo", zap.Error(err))
+		return err
 	}
+	return nil
+}
 
-	if search.Bbox != nil {
-		bboxClause := fmt.Sprintf(" (latitude >= %v AND latitude <= %v AND longitude >= %v AND longitude <= %v) ", search.Bbox.MinLatitude, search.Bbox.MaxLatitude,
-			search.Bbox.MinLongitude, search.Bbox.MaxLongitude)
+// UpdatePropertyPhotos stores the positions and the cover of the photos of the property
+func (adapter *PostgreSQLAdapter) UpdatePropertyPhotos(propertyID int64, photos []*model.Photo) error {
+	tx, err := adapter.postgres.Conn.Begin()
+	if err != nil {
+		return err
+	}
 
-		if whereClause != "" {
-			whereClause += " AND " + bboxClause
-		} else {
-			whereClause += " WHERE " + bboxClause
+	if _, err := tx.Exec(`UPDATE property_photos SET is_cover = false WHERE property_id = $1 AND is_cover`, propertyID); err != nil {
+		logger.GetInstance().Error("fail to clear property photo cover", zap.Error(err))
+		_ = tx.Rollback()
+		return err
+	}
+	for _, photo := range photos {
+		_, err := tx.Exec(`UPDATE property_photos SET position = $1, is_cover = $2 WHERE id = $3 AND property_id = $4`,
+			photo.Position, photo.Cover, photo.ID, propertyID)
+		if err != nil {
+			logger.GetInstance().Error("fail to update property photo", zap.Error(err))
+			_ = tx.Rollback()
+			return err
 		}
 	}
+	return tx.Commit()
+}
 
-	offset := search.PageSize * (search.Page - 1)
+// DeletePropertyPhoto returns false when the photo does not belong to the property
+func (adapter *PostgreSQLAdapter) DeletePropertyPhoto(propertyID, photoID int64) (bool, error) {
+	result, err := adapter.postgres.Conn.Exec(`DELETE FROM property_photos WHERE id = $1 AND property_id = $2`, photoID, propertyID)
+	if err != nil {
+		logger.GetInstance().Error("fail to delete property photo", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
 
-	query := fmt.Sprintf(`SELECT *, count(*) OVER() AS full_count FROM properties %s ORDER BY updated_at DESC OFFSET %d LIMIT %d`, whereClause, offset, search.PageSize)
+func mapRowToPhoto(row rowScanner) (*model.Photo, error) {
+	photo := &model.Photo{}
+	err := row.Scan(&photo.ID, &photo.PropertyID, &photo.Position, &photo.Cover, &photo.ContentType, &photo.Width, &photo.Height,
+		&photo.URL, &photo.ThumbnailURL, &photo.OriginalURL, pq.Array(&photo.Keys), &photo.CreatedAt)
+	if err != nil {
+		logger.GetInstance().Error("error mapping property photo rows", zap.Error(err))
+		return nil, err
+	}
+	return photo, nil
+}
 
-	rows, err := adapter.postgres.Conn.Query(query)
+// ListDuplicateCandidates returns the properties within the radius of the location with the type and the area range
+func (adapter *PostgreSQLAdapter) ListDuplicateCandidates(search properties.DuplicateSearchParams) ([]*model.Property, error) {
+	latitudeDelta := search.RadiusInMeters / (earthRadiusInMeters * math.Pi / 180)
+	longitudeDelta := latitudeDelta / math.Max(math.Cos(search.Location.Latitude*math.Pi/180), 0.01)
+	rows, err := adapter.postgres.Conn.Query(`SELECT *, count(*) OVER() AS full_count FROM properties
+		WHERE property_type = $1 AND status <> 'INVALID' AND area BETWEEN $2 AND $3
+			AND latitude BETWEEN $4 - $6 AND $4 + $6 AND longitude BETWEEN $5 - $7 AND $5 + $7
+			AND 2 * $8 * asin(sqrt(power(sin(radians(latitude - $4) / 2), 2)
+				+ cos(radians($4)) * cos(radians(latitude)) * power(sin(radians(longitude - $5) / 2), 2))) <= $9
+		ORDER BY id DESC LIMIT 20`, search.PropertyType, search.MinArea, search.MaxArea, search.Location.Latitude, search.Location.Longitude,
+		latitudeDelta, longitudeDelta, earthRadiusInMeters, search.RadiusInMeters)
 	if err != nil {
-		logger.GetInstance().Error("error executing filtering properties query", zap.Error(err))
+		logger.GetInstance().Error("fail to list duplicate candidates", zap.Error(err))
 		return nil, err
 	}
+	defer rows.Close()
 
+	candidates := []*model.Property{}
 	for rows.Next() {
-		property, count, err := mapRowsToProperty(rows)
+		property, _, err := mapRowsToProperty(rows)
 		if err != nil {
 			return nil, err
 		}
-		pagingResult.Data = append(pagingResult.Data, property)
-		pagingResult.Total = count
+		candidates = append(candidates, property)
 	}
-
-	return pagingResult, nil
+	return candidates, rows.Err()
 }
 
-func mapRowsToProperty(rows *sql.Rows) (*model.Property, int64, error) {
+// mapRowsToProperty scans the property columns, then the extra destinations and last the full count
+func mapRowsToProperty(rows *sql.Rows, extra ...interface{}) (*model.Property, int64, error) {
 	var title, propertyType, status string
 	var description sql.NullString
 	var longitude, latitude float64
-	var bedrooms, bathrooms, area, salePrice int
+	var bedrooms, bathrooms, area int
+	var salePrice int64
 	var parkingSpots sql.NullInt64
 	var id int64
 	var createdAt, updateAt time.Time
 	var photos []string
 	var administrativeFee sql.NullInt64
+	var ownerID sql.NullInt64
 	var fullCount int64
 
-	err := rows.Scan(&id, &title, &description, &longitude, &latitude, &salePrice, &administrativeFee, &propertyType, &bedrooms, &bathrooms,
-		&parkingSpots, &area, pq.Array(&photos), &status, &createdAt, &updateAt, &fullCount)
+	var version int64
+	var listedAt time.Time
+	var invalidReason sql.NullString
+	var operationType, currency string
+	var monthlyRent, depositMonths, minContractMonths sql.NullInt64
+	var amenities []string
+	var floor, totalFloors, yearBuilt sql.NullInt64
+	var condition, orientation sql.NullString
+	var pricePerSquareMeter, monthlyCost sql.NullInt64
+	var suspectReason sql.NullString
+
+	dest := []interface{}{&id, &title, &description, &longitude, &latitude, &salePrice, &administrativeFee, &propertyType, &bedrooms, &bathrooms,
+		&parkingSpots, &area, pq.Array(&photos), &status, &createdAt, &updateAt, &ownerID, &version, &listedAt, &invalidReason,
+		&operationType, &monthlyRent, &depositMonths, &minContractMonths, &currency, pq.Array(&amenities), &floor, &totalFloors, &yearBuilt,
+		&condition, &orientation, &pricePerSquareMeter, &monthlyCost, &suspectReason}
+	dest = append(dest, extra...)
+	err := rows.Scan(append(dest, &fullCount)...)
 	if err != nil {
 		logger.GetInstance().Error("error mapping property rows", zap.Error(err))
 		return nil, 0, err
@@ -268,7 +1876,7 @@ func mapRowsToProperty(rows *sql.Rows) (*model.Property, int64, error) {
 
 	var administrativeFeeValue model.AdministrativeFee
 	if administrativeFee.Valid {
-		v := int(administrativeFee.Int64)
+		v := model.Amount(administrativeFee.Int64)
 		administrativeFeeValue = &v
 	}
 
@@ -281,39 +1889,71 @@ func mapRowsToProperty(rows *sql.Rows) (*model.Property, int64, error) {
 			Latitude:  latitude,
 		},
 		Pricing: model.Pricing{
-			SalePrice:         salePrice,
+			Currency:          model.Currency(currency),
+			SalePrice:         model.Amount(salePrice),
 			AdministrativeFee: administrativeFeeValue,
+			Rent:              nullIntsToRentPricing(monthlyRent, depositMonths, minContractMonths),
 		},
-		PropertyType: model.PropertyType(propertyType),
-		Bedrooms:     bedrooms,
-		Bathrooms:    bathrooms,
-		ParkingSpots: parkingSpotsValue,
-		Area:         area,
-		Photos:       photos,
-		CreatedAt:    createdAt,
-		UpdatedAt:    updateAt,
-		Status:       model.PropertyStatus(status),
+		PropertyType:        model.PropertyType(propertyType),
+		OperationType:       model.OperationType(operationType),
+		Bedrooms:            bedrooms,
+		Bathrooms:           bathrooms,
+		ParkingSpots:        parkingSpotsValue,
+		Area:                area,
+		Photos:              photos,
+		Amenities:           stringsToAmenities(amenities),
+		Floor:               nullIntToPointer(floor),
+		TotalFloors:         nullIntToPointer(totalFloors),
+		YearBuilt:           nullIntToPointer(yearBuilt),
+		Condition:           model.PropertyCondition(condition.String),
+		Orientation:         model.Orientation(orientation.String),
+		CreatedAt:           createdAt,
+		UpdatedAt:           updateAt,
+		Status:              model.PropertyStatus(status),
+		OwnerID:             ownerID.Int64,
+		Version:             version,
+		ListedAt:            listedAt,
+		InvalidReason:       nullStringToPointer(invalidReason),
+		SuspectReason:       nullStringToPointer(suspectReason),
+		PricePerSquareMeter: nullIntToAmount(pricePerSquareMeter),
+		MonthlyCost:         nullIntToAmount(monthlyCost),
 	}, fullCount, nil
 
 }
 
-func mapRowToProperty(row *sql.Row) (*model.Property, bool, error) {
+func mapRowToProperty(row *sql.Row, extra ...interface{}) (*model.Property, bool, error) {
 	if row.Err() != nil {
 		logger.GetInstance().Error("error executing operation on property ", zap.Error(row.Err()))
 		return nil, false, row.Err()
 	}
 	var title, propertyType, status string
 	var longitude, latitude float64
-	var bedrooms, bathrooms, area, salePrice int
+	var bedrooms, bathrooms, area int
+	var salePrice int64
 	var parkingSpots sql.NullInt64
 	var description sql.NullString
 	var id int64
 	var createdAt, updateAt time.Time
 	var photos []string
 	var administrativeFee sql.NullInt64
+	var ownerID sql.NullInt64
+
+	var version int64
+	var listedAt time.Time
+	var invalidReason sql.NullString
+	var operationType, currency string
+	var monthlyRent, depositMonths, minContractMonths sql.NullInt64
+	var amenities []string
+	var floor, totalFloors, yearBuilt sql.NullInt64
+	var condition, orientation sql.NullString
+	var pricePerSquareMeter, monthlyCost sql.NullInt64
+	var suspectReason sql.NullString
 
-	err := row.Scan(&id, &title, &description, &longitude, &latitude, &salePrice, &administrativeFee, &propertyType, &bedrooms, &bathrooms,
-		&parkingSpots, &area, pq.Array(&photos), &status, &createdAt, &updateAt)
+	dest := []interface{}{&id, &title, &description, &longitude, &latitude, &salePrice, &administrativeFee, &propertyType, &bedrooms, &bathrooms,
+		&parkingSpots, &area, pq.Array(&photos), &status, &createdAt, &updateAt, &ownerID, &version, &listedAt, &invalidReason,
+		&operationType, &monthlyRent, &depositMonths, &minContractMonths, &currency, pq.Array(&amenities), &floor, &totalFloors, &yearBuilt,
+		&condition, &orientation, &pricePerSquareMeter, &monthlyCost, &suspectReason}
+	err := row.Scan(append(dest, extra...)...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, false, nil
@@ -336,7 +1976,7 @@ func mapRowToProperty(row *sql.Row) (*model.Property, bool, error) {
 
 	var administrativeFeeValue model.AdministrativeFee
 	if administrativeFee.Valid {
-		v := int(administrativeFee.Int64)
+		v := model.Amount(administrativeFee.Int64)
 		administrativeFeeValue = &v
 	}
 
@@ -349,18 +1989,226 @@ func mapRowToProperty(row *sql.Row) (*model.Property, bool, error) {
 			Latitude:  latitude,
 		},
 		Pricing: model.Pricing{
-			SalePrice:         salePrice,
+			Currency:          model.Currency(currency),
+			SalePrice:         model.Amount(salePrice),
 			AdministrativeFee: administrativeFeeValue,
+			Rent:              nullIntsToRentPricing(monthlyRent, depositMonths, minContractMonths),
 		},
-		PropertyType: model.PropertyType(propertyType),
-		Bedrooms:     bedrooms,
-		Bathrooms:    bathrooms,
-		ParkingSpots: parkingSpotsValue,
-		Area:         area,
-		Photos:       photos,
-		CreatedAt:    createdAt,
-		UpdatedAt:    updateAt,
-		Status:       model.PropertyStatus(status),
+		PropertyType:        model.PropertyType(propertyType),
+		OperationType:       model.OperationType(operationType),
+		Bedrooms:            bedrooms,
+		Bathrooms:           bathrooms,
+		ParkingSpots:        parkingSpotsValue,
+		Area:                area,
+		Photos:              photos,
+		Amenities:           stringsToAmenities(amenities),
+		Floor:               nullIntToPointer(floor),
+		TotalFloors:         nullIntToPointer(totalFloors),
+		YearBuilt:           nullIntToPointer(yearBuilt),
+		Condition:           model.PropertyCondition(condition.String),
+		Orientation:         model.Orientation(orientation.String),
+		CreatedAt:           createdAt,
+		UpdatedAt:           updateAt,
+		Status:              model.PropertyStatus(status),
+		OwnerID:             ownerID.Int64,
+		Version:             version,
+		ListedAt:            listedAt,
+		InvalidReason:       nullStringToPointer(invalidReason),
+		SuspectReason:       nullStringToPointer(suspectReason),
+		PricePerSquareMeter: nullIntToAmount(pricePerSquareMeter),
+		MonthlyCost:         nullIntToAmount(monthlyCost),
+	}, true, nil
+
+}
+
+// auditedMutation runs the mutation and writes its audit entry in the same transaction when it changed something
+func (adapter *PostgreSQLAdapter) auditedMutation(audit *model.AuditEntry, mutation func(tx *sql.Tx) (int64, bool, error)) error {
+	tx, err := adapter.postgres.Conn.Begin()
+	if err != nil {
+		return err
+	}
+	entityID, changed, err := mutation(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if changed {
+		audit.EntityID = entityID
+		if err := insertAuditEntry(tx, audit); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func insertAuditEntry(tx *sql.Tx, audit *model.AuditEntry) error {
+	changes, err := json.Marshal(audit.Changes)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRow(`INSERT INTO audit_log(entity, entity_id, action, actor_id, actor_role, request_id, changes)
+		VALUES($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at`, audit.Entity, audit.EntityID, audit.Action, audit.ActorID,
+		sql.NullString{String: string(audit.ActorRole), Valid: audit.ActorRole != ""}, sql.NullString{String: audit.RequestID, Valid: audit.RequestID != ""},
+		changes).Scan(&audit.ID, &audit.CreatedAt)
+	if err != nil {
+		logger.GetInstance().Error("fail to save audit entry", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// SaveAuditEntry records the operations that only write the audit entry, like the login
+func (adapter *PostgreSQLAdapter) SaveAuditEntry(audit *model.AuditEntry) error {
+	return adapter.auditedMutation(audit, func(tx *sql.Tx) (int64, bool, error) {
+		return audit.EntityID, true, nil
+	})
+}
+
+func (adapter *PostgreSQLAdapter) ListAuditEntries(search audits.AuditSearchParams) (*model.AuditPaging, error) {
+	pagingResult := &model.AuditPaging{
+		Page:     search.Page,
+		PageSize: search.PageSize,
+		Data:     []*model.AuditEntry{},
+	}
+	offset := search.PageSize * (search.Page - 1)
+	var entityID sql.NullInt64
+	if search.EntityID != nil {
+		entityID = sql.NullInt64{Int64: *search.EntityID, Valid: true}
+	}
+
+	rows, err := adapter.postgres.Conn.Query(`SELECT id, entity, entity_id, action, actor_id, actor_role, request_id, changes, created_at,
+		count(*) OVER() AS full_count FROM audit_log WHERE entity = $1 AND ($2::BIGINT IS NULL OR entity_id = $2)
+		ORDER BY created_at DESC, id DESC OFFSET $3 LIMIT $4`, search.Entity, entityID, offset, search.PageSize)
+	if err != nil {
+		logger.GetInstance().Error("error listing audit entries", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entry := &model.AuditEntry{}
+		var actorID sql.NullInt64
+		var actorRole, requestID sql.NullString
+		var changes []byte
+		err := rows.Scan(&entry.ID, &entry.Entity, &entry.EntityID, &entry.Action, &actorID, &actorRole, &requestID, &changes,
+			&entry.CreatedAt, &pagingResult.Total)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(changes, &entry.Changes); err != nil {
+			return nil, err
+		}
+		if actorID.Valid {
+			entry.ActorID = &actorID.Int64
+		}
+		entry.ActorRole = model.Role(actorRole.String)
+		entry.RequestID = requestID.String
+		pagingResult.Data = append(pagingResult.Data, entry)
+	}
+	return pagingResult, rows.Err()
+}
+
+// idempotencyLockClass is the first key of the advisory locks on the idempotency keys, the second one is the hash of the key
+const idempotencyLockClass = 1
+
+// LockIdempotencyKey takes a session advisory lock on a dedicated connection until the returned function releases it
+func (adapter *PostgreSQLAdapter) LockIdempotencyKey(userID int64, key string) (func(), error) {
+	ctx := context.Background()
+	conn, err := adapter.postgres.Conn.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockKey := fmt.Sprintf("%d:%s", userID, key)
+	_, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1, hashtext($2))`, idempotencyLockClass, lockKey)
+	if err != nil {
+		logger.GetInstance().Error("fail to lock idempotency key", zap.Error(err))
+		_ = conn.Close()
+		return nil, err
+	}
+	return func() {
+		unlockAdvisoryLock(ctx, conn, idempotencyLockClass, lockKey)
+	}, nil
+}
+
+// jobLockClass is the first key of the advisory locks of the scheduled jobs, the second one is the hash of the job name
+const jobLockClass = 2
+
+// TryLockJob takes the advisory lock of the job without waiting, it returns false when another replica holds it
+func (adapter *PostgreSQLAdapter) TryLockJob(name string) (func(), bool, error) {
+	ctx := context.Background()
+	conn, err := adapter.postgres.Conn.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	var locked bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1, hashtext($2))`, jobLockClass, name).Scan(&locked)
+	if err != nil || !locked {
+		if err != nil {
+			logger.GetInstance().Error("fail to lock job", zap.Error(err), zap.String("job", name))
+		}
+		_ = conn.Close()
+		return nil, false, err
+	}
+	return func() {
+		unlockAdvisoryLock(ctx, conn, jobLockClass, name)
 	}, true, nil
+}
+
+// unlockAdvisoryLock releases the lock and discards the connection when the unlock fails
+func unlockAdvisoryLock(ctx context.Context, conn *sql.Conn, class int, key string) {
+	_, err := conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1, hashtext($2))`, class, key)
+	if err != nil {
+		logger.GetInstance().Error("fail to unlock advisory lock", zap.Error(err), zap.Int("class", class))
+		_ = conn.Raw(func(interface{}) error {
+			return driver.ErrBadConn
+		})
+	}
+	_ = conn.Close()
+}
+
+// GetIdempotencyKey ignores the expired keys
+func (adapter *PostgreSQLAdapter) GetIdempotencyKey(userID int64, key string) (*model.IdempotencyKey, bool, error) {
+	row := adapter.postgres.Conn.QueryRow(`SELECT user_id, key, request_hash, status_code, headers, body, created_at, expires_at
+		FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND expires_at > $3`, userID, key, time.Now().UTC())
+	if row.Err() != nil {
+		return nil, false, row.Err()
+	}
+
+	idempotencyKey := &model.IdempotencyKey{}
+	var headers []byte
+	err := row.Scan(&idempotencyKey.UserID, &idempotencyKey.Key, &idempotencyKey.RequestHash, &idempotencyKey.Response.StatusCode,
+		&headers, &idempotencyKey.Response.Body, &idempotencyKey.CreatedAt, &idempotencyKey.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		logger.GetInstance().Error("error scanning idempotency key", zap.Error(err))
+		return nil, false, err
+	}
+	if err := json.Unmarshal(headers, &idempotencyKey.Response.Header); err != nil {
+		return nil, false, err
+	}
+	return idempotencyKey, true, nil
+}
 
+// SaveIdempotencyKey replaces the key when it expired, and deletes the other expired keys
+func (adapter *PostgreSQLAdapter) SaveIdempotencyKey(idempotencyKey *model.IdempotencyKey) error {
+	headers, err := json.Marshal(idempotencyKey.Response.Header)
+	if err != nil {
+		return err
+	}
+	_, err = adapter.postgres.Conn.Exec(`WITH expired AS (
+			DELETE FROM idempotency_keys WHERE expires_at <= $7 AND NOT (user_id = $1 AND key = $2)
+		)
+		INSERT INTO idempotency_keys(user_id, key, request_hash, status_code, headers, body, created_at, expires_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id, key) DO UPDATE SET request_hash = $3, status_code = $4, headers = $5, body = $6, created_at = $7, expires_at = $8`,
+		idempotencyKey.UserID, idempotencyKey.Key, idempotencyKey.RequestHash, idempotencyKey.Response.StatusCode, headers,
+		idempotencyKey.Response.Body, idempotencyKey.CreatedAt, idempotencyKey.ExpiresAt)
+	if err != nil {
+		logger.GetInstance().Error("fail to save idempotency key", zap.Error(err))
+		return err
+	}
+	return nil
 }
@@ -1,15 +1,20 @@
 package adapter
 
 import (
+	"errors"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/stretchr/testify/suite"
 	"lahaus/config"
 	"lahaus/domain/model"
+	"lahaus/domain/usecases/audits"
+	"lahaus/domain/usecases/collections"
+	"lahaus/domain/usecases/moderation"
 	"lahaus/domain/usecases/properties"
 	"lahaus/domain/usecases/users"
 	"lahaus/infrastructure/storage"
 	"testing"
+	"time"
 )
 
 type PostgreSQLAdapterSuite struct {
@@ -70,16 +75,16 @@ func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter() {
 		},
 		Status: model.INACTIVE,
 	}
-	propertyStored, err := suite.postgresAdapter.SaveProperty(property)
+	propertyStored, err := suite.postgresAdapter.SaveProperty(property, testAudit(model.PROPERTY, model.CREATE))
 	suite.NoError(err)
 	suite.NotEqual(int64(0), propertyStored.ID)
 
 	description := "casa elegante"
-	administrativeFee := 20000
+	administrativeFee := model.Amount(20000)
 	propertyStored.Description = &description
 	propertyStored.Pricing.AdministrativeFee = &administrativeFee
 
-	propertyUpdated, err := suite.postgresAdapter.UpdateProperty(propertyStored)
+	propertyUpdated, err := suite.postgresAdapter.UpdateProperty(propertyStored, testAudit(model.PROPERTY, model.UPDATE))
 	suite.NoError(err)
 	suite.Equal(int64(1), propertyUpdated.ID)
 	suite.Equal(description, *propertyUpdated.Description)
@@ -105,33 +110,1207 @@ func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter() {
 	suite.Equal(int64(0), filter.TotalPages)
 	suite.Len(filter.Data, 0)
 
-	user := &model.User{Email: "david@mail.com", Password: "sarasa"}
-	err = suite.postgresAdapter.SaveUser(user)
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	err = suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE))
 	suite.NoError(err)
 	userStored, found, err := suite.postgresAdapter.GetUser(user.Email)
 	suite.NoError(err)
 	suite.True(found)
 	suite.Equal(int64(1), userStored.ID)
+	suite.Equal(model.BUYER, userStored.Role)
 
 	userNotFound, found, err := suite.postgresAdapter.GetUser("nada@noexiste.com")
 	suite.NoError(err)
 	suite.False(found)
 	suite.Nil(userNotFound)
 
-	err = suite.postgresAdapter.AddFavourite(userStored.ID, propertyStored.ID)
+	created, err := suite.postgresAdapter.AddFavourite(userStored.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_ADD))
 	suite.NoError(err)
+	suite.True(created)
+	created, err = suite.postgresAdapter.AddFavourite(userStored.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_ADD))
+	suite.NoError(err)
+	suite.False(created)
+
+	ids, err := suite.postgresAdapter.ListFavouriteIDs(userStored.ID, []int64{propertyStored.ID, propertyStored.ID + 100})
+	suite.NoError(err)
+	suite.Equal([]int64{propertyStored.ID}, ids)
 
 	list, err := suite.postgresAdapter.ListFavourites(
 		users.FavouritesSearchParams{UserID: userStored.ID, Page: 1, PageSize: 10})
 	suite.NoError(err)
 	suite.Len(list.Data, 0)
 
+	list, err = suite.postgresAdapter.ListFavourites(
+		users.FavouritesSearchParams{UserID: userStored.ID, Page: 1, PageSize: 10, IncludeUnavailable: true})
+	suite.NoError(err)
+	suite.Len(list.Data, 1)
+	suite.Equal(propertyUpdated.Status, list.Data[0].Status)
+	suite.False(list.Data[0].PriceChanged)
+
 	propertyUpdated.Status = model.ACTIVE
-	_, err = suite.postgresAdapter.UpdateProperty(propertyUpdated)
+	propertyUpdated.Pricing.SalePrice = propertyUpdated.Pricing.SalePrice - 1
+	_, err = suite.postgresAdapter.UpdateProperty(propertyUpdated, testAudit(model.PROPERTY, model.UPDATE))
 	suite.NoError(err)
 	list, err = suite.postgresAdapter.ListFavourites(
 		users.FavouritesSearchParams{UserID: userStored.ID, Page: 1, PageSize: 10})
 	suite.NoError(err)
 	suite.Len(list.Data, 1)
+	suite.True(list.Data[0].PriceChanged)
+	suite.Equal(propertyUpdated.Pricing.SalePrice+1, *list.Data[0].SavedPrice)
+
+	property.OwnerID = userStored.ID
+	ownedProperty, err := suite.postgresAdapter.SaveProperty(property, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(userStored.ID, ownedProperty.OwnerID)
+
+	filter, err = suite.postgresAdapter.FilterProperties(properties.PropertySearchParams{
+		Status:   "ALL",
+		OwnerID:  &userStored.ID,
+		Page:     1,
+		PageSize: 10,
+	})
+	suite.NoError(err)
+	suite.Equal(int64(1), filter.Total)
+	suite.Equal(ownedProperty.ID, filter.Data[0].ID)
+
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_PasswordReset() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	err := suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE))
+	suite.NoError(err)
+	userStored, _, err := suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+
+	err = suite.postgresAdapter.SavePasswordResetToken(&model.PasswordResetToken{
+		UserID:    userStored.ID,
+		TokenHash: "hash",
+		ExpiresAt: time.Now().UTC().Add(time.Hour),
+	})
+	suite.NoError(err)
+
+	token, found, err := suite.postgresAdapter.GetPasswordResetToken("hash")
+	suite.NoError(err)
+	suite.True(found)
+	suite.Nil(token.UsedAt)
+
+	consumed, err := suite.postgresAdapter.ResetPassword(token, "new-hash")
+	suite.NoError(err)
+	suite.True(consumed)
+
+	consumed, err = suite.postgresAdapter.ResetPassword(token, "other-hash")
+	suite.NoError(err)
+	suite.False(consumed)
+
+	userStored, _, err = suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+	suite.Equal("new-hash", userStored.Password)
+
+	token, found, err = suite.postgresAdapter.GetPasswordResetToken("hash")
+	suite.NoError(err)
+	suite.True(found)
+	suite.NotNil(token.UsedAt)
+
+	_, found, err = suite.postgresAdapter.GetPasswordResetToken("unknown")
+	suite.NoError(err)
+	suite.False(found)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_VerifyEmail() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	err := suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE))
+	suite.NoError(err)
+	userStored, _, err := suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+	suite.Nil(userStored.EmailVerifiedAt)
+
+	found, err := suite.postgresAdapter.VerifyEmail(user.Email)
+	suite.NoError(err)
+	suite.True(found)
+
+	userVerified, found, err := suite.postgresAdapter.GetUserByID(userStored.ID)
+	suite.NoError(err)
+	suite.True(found)
+	suite.NotNil(userVerified.EmailVerifiedAt)
+
+	found, err = suite.postgresAdapter.VerifyEmail(user.Email)
+	suite.NoError(err)
+	suite.True(found)
+	userVerifiedTwice, _, err := suite.postgresAdapter.GetUserByID(userStored.ID)
+	suite.NoError(err)
+	suite.Equal(*userVerified.EmailVerifiedAt, *userVerifiedTwice.EmailVerifiedAt)
+
+	found, err = suite.postgresAdapter.VerifyEmail("nada@noexiste.com")
+	suite.NoError(err)
+	suite.False(found)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_UpdateUserRole() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+
+	found, err := suite.postgresAdapter.UpdateUserRole(user.ID, model.AGENT, testAudit(model.USER, model.UPDATE))
+	suite.NoError(err)
+	suite.True(found)
+	userStored, _, err := suite.postgresAdapter.GetUserByID(user.ID)
+	suite.NoError(err)
+	suite.Equal(model.AGENT, userStored.Role)
+	entityID := user.ID
+	entries, err := suite.postgresAdapter.ListAuditEntries(audits.AuditSearchParams{Entity: model.USER, EntityID: &entityID, Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(2), entries.Total)
+
+	found, err = suite.postgresAdapter.UpdateUserRole(user.ID+1, model.AGENT, testAudit(model.USER, model.UPDATE))
+	suite.NoError(err)
+	suite.False(found)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_LoginAttempts() {
+	now := time.Now().UTC()
+	attempts, err := suite.postgresAdapter.GetLoginAttempts("email:david@mail.com")
+	suite.NoError(err)
+	suite.Nil(attempts)
+
+	_, err = suite.postgresAdapter.RegisterLoginFailure("email:david@mail.com", now, now.Add(-time.Minute))
+	suite.NoError(err)
+	attempts, err = suite.postgresAdapter.RegisterLoginFailure("email:david@mail.com", now, now.Add(-time.Minute))
+	suite.NoError(err)
+	suite.Equal(2, attempts.Failures)
+	suite.Nil(attempts.LockedUntil)
+
+	err = suite.postgresAdapter.LockLogin("email:david@mail.com", now.Add(time.Minute))
+	suite.NoError(err)
+	attempts, err = suite.postgresAdapter.GetLoginAttempts("email:david@mail.com")
+	suite.NoError(err)
+	suite.NotNil(attempts.LockedUntil)
+
+	attempts, err = suite.postgresAdapter.RegisterLoginFailure("email:david@mail.com", now.Add(time.Hour), now.Add(time.Minute))
+	suite.NoError(err)
+	suite.Equal(1, attempts.Failures)
+
+	err = suite.postgresAdapter.ResetLoginAttempts("email:david@mail.com")
+	suite.NoError(err)
+	attempts, err = suite.postgresAdapter.GetLoginAttempts("email:david@mail.com")
+	suite.NoError(err)
+	suite.Nil(attempts)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_RemoveFavourite() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+	userStored, _, err := suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento cerca a la estación",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         60,
+		Photos:       model.Photos{"https://cdn.pixabay.com/photo/2014/08/11/21/39/wall-416060_960_720.jpg"},
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	_, err = suite.postgresAdapter.AddFavourite(userStored.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_ADD))
+	suite.NoError(err)
+
+	removed, err := suite.postgresAdapter.RemoveFavourite(userStored.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_REMOVE))
+	suite.NoError(err)
+	suite.True(removed)
+	removed, err = suite.postgresAdapter.RemoveFavourite(userStored.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_REMOVE))
+	suite.NoError(err)
+	suite.False(removed)
+
+	ids, err := suite.postgresAdapter.ListFavouriteIDs(userStored.ID, []int64{propertyStored.ID})
+	suite.NoError(err)
+	suite.Empty(ids)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_Collections() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+	userStored, _, err := suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento cerca a la estación",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         60,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	userCollections, err := suite.postgresAdapter.ListCollections(userStored.ID)
+	suite.NoError(err)
+	suite.Len(userCollections, 1)
+	suite.True(userCollections[0].IsDefault)
+	defaultCollection := userCollections[0]
+
+	_, err = suite.postgresAdapter.AddFavourite(userStored.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_ADD))
+	suite.NoError(err)
+	defaultCollection, _, err = suite.postgresAdapter.GetCollection(defaultCollection.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), defaultCollection.ItemsCount)
+
+	polanco, err := suite.postgresAdapter.SaveCollection(&model.Collection{UserID: userStored.ID, Name: "Polanco options"})
+	suite.NoError(err)
+	suite.False(polanco.IsDefault)
+
+	polanco.Name = "Polanco"
+	polanco, err = suite.postgresAdapter.UpdateCollection(polanco)
+	suite.NoError(err)
+	suite.Equal("Polanco", polanco.Name)
+
+	note := "close to the park"
+	created, err := suite.postgresAdapter.SaveCollectionItem(polanco.ID, propertyStored.ID, &note)
+	suite.NoError(err)
+	suite.True(created)
+	created, err = suite.postgresAdapter.SaveCollectionItem(polanco.ID, propertyStored.ID, nil)
+	suite.NoError(err)
+	suite.False(created)
+
+	items, err := suite.postgresAdapter.ListCollectionItems(collectionsSearch(userStored.ID, polanco.ID))
+	suite.NoError(err)
+	suite.Len(items.Data, 1)
+	suite.Equal(note, *items.Data[0].Note)
+	suite.Equal(propertyStored.ID, items.Data[0].Property.ID)
+
+	parents, err := suite.postgresAdapter.SaveCollection(&model.Collection{UserID: userStored.ID, Name: "For parents"})
+	suite.NoError(err)
+	found, err := suite.postgresAdapter.TransferCollectionItem(polanco.ID, parents.ID, propertyStored.ID, false)
+	suite.NoError(err)
+	suite.True(found)
+	items, err = suite.postgresAdapter.ListCollectionItems(collectionsSearch(userStored.ID, parents.ID))
+	suite.NoError(err)
+	suite.Len(items.Data, 1)
+	suite.Equal(note, *items.Data[0].Note)
+	items, err = suite.postgresAdapter.ListCollectionItems(collectionsSearch(userStored.ID, polanco.ID))
+	suite.NoError(err)
+	suite.Len(items.Data, 0)
+
+	found, err = suite.postgresAdapter.TransferCollectionItem(polanco.ID, parents.ID, propertyStored.ID, true)
+	suite.NoError(err)
+	suite.False(found)
+
+	removed, err := suite.postgresAdapter.RemoveCollectionItem(parents.ID, propertyStored.ID)
+	suite.NoError(err)
+	suite.True(removed)
+
+	suite.NoError(suite.postgresAdapter.DeleteCollection(parents.ID))
+	_, found, err = suite.postgresAdapter.GetCollection(parents.ID)
+	suite.NoError(err)
+	suite.False(found)
+}
+
+func collectionsSearch(userID, collectionID int64) collections.CollectionItemsSearchParams {
+	return collections.CollectionItemsSearchParams{UserID: userID, CollectionID: collectionID, Page: 1, PageSize: 10}
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_ShareLinks() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+	userStored, _, err := suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+	defaultCollection, found, err := suite.postgresAdapter.GetDefaultCollection(userStored.ID)
+	suite.NoError(err)
+	suite.True(found)
+
+	expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
+	link, err := suite.postgresAdapter.SaveShareLink(&model.ShareLink{CollectionID: defaultCollection.ID, TokenHash: "hash", ExpiresAt: &expiresAt})
+	suite.NoError(err)
+	suite.Equal(expiresAt, link.ExpiresAt.UTC())
+
+	linkStored, found, err := suite.postgresAdapter.GetShareLink("hash")
+	suite.NoError(err)
+	suite.True(found)
+	suite.Nil(linkStored.RevokedAt)
+
+	links, err := suite.postgresAdapter.ListShareLinks(defaultCollection.ID)
+	suite.NoError(err)
+	suite.Len(links, 1)
+
+	revoked, err := suite.postgresAdapter.RevokeShareLink(defaultCollection.ID+1, link.ID)
+	suite.NoError(err)
+	suite.False(revoked)
+	revoked, err = suite.postgresAdapter.RevokeShareLink(defaultCollection.ID, link.ID)
+	suite.NoError(err)
+	suite.True(revoked)
+	linkStored, _, err = suite.postgresAdapter.GetShareLink("hash")
+	suite.NoError(err)
+	suite.NotNil(linkStored.RevokedAt)
+
+	_, found, err = suite.postgresAdapter.GetShareLink("unknown")
+	suite.NoError(err)
+	suite.False(found)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_SavedSearches() {
+	user := &model.User{Email: "searches@mail.com", Password: "sarasa", Role: model.BUYER}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+	userStored, _, err := suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+
+	filters := model.SearchFilters{Polygon: []model.Location{
+		{Longitude: -94.1, Latitude: 4.6}, {Longitude: -94, Latitude: 4.6}, {Longitude: -94, Latitude: 4.7},
+	}}
+	search, err := suite.postgresAdapter.SaveSavedSearch(&model.SavedSearch{UserID: userStored.ID, Name: "Near the station",
+		Filters: filters, Frequency: model.DAILY})
+	suite.NoError(err)
+	suite.Equal(filters, search.Filters)
+
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento cerca a la estación",
+		Location:     model.Location{Longitude: -94.0065887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         80,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	filter, err := suite.postgresAdapter.FilterProperties(properties.PropertySearchParams{
+		SearchFilters: filters, Status: "ALL", Page: 1, PageSize: 10,
+	})
+	suite.NoError(err)
+	suite.Len(filter.Data, 1)
+	suite.Equal(propertyStored.ID, filter.Data[0].ID)
+
+	now := time.Now().UTC()
+	created, err := suite.postgresAdapter.SaveSearchMatch(search.ID, propertyStored.ID, now)
+	suite.NoError(err)
+	suite.True(created)
+	created, err = suite.postgresAdapter.SaveSearchMatch(search.ID, propertyStored.ID, now)
+	suite.NoError(err)
+	suite.False(created)
+
+	due, err := suite.postgresAdapter.ListDueSavedSearches(model.DAILY, now.Add(-24*time.Hour))
+	suite.NoError(err)
+	suite.Len(due, 1)
+
+	updated, err := suite.postgresAdapter.ListActivePropertiesUpdatedSince(now.Add(-time.Hour))
+	suite.NoError(err)
+	suite.Len(updated, 1)
+
+	// a failed delivery keeps the matches pending
+	err = suite.postgresAdapter.DeliverSearchMatches(search.ID, now, func(matched []*model.Property) error {
+		suite.Len(matched, 1)
+		return errors.New("fail")
+	})
+	suite.Error(err)
+	due, err = suite.postgresAdapter.ListDueSavedSearches(model.DAILY, now.Add(-24*time.Hour))
+	suite.NoError(err)
+	suite.Len(due, 1)
+
+	delivered := 0
+	deliver := func(matched []*model.Property) error {
+		delivered += len(matched)
+		return nil
+	}
+	suite.NoError(suite.postgresAdapter.DeliverSearchMatches(search.ID, now, deliver))
+	suite.NoError(suite.postgresAdapter.DeliverSearchMatches(search.ID, now, deliver))
+	suite.Equal(1, delivered)
+	due, err = suite.postgresAdapter.ListDueSavedSearches(model.DAILY, now.Add(-24*time.Hour))
+	suite.NoError(err)
+	suite.Len(due, 0)
+
+	suite.NoError(suite.postgresAdapter.DeleteSavedSearch(search.ID))
+	_, found, err := suite.postgresAdapter.GetSavedSearch(search.ID)
+	suite.NoError(err)
+	suite.False(found)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_PriceDrops() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+	userStored, _, err := suite.postgresAdapter.GetUser(user.Email)
+	suite.NoError(err)
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento cerca a la estación",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         60,
+		Photos:       model.Photos{"https://cdn.pixabay.com/photo/2014/08/11/21/39/wall-416060_960_720.jpg"},
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	settings, err := suite.postgresAdapter.GetPriceDropSettings(userStored.ID)
+	suite.NoError(err)
+	suite.True(settings.Enabled)
+	suite.Equal(0, settings.MinDropPercent)
+
+	_, err = suite.postgresAdapter.AddFavourite(userStored.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_ADD))
+	suite.NoError(err)
+	_, err = suite.postgresAdapter.SavePriceDropSettings(&model.PriceDropSettings{UserID: userStored.ID, Enabled: true, MinDropPercent: 5})
+	suite.NoError(err)
+
+	watchers, err := suite.postgresAdapter.ListPriceDropWatchers(propertyStored.ID)
+	suite.NoError(err)
+	suite.Len(watchers, 1)
+	suite.Equal(user.Email, watchers[0].Email)
+	suite.Equal(5, watchers[0].MinDropPercent)
+
+	propertyStored.Title = "Apartamento junto a la estación"
+	renamed, err := suite.postgresAdapter.UpdateProperty(propertyStored, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	suite.Zero(renamed.PriceChangeID)
+
+	renamed.Pricing.SalePrice = 400000000
+	dropped, err := suite.postgresAdapter.UpdateProperty(renamed, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	suite.NotZero(dropped.PriceChangeID)
+
+	saved, err := suite.postgresAdapter.SavePriceDropNotification(userStored.ID, dropped.PriceChangeID)
+	suite.NoError(err)
+	suite.True(saved)
+	saved, err = suite.postgresAdapter.SavePriceDropNotification(userStored.ID, dropped.PriceChangeID)
+	suite.NoError(err)
+	suite.False(saved)
+
+	// dropping again to the same price is another change
+	dropped.Pricing.SalePrice = 450000000
+	raised, err := suite.postgresAdapter.UpdateProperty(dropped, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	raised.Pricing.SalePrice = 400000000
+	droppedAgain, err := suite.postgresAdapter.UpdateProperty(raised, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	suite.NotEqual(dropped.PriceChangeID, droppedAgain.PriceChangeID)
+	saved, err = suite.postgresAdapter.SavePriceDropNotification(userStored.ID, droppedAgain.PriceChangeID)
+	suite.NoError(err)
+	suite.True(saved)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_PriceHistory() {
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento cerca a la estación",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         60,
+		Photos:       model.Photos{"https://cdn.pixabay.com/photo/2014/08/11/21/39/wall-416060_960_720.jpg"},
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	history, err := suite.postgresAdapter.ListPriceHistory(propertyStored.ID)
+	suite.NoError(err)
+	suite.Empty(history)
+
+	administrativeFee := model.Amount(300000)
+	propertyStored.Pricing.AdministrativeFee = &administrativeFee
+	propertyStored, err = suite.postgresAdapter.UpdateProperty(propertyStored, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	propertyStored.Pricing.SalePrice = 400000000
+	propertyStored, err = suite.postgresAdapter.UpdateProperty(propertyStored, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	propertyStored.Title = "Apartamento con vista"
+	_, err = suite.postgresAdapter.UpdateProperty(propertyStored, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+
+	history, err = suite.postgresAdapter.ListPriceHistory(propertyStored.ID)
+	suite.NoError(err)
+	suite.Len(history, 2)
+	suite.Equal(450000000, history[0].Previous.SalePrice)
+	suite.Equal(400000000, history[0].Current.SalePrice)
+	suite.Nil(history[1].Previous.AdministrativeFee)
+	suite.Equal(administrativeFee, *history[1].Current.AdministrativeFee)
+
+	filter, err := suite.postgresAdapter.FilterProperties(properties.PropertySearchParams{
+		Status:   "ACTIVE",
+		Page:     1,
+		PageSize: 10,
+	})
+	suite.NoError(err)
+	suite.Len(filter.Data, 1)
+	suite.Equal(450000000, *filter.Data[0].PreviousPrice)
+	suite.Equal(history[0].ChangedAt, *filter.Data[0].PriceChangedAt)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_Version() {
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento cerca a la estación",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         60,
+		Photos:       model.Photos{"https://cdn.pixabay.com/photo/2014/08/11/21/39/wall-416060_960_720.jpg"},
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(int64(1), propertyStored.Version)
+
+	stale := *propertyStored
+	propertyStored.Title = "Apartamento con vista"
+	propertyUpdated, err := suite.postgresAdapter.UpdateProperty(propertyStored, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	suite.Equal(int64(2), propertyUpdated.Version)
+
+	stale.Title = "Apartamento con balcón"
+	_, err = suite.postgresAdapter.UpdateProperty(&stale, testAudit(model.PROPERTY, model.UPDATE))
+	suite.IsType(&model.PreconditionFailedError{}, err)
+
+	property, found, err := suite.postgresAdapter.GetProperty(propertyStored.ID)
+	suite.NoError(err)
+	suite.True(found)
+	suite.Equal("Apartamento con vista", property.Title)
+	suite.Equal(int64(2), property.Version)
+}
+
+func testAudit(entity model.AuditEntity, action model.AuditAction) *model.AuditEntry {
+	return model.NewAuditEntry(entity, 0, action, &model.Actor{UserID: 1, Role: model.ADMIN, RequestID: "test"}, nil)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_AuditLog() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.BUYER}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento cerca a la estación",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         60,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	changes := map[string]model.AuditChange{"status": {Before: "ACTIVE", After: "INACTIVE"}}
+	propertyStored.Status = model.INACTIVE
+	_, err = suite.postgresAdapter.UpdateProperty(propertyStored, model.NewAuditEntry(model.PROPERTY, propertyStored.ID, model.STATUS_CHANGE,
+		&model.Actor{UserID: 1, Role: model.ADMIN, RequestID: "request-1"}, changes))
+	suite.NoError(err)
+
+	entityID := propertyStored.ID
+	entries, err := suite.postgresAdapter.ListAuditEntries(audits.AuditSearchParams{Entity: model.PROPERTY, EntityID: &entityID, Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(2), entries.Total)
+	suite.Equal(model.STATUS_CHANGE, entries.Data[0].Action)
+	suite.Equal("request-1", entries.Data[0].RequestID)
+	suite.Equal(changes, entries.Data[0].Changes)
+	suite.Equal(model.CREATE, entries.Data[1].Action)
+
+	_, err = suite.postgresAdapter.AddFavourite(user.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_ADD))
+	suite.NoError(err)
+	_, err = suite.postgresAdapter.AddFavourite(user.ID, propertyStored.ID, testAudit(model.USER, model.FAVOURITE_ADD))
+	suite.NoError(err)
+	entityID = user.ID
+	entries, err = suite.postgresAdapter.ListAuditEntries(audits.AuditSearchParams{Entity: model.USER, EntityID: &entityID, Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(2), entries.Total)
+
+	_, err = suite.postgresAdapter.UpdateProperty(&model.Property{ID: 999, Status: model.ACTIVE}, testAudit(model.PROPERTY, model.UPDATE))
+	suite.Error(err)
+	entries, err = suite.postgresAdapter.ListAuditEntries(audits.AuditSearchParams{Entity: model.PROPERTY, Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(2), entries.Total)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_IdempotencyKeys() {
+	user := &model.User{Email: "david@mail.com", Password: "sarasa", Role: model.AGENT}
+	suite.NoError(suite.postgresAdapter.SaveUser(user, testAudit(model.USER, model.CREATE)))
+
+	_, found, err := suite.postgresAdapter.GetIdempotencyKey(user.ID, "key")
+	suite.NoError(err)
+	suite.False(found)
+
+	now := time.Now().UTC()
+	idempotencyKey := &model.IdempotencyKey{
+		UserID:      user.ID,
+		Key:         "key",
+		RequestHash: "hash",
+		Response:    model.StoredResponse{StatusCode: 200, Header: map[string]string{"Etag": `"1"`}, Body: []byte(`{"id":7}`)},
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+	}
+	suite.NoError(suite.postgresAdapter.SaveIdempotencyKey(idempotencyKey))
+	stored, found, err := suite.postgresAdapter.GetIdempotencyKey(user.ID, "key")
+	suite.NoError(err)
+	suite.True(found)
+	suite.Equal(idempotencyKey.RequestHash, stored.RequestHash)
+	suite.Equal(idempotencyKey.Response, stored.Response)
+
+	expired := &model.IdempotencyKey{UserID: user.ID, Key: "expired", RequestHash: "hash", Response: model.StoredResponse{StatusCode: 200, Body: []byte{}},
+		CreatedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+	suite.NoError(suite.postgresAdapter.SaveIdempotencyKey(expired))
+	_, found, err = suite.postgresAdapter.GetIdempotencyKey(user.ID, "expired")
+	suite.NoError(err)
+	suite.False(found)
+	expired.RequestHash = "another hash"
+	expired.ExpiresAt = now.Add(time.Hour)
+	suite.NoError(suite.postgresAdapter.SaveIdempotencyKey(expired))
+	stored, found, err = suite.postgresAdapter.GetIdempotencyKey(user.ID, "expired")
+	suite.NoError(err)
+	suite.True(found)
+	suite.Equal("another hash", stored.RequestHash)
+
+	unlock, err := suite.postgresAdapter.LockIdempotencyKey(user.ID, "key")
+	suite.NoError(err)
+	locked := make(chan struct{})
+	go func() {
+		unlockSecond, err := suite.postgresAdapter.LockIdempotencyKey(user.ID, "key")
+		suite.NoError(err)
+		close(locked)
+		unlockSecond()
+	}()
+	select {
+	case <-locked:
+		suite.Fail("the key must stay locked until it is unlocked")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		suite.Fail("the key must be locked by the second request after the unlock")
+	}
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_DuplicateCandidates() {
+	newProperty := func(title string, longitude, latitude float64, area int, status model.PropertyStatus) *model.Property {
+		return &model.Property{
+			Title:        title,
+			Location:     model.Location{Longitude: longitude, Latitude: latitude},
+			Pricing:      model.Pricing{SalePrice: 3000000},
+			PropertyType: model.HOUSE,
+			Bedrooms:     1,
+			Bathrooms:    1,
+			Area:         area,
+			Status:       status,
+		}
+	}
+	near, err := suite.postgresAdapter.SaveProperty(newProperty("Casa de familia", -99.096741, 19.296135, 300, model.ACTIVE),
+		testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suspect, err := suite.postgresAdapter.SaveProperty(newProperty("Casa familiar", -99.096800, 19.296200, 305, model.DUPLICATE_SUSPECT),
+		testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	_, err = suite.postgresAdapter.SaveProperty(newProperty("Casa lejana", -99.106741, 19.296135, 300, model.ACTIVE),
+		testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	_, err = suite.postgresAdapter.SaveProperty(newProperty("Casa grande", -99.096741, 19.296135, 600, model.ACTIVE),
+		testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	_, err = suite.postgresAdapter.SaveProperty(newProperty("Casa invalida", -99.096741, 19.296135, 300, model.INVALID),
+		testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	candidates, err := suite.postgresAdapter.ListDuplicateCandidates(properties.DuplicateSearchParams{
+		PropertyType:   model.HOUSE,
+		Location:       model.Location{Longitude: -99.096741, Latitude: 19.296135},
+		RadiusInMeters: 50,
+		MinArea:        270,
+		MaxArea:        330,
+	})
+	suite.NoError(err)
+	suite.Len(candidates, 2)
+	suite.Equal(suspect.ID, candidates[0].ID)
+	suite.Equal(near.ID, candidates[1].ID)
+
+	filter, err := suite.postgresAdapter.FilterProperties(properties.PropertySearchParams{Status: "ALL", Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(3), filter.Total)
+	filter, err = suite.postgresAdapter.FilterProperties(properties.PropertySearchParams{Status: "DUPLICATE_SUSPECT", Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(1), filter.Total)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_Moderation() {
+	reviewer := &model.User{Email: "admin@mail.com", Password: "sarasa", Role: model.ADMIN}
+	suite.NoError(suite.postgresAdapter.SaveUser(reviewer, testAudit(model.USER, model.CREATE)))
+	for _, status := range []model.PropertyStatus{model.PENDING_REVIEW, model.ACTIVE, model.DUPLICATE_SUSPECT} {
+		_, err := suite.postgresAdapter.SaveProperty(&model.Property{
+			Title:        "Apartamento cerca a la estación",
+			Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+			Pricing:      model.Pricing{SalePrice: 450000000},
+			PropertyType: model.APARTMENT,
+			Bedrooms:     3,
+			Bathrooms:    2,
+			Area:         60,
+			Status:       status,
+		}, testAudit(model.PROPERTY, model.CREATE))
+		suite.NoError(err)
+	}
+
+	queue, err := suite.postgresAdapter.ListModerationQueue(moderation.ModerationSearchParams{Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(2), queue.Total)
+	suite.Len(queue.Data, 2)
+	suite.Equal(model.PENDING_REVIEW, queue.Data[0].Status)
+
+	searchResult, err := suite.postgresAdapter.FilterProperties(properties.PropertySearchParams{Status: "ALL", Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(1), searchResult.Total)
+
+	reason := "fotos de otra propiedad"
+	property := *queue.Data[0]
+	property.Status = model.REJECTED
+	review := &model.PropertyReview{PropertyID: property.ID, ReviewerID: reviewer.ID, Decision: model.REJECT, Reason: &reason, Status: model.REJECTED}
+	propertyReviewed, err := suite.postgresAdapter.ReviewProperty(&property, review, testAudit(model.PROPERTY, model.STATUS_CHANGE))
+	suite.NoError(err)
+	suite.Equal(model.REJECTED, propertyReviewed.Status)
+	suite.NotZero(review.ID)
+	suite.False(review.CreatedAt.IsZero())
+
+	_, err = suite.postgresAdapter.ReviewProperty(&property, review, testAudit(model.PROPERTY, model.STATUS_CHANGE))
+	suite.IsType(&model.PreconditionFailedError{}, err)
+
+	queue, err = suite.postgresAdapter.ListModerationQueue(moderation.ModerationSearchParams{Page: 1, PageSize: 10})
+	suite.NoError(err)
+	suite.Equal(int64(1), queue.Total)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_ListingExpiry() {
+	owner := &model.User{Email: "owner@mail.com", Password: "sarasa", Role: model.AGENT}
+	suite.NoError(suite.postgresAdapter.SaveUser(owner, testAudit(model.USER, model.CREATE)))
+	for _, propertyType := range []model.PropertyType{model.HOUSE, model.APARTMENT} {
+		_, err := suite.postgresAdapter.SaveProperty(&model.Property{
+			Title:        "Casa cerca a la estación",
+			Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+			Pricing:      model.Pricing{SalePrice: 450000000},
+			PropertyType: propertyType,
+			Bedrooms:     3,
+			Bathrooms:    2,
+			Area:         60,
+			Status:       model.ACTIVE,
+			OwnerID:      owner.ID,
+		}, testAudit(model.PROPERTY, model.CREATE))
+		suite.NoError(err)
+	}
+
+	now := time.Now().UTC()
+	listedBefore := map[model.PropertyType]time.Time{model.HOUSE: now.Add(time.Hour), model.APARTMENT: now.Add(-time.Hour)}
+	toRemind, err := suite.postgresAdapter.ListListingsToRemind(listedBefore)
+	suite.NoError(err)
+	suite.Len(toRemind, 1)
+	suite.Equal(model.HOUSE, toRemind[0].PropertyType)
+	saved, err := suite.postgresAdapter.SaveExpiryReminder(toRemind[0].ID, toRemind[0].ListedAt)
+	suite.NoError(err)
+	suite.True(saved)
+	saved, err = suite.postgresAdapter.SaveExpiryReminder(toRemind[0].ID, toRemind[0].ListedAt)
+	suite.NoError(err)
+	suite.False(saved)
+	toRemind, err = suite.postgresAdapter.ListListingsToRemind(listedBefore)
+	suite.NoError(err)
+	suite.Len(toRemind, 0)
+
+	expired, err := suite.postgresAdapter.ListExpiredListings(listedBefore)
+	suite.NoError(err)
+	suite.Len(expired, 1)
+	property := *expired[0]
+	property.Status = model.EXPIRED
+	propertyExpired, err := suite.postgresAdapter.ExpireProperty(&property, testAudit(model.PROPERTY, model.STATUS_CHANGE))
+	suite.NoError(err)
+	suite.Equal(model.EXPIRED, propertyExpired.Status)
+	_, err = suite.postgresAdapter.ExpireProperty(&property, testAudit(model.PROPERTY, model.STATUS_CHANGE))
+	suite.IsType(&model.PreconditionFailedError{}, err)
+
+	propertyExpired.Status = model.ACTIVE
+	propertyRenewed, err := suite.postgresAdapter.RenewProperty(propertyExpired, testAudit(model.PROPERTY, model.RENEW))
+	suite.NoError(err)
+	suite.Equal(model.ACTIVE, propertyRenewed.Status)
+	suite.True(propertyRenewed.ListedAt.After(expired[0].ListedAt))
+
+	toRemind, err = suite.postgresAdapter.ListListingsToRemind(listedBefore)
+	suite.NoError(err)
+	suite.Len(toRemind, 1)
+	noExpiry, err := suite.postgresAdapter.ListExpiredListings(map[model.PropertyType]time.Time{})
+	suite.NoError(err)
+	suite.Len(noExpiry, 0)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_RenewProperty() {
+	owner := &model.User{Email: "owner@mail.com", Password: "sarasa", Role: model.AGENT}
+	suite.NoError(suite.postgresAdapter.SaveUser(owner, testAudit(model.USER, model.CREATE)))
+	property, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Casa cerca a la estación",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.HOUSE,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         60,
+		Status:       model.ACTIVE,
+		OwnerID:      owner.ID,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	propertyRenewed, err := suite.postgresAdapter.RenewProperty(property, testAudit(model.PROPERTY, model.RENEW))
+	suite.NoError(err)
+	suite.Equal(property.ID, propertyRenewed.ID)
+	suite.Equal(model.ACTIVE, propertyRenewed.Status)
+	suite.Equal(property.Version+1, propertyRenewed.Version)
+	suite.Equal(int64(0), propertyRenewed.PriceChangeID)
+	suite.False(propertyRenewed.ListedAt.Before(property.ListedAt))
+
+	_, err = suite.postgresAdapter.RenewProperty(property, testAudit(model.PROPERTY, model.RENEW))
+	suite.IsType(&model.PreconditionFailedError{}, err)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_TryLockJob() {
+	unlock, locked, err := suite.postgresAdapter.TryLockJob("listing-expiry")
+	suite.NoError(err)
+	suite.True(locked)
+
+	_, lockedAgain, err := suite.postgresAdapter.TryLockJob("listing-expiry")
+	suite.NoError(err)
+	suite.False(lockedAgain)
+
+	unlock()
+	unlock, locked, err = suite.postgresAdapter.TryLockJob("listing-expiry")
+	suite.NoError(err)
+	suite.True(locked)
+	unlock()
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_PropertyPhotos() {
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Casa con jardín",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.HOUSE,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         120,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	cover := &model.Photo{PropertyID: propertyStored.ID, Position: 0, Cover: true, ContentType: "image/jpeg", Width: 1280, Height: 960,
+		URL: "http://localhost:8080/photos/1/web.jpg", ThumbnailURL: "http://localhost:8080/photos/1/thumb.jpg",
+		OriginalURL: "http://localhost:8080/photos/1/original.jpg", Keys: []string{"1/original.jpg", "1/web.jpg", "1/thumb.jpg"}}
+	suite.NoError(suite.postgresAdapter.SavePropertyPhoto(cover))
+	suite.NotZero(cover.ID)
+	second := &model.Photo{PropertyID: propertyStored.ID, Position: 1, ContentType: "image/png", Width: 800, Height: 600,
+		URL: "http://localhost:8080/photos/2/web.png", ThumbnailURL: "http://localhost:8080/photos/2/thumb.png",
+		OriginalURL: "http://localhost:8080/photos/2/original.png", Keys: []string{"2/original.png"}}
+	suite.NoError(suite.postgresAdapter.SavePropertyPhoto(second))
+
+	photos, err := suite.postgresAdapter.ListPropertyPhotos(propertyStored.ID)
+	suite.NoError(err)
+	suite.Len(photos, 2)
+	suite.Equal(cover.Keys, photos[0].Keys)
+	suite.True(photos[0].Cover)
+
+	photos[0].Cover, photos[0].Position = false, 1
+	photos[1].Cover, photos[1].Position = true, 0
+	suite.NoError(suite.postgresAdapter.UpdatePropertyPhotos(propertyStored.ID, photos))
+	photos, err = suite.postgresAdapter.ListPropertyPhotos(propertyStored.ID)
+	suite.NoError(err)
+	suite.Equal(second.ID, photos[0].ID)
+	suite.True(photos[0].Cover)
+	suite.False(photos[1].Cover)
+
+	deleted, err := suite.postgresAdapter.DeletePropertyPhoto(propertyStored.ID+1, cover.ID)
+	suite.NoError(err)
+	suite.False(deleted)
+	deleted, err = suite.postgresAdapter.DeletePropertyPhoto(propertyStored.ID, cover.ID)
+	suite.NoError(err)
+	suite.True(deleted)
+	photos, err = suite.postgresAdapter.ListPropertyPhotos(propertyStored.ID)
+	suite.NoError(err)
+	suite.Len(photos, 1)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_InvalidReason() {
+	reason := "the host of the photo \"https://example.com/a.jpg\" is not allowed"
+	propertyStored, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:         "Casa con jardín",
+		Location:      model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:       model.Pricing{SalePrice: 450000000},
+		PropertyType:  model.HOUSE,
+		Bedrooms:      3,
+		Bathrooms:     2,
+		Area:          120,
+		Photos:        model.Photos{"https://example.com/a.jpg"},
+		Status:        model.INVALID,
+		InvalidReason: &reason,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(reason, *propertyStored.InvalidReason)
+
+	propertyStored.Photos = model.Photos{"https://cdn.pixabay.com/a.jpg"}
+	propertyStored.Status = model.ACTIVE
+	propertyStored.InvalidReason = nil
+	propertyStored, err = suite.postgresAdapter.UpdateProperty(propertyStored, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	suite.Nil(propertyStored.InvalidReason)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_RentalListings() {
+	forSale, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Casa con jardín",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.HOUSE,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         120,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(model.SALE, forSale.OperationType)
+	suite.Nil(forSale.Pricing.Rent)
 
+	rent := &model.RentPricing{MonthlyRent: 2500000, DepositMonths: 2, MinContractMonths: 12}
+	forRent, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:         "Apartamento amoblado",
+		Location:      model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:       model.Pricing{Rent: rent},
+		PropertyType:  model.APARTMENT,
+		OperationType: model.RENT,
+		Bedrooms:      2,
+		Bathrooms:     1,
+		Area:          60,
+		Status:        model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(model.RENT, forRent.OperationType)
+	suite.Equal(rent, forRent.Pricing.Rent)
+
+	search := properties.PropertySearchParams{Status: "ACTIVE", Page: 1, PageSize: 10}
+	search.Operation = model.RENT
+	paging, err := suite.postgresAdapter.FilterProperties(search)
+	suite.NoError(err)
+	suite.Len(paging.Data, 1)
+	suite.Equal(forRent.ID, paging.Data[0].ID)
+	suite.Equal(rent, paging.Data[0].Pricing.Rent)
+
+	forSale.OperationType = model.SALE_AND_RENT
+	forSale.Pricing.Rent = rent
+	forSale, err = suite.postgresAdapter.UpdateProperty(forSale, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	suite.Equal(model.SALE_AND_RENT, forSale.OperationType)
+
+	paging, err = suite.postgresAdapter.FilterProperties(search)
+	suite.NoError(err)
+	suite.Len(paging.Data, 2)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_Currencies() {
+	updatedAt := time.Now().UTC()
+	suite.NoError(suite.postgresAdapter.SaveExchangeRate(&model.ExchangeRate{Currency: model.COP, Rate: 4000, UpdatedAt: &updatedAt},
+		testAudit(model.EXCHANGE_RATE, model.UPDATE)))
+	suite.NoError(suite.postgresAdapter.SaveExchangeRate(&model.ExchangeRate{Currency: model.COP, Rate: 4100, UpdatedAt: &updatedAt},
+		testAudit(model.EXCHANGE_RATE, model.UPDATE)))
+	rates, err := suite.postgresAdapter.ListExchangeRates()
+	suite.NoError(err)
+	suite.Len(rates, 1)
+	suite.Equal(4100.0, rates[0].Rate)
+
+	inPesos, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Casa en pesos",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{Currency: model.COP, SalePrice: 410000000},
+		PropertyType: model.HOUSE,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         120,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(model.COP, inPesos.Pricing.Currency)
+	_, err = suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Casa en dólares",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{Currency: model.USD, SalePrice: 500000},
+		PropertyType: model.HOUSE,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         120,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+
+	minPrice, maxPrice := int64(90000), int64(110000)
+	paging, err := suite.postgresAdapter.FilterProperties(properties.PropertySearchParams{
+		Status:   "ACTIVE",
+		Page:     1,
+		PageSize: 10,
+		Currency: model.USD,
+		MinPrice: &minPrice,
+		MaxPrice: &maxPrice,
+		Rates:    model.ExchangeRates{model.USD: 1, model.COP: 4100},
+	})
+	suite.NoError(err)
+	suite.Len(paging.Data, 1)
+	suite.Equal(inPesos.ID, paging.Data[0].ID)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_PropertyAttributes() {
+	floor, totalFloors, yearBuilt := 7, 12, 2015
+	withAttributes, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Apartamento con piscina",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.APARTMENT,
+		Bedrooms:     2,
+		Bathrooms:    1,
+		Area:         60,
+		Status:       model.ACTIVE,
+		Amenities:    []model.Amenity{model.POOL, model.ELEVATOR},
+		Floor:        &floor,
+		TotalFloors:  &totalFloors,
+		YearBuilt:    &yearBuilt,
+		Condition:    model.GOOD,
+		Orientation:  model.NORTH,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal([]model.Amenity{model.POOL, model.ELEVATOR}, withAttributes.Amenities)
+	suite.Equal(&floor, withAttributes.Floor)
+	suite.Equal(model.GOOD, withAttributes.Condition)
+
+	withoutAttributes, err := suite.postgresAdapter.SaveProperty(&model.Property{
+		Title:        "Casa sin detalles",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{SalePrice: 450000000},
+		PropertyType: model.HOUSE,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         120,
+		Status:       model.ACTIVE,
+	}, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Nil(withoutAttributes.Amenities)
+	suite.Nil(withoutAttributes.Floor)
+	suite.Equal(model.PropertyCondition(""), withoutAttributes.Condition)
+
+	minFloor := 5
+	search := properties.PropertySearchParams{Status: "ACTIVE", Page: 1, PageSize: 10}
+	search.Amenities = []model.Amenity{model.POOL}
+	search.MinFloor = &minFloor
+	search.Orientation = model.NORTH
+	paging, err := suite.postgresAdapter.FilterProperties(search)
+	suite.NoError(err)
+	suite.Len(paging.Data, 1)
+	suite.Equal(withAttributes.ID, paging.Data[0].ID)
+
+	withAttributes.Amenities = nil
+	withAttributes.Condition = ""
+	withAttributes, err = suite.postgresAdapter.UpdateProperty(withAttributes, testAudit(model.PROPERTY, model.UPDATE))
+	suite.NoError(err)
+	suite.Nil(withAttributes.Amenities)
+	suite.Equal(model.PropertyCondition(""), withAttributes.Condition)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_PropertyMetrics() {
+	fee := model.Amount(300000)
+	cheap := &model.Property{
+		Title:        "Casa barata",
+		Location:     model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:      model.Pricing{Currency: model.COP, SalePrice: 200000000, AdministrativeFee: &fee},
+		PropertyType: model.HOUSE,
+		Bedrooms:     3,
+		Bathrooms:    2,
+		Area:         100,
+		Status:       model.ACTIVE,
+	}
+	cheap.UpdateMetrics()
+	cheap, err := suite.postgresAdapter.SaveProperty(cheap, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(model.Amount(2000000), *cheap.PricePerSquareMeter)
+	suite.Equal(fee, *cheap.MonthlyCost)
+
+	reason := "the price per square meter is out of the usual range"
+	expensive := &model.Property{
+		Title:         "Casa cara",
+		Location:      model.Location{Longitude: -94.0665887, Latitude: 4.6371593},
+		Pricing:       model.Pricing{Currency: model.COP, SalePrice: 900000000},
+		PropertyType:  model.HOUSE,
+		Bedrooms:      3,
+		Bathrooms:     2,
+		Area:          100,
+		Status:        model.ACTIVE,
+		SuspectReason: &reason,
+	}
+	expensive.UpdateMetrics()
+	expensive, err = suite.postgresAdapter.SaveProperty(expensive, testAudit(model.PROPERTY, model.CREATE))
+	suite.NoError(err)
+	suite.Equal(&reason, expensive.SuspectReason)
+	suite.Nil(expensive.MonthlyCost)
+
+	quartiles, err := suite.postgresAdapter.PricePerSquareMeterQuartiles(model.HOUSE, model.COP, expensive.ID)
+	suite.NoError(err)
+	suite.Equal(1, quartiles.Samples)
+	suite.Equal(2000000.0, quartiles.FirstQuartile)
+
+	search := properties.PropertySearchParams{Status: "ACTIVE", Page: 1, PageSize: 10, Currency: model.COP,
+		Rates: model.ExchangeRates{model.USD: 1, model.COP: 4000}, SortBy: properties.SortByPricePerSquareMeter, SortDescending: true}
+	paging, err := suite.postgresAdapter.FilterProperties(search)
+	suite.NoError(err)
+	suite.Len(paging.Data, 2)
+	suite.Equal(expensive.ID, paging.Data[0].ID)
+
+	maxPricePerSquareMeter := int64(5000000)
+	search.MaxPricePerSquareMeter = &maxPricePerSquareMeter
+	paging, err = suite.postgresAdapter.FilterProperties(search)
+	suite.NoError(err)
+	suite.Len(paging.Data, 1)
+	suite.Equal(cheap.ID, paging.Data[0].ID)
+}
+
+func (suite *PostgreSQLAdapterSuite) TestPostgreSQLAdapter_MarketStats() {
+	for _, property := range []*model.Property{
+		{Title: "Casa uno", Location: model.Location{Longitude: -99.1, Latitude: 19.4}, Pricing: model.Pricing{Currency: model.COP, SalePrice: 200000000},
+			PropertyType: model.HOUSE, Bedrooms: 3, Bathrooms: 2, Area: 100, Status: model.ACTIVE},
+		{Title: "Casa dos", Location: model.Location{Longitude: -99.1, Latitude: 19.4}, Pricing: model.Pricing{Currency: model.USD, SalePrice: 100000},
+			PropertyType: model.HOUSE, Bedrooms: 3, Bathrooms: 2, Area: 50, Status: model.ACTIVE},
+		{Title: "Apartamento", Location: model.Location{Longitude: -94.0665887, Latitude: 4.6371593}, Pricing: model.Pricing{Currency: model.COP, SalePrice: 300000000},
+			PropertyType: model.APARTMENT, Bedrooms: 10, Bathrooms: 2, Area: 150, Status: model.ACTIVE},
+	} {
+		property.UpdateMetrics()
+		_, err := suite.postgresAdapter.SaveProperty(property, testAudit(model.PROPERTY, model.CREATE))
+		suite.NoError(err)
+	}
+	search := properties.PropertySearchParams{Status: "ACTIVE", Currency: model.COP, Rates: model.ExchangeRates{model.USD: 1, model.COP: 4000}}
+	// the properties in both zones belong to the first one
+	zones := []model.MarketZone{
+		{Name: "Ciudad de México", Box: model.BoundingBox{MinLongitude: -99.29, MinLatitude: 19.29, MaxLongitude: -98.91, MaxLatitude: 19.66}},
+		{Name: "Valle de México", Box: model.BoundingBox{MinLongitude: -99.5, MinLatitude: 19, MaxLongitude: -98.5, MaxLatitude: 20}},
+		{Name: "Bogotá's north", Box: model.BoundingBox{MinLongitude: -74.1, MinLatitude: 4.6, MaxLongitude: -74, MaxLatitude: 4.8}},
+	}
+
+	groups, err := suite.postgresAdapter.MarketStats(search, model.MarketGrouping{By: model.GROUP_BY_ZONE, Zones: zones})
+	suite.NoError(err)
+	suite.Len(groups, 2)
+	suite.Equal("Ciudad de México", groups[0].Group)
+	suite.Equal(int64(2), groups[0].Count)
+	suite.Equal(3000000.0, groups[0].Price.Mean)
+	suite.Equal(3000000.0, groups[0].Price.Median)
+	suite.Equal(50000.0, groups[0].PricePerSquareMeter.Mean)
+	suite.Equal(75.0, groups[0].AverageArea)
+	suite.Equal(model.OTHER_ZONE, groups[1].Group)
+	suite.Equal(int64(1), groups[1].Count)
+
+	// the bedrooms are ordered as numbers
+	groups, err = suite.postgresAdapter.MarketStats(search, model.MarketGrouping{By: model.GROUP_BY_BEDROOMS})
+	suite.NoError(err)
+	suite.Len(groups, 2)
+	suite.Equal("3", groups[0].Group)
+	suite.Equal("10", groups[1].Group)
+
+	groups, err = suite.postgresAdapter.MarketStats(search, model.MarketGrouping{By: model.GROUP_BY_GEOHASH, GeohashPrecision: 5})
+	suite.NoError(err)
+	suite.Len(groups, 2)
+	suite.Equal("9bv47", groups[0].Group)
+	suite.Equal("9g3w2", groups[1].Group)
+
+	search.Operation = model.RENT
+	groups, err = suite.postgresAdapter.MarketStats(search, model.MarketGrouping{By: model.GROUP_BY_PROPERTY_TYPE})
+	suite.NoError(err)
+	suite.Empty(groups)
 }
@@ -0,0 +1,135 @@
+package adapter
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"lahaus/config"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const s3Timeout = 30 * time.Second
+
+// S3BlobStore writes the files to a bucket of an S3-compatible service, the requests are signed with AWS Signature Version 4
+type S3BlobStore struct {
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	publicURL string
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewS3BlobStore creates a new S3BlobStore
+func NewS3BlobStore(config *config.Photos) *S3BlobStore {
+	return &S3BlobStore{
+		endpoint:  strings.TrimRight(config.S3.Endpoint, "/"),
+		region:    config.S3.Region,
+		bucket:    config.S3.Bucket,
+		accessKey: config.S3.AccessKey,
+		secretKey: config.S3.SecretKey,
+		publicURL: strings.TrimRight(config.PublicURL, "/"),
+		client:    &http.Client{Timeout: s3Timeout},
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (store *S3BlobStore) Put(key string, contentType string, data []byte) error {
+	request, err := http.NewRequest(http.MethodPut, store.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", contentType)
+	return store.do(request, data)
+}
+
+func (store *S3BlobStore) Delete(key string) error {
+	request, err := http.NewRequest(http.MethodDelete, store.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	return store.do(request, nil)
+}
+
+// URL is under the public URL when it is configured, a CDN in front of the bucket, otherwise the object in the bucket
+func (store *S3BlobStore) URL(key string) string {
+	if store.publicURL != "" {
+		return store.publicURL + "/" + key
+	}
+	return store.objectURL(key)
+}
+
+func (store *S3BlobStore) objectURL(key string) string {
+	return store.endpoint + "/" + store.bucket + "/" + key
+}
+
+func (store *S3BlobStore) do(request *http.Request, payload []byte) error {
+	store.sign(request, payload, store.now())
+	response, err := store.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("the blob store responded %d to %s %s", response.StatusCode, request.Method, request.URL.Path)
+	}
+	return nil
+}
+
+// sign adds the Authorization header of AWS Signature Version 4, signing the host, the content type and the x-amz headers
+func (store *S3BlobStore) sign(request *http.Request, payload []byte, now time.Time) {
+	payloadHash := sha256Hex(payload)
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		name = strings.ToLower(name)
+		if name == "content-type" || strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{request.Method, request.URL.EscapedPath(), request.URL.RawQuery,
+		canonicalHeaders.String(), signedHeaders, payloadHash}, "\n")
+	scope := date + "/" + store.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := []byte("AWS4" + store.secretKey)
+	for _, part := range []string{date, store.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		store.accessKey, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,80 @@
+package adapter
+
+import (
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"lahaus/config"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type S3BlobStoreSuite struct {
+	suite.Suite
+}
+
+func TestS3BlobStoreSuite(t *testing.T) {
+	suite.Run(t, new(S3BlobStoreSuite))
+}
+
+func newTestS3BlobStore(endpoint, publicURL string) *S3BlobStore {
+	store := NewS3BlobStore(&config.Photos{PublicURL: publicURL, S3: &config.S3{
+		Endpoint:  endpoint,
+		Region:    "us-east-1",
+		Bucket:    "lahaus",
+		AccessKey: "AKIDEXAMPLE",
+		SecretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}})
+	store.now = func() time.Time { return time.Date(2024, 5, 2, 10, 30, 0, 0, time.UTC) }
+	return store
+}
+
+func (suite *S3BlobStoreSuite) TestS3BlobStore_PutSigned() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPut, r.Method)
+		suite.Equal("/lahaus/properties/1/abc/web.jpg", r.URL.Path)
+		suite.Equal("image/jpeg", r.Header.Get("Content-Type"))
+		suite.Equal("20240502T103000Z", r.Header.Get("X-Amz-Date"))
+		suite.Equal(sha256Hex([]byte("jpeg")), r.Header.Get("X-Amz-Content-Sha256"))
+		authorization := r.Header.Get("Authorization")
+		suite.True(strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20240502/us-east-1/s3/aws4_request, "+
+			"SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature="))
+		body, err := ioutil.ReadAll(r.Body)
+		suite.NoError(err)
+		suite.Equal("jpeg", string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := newTestS3BlobStore(server.URL+"/", "")
+	suite.NoError(store.Put("properties/1/abc/web.jpg", "image/jpeg", []byte("jpeg")))
+	suite.Equal(server.URL+"/lahaus/properties/1/abc/web.jpg", store.URL("properties/1/abc/web.jpg"))
+}
+
+func (suite *S3BlobStoreSuite) TestS3BlobStore_SignatureIsDeterministic() {
+	store := newTestS3BlobStore("https://s3.amazonaws.com", "")
+	first, _ := http.NewRequest(http.MethodDelete, store.objectURL("properties/1/abc/web.jpg"), nil)
+	second, _ := http.NewRequest(http.MethodDelete, store.objectURL("properties/1/abc/web.jpg"), nil)
+	store.sign(first, nil, store.now())
+	store.sign(second, nil, store.now())
+	suite.Equal(first.Header.Get("Authorization"), second.Header.Get("Authorization"))
+
+	store.secretKey = "another"
+	third, _ := http.NewRequest(http.MethodDelete, store.objectURL("properties/1/abc/web.jpg"), nil)
+	store.sign(third, nil, store.now())
+	suite.NotEqual(first.Header.Get("Authorization"), third.Header.Get("Authorization"))
+}
+
+func (suite *S3BlobStoreSuite) TestS3BlobStore_DeleteError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	store := newTestS3BlobStore(server.URL, "https://cdn.lahaus.com/")
+	suite.Error(store.Delete("properties/1/abc/web.jpg"))
+	suite.Equal("https://cdn.lahaus.com/properties/1/abc/web.jpg", store.URL("properties/1/abc/web.jpg"))
+}
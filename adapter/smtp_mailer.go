@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/logger"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPMailer delivers emails through a SMTP server
+type SMTPMailer struct {
+	from    string
+	address string
+	auth    smtp.Auth
+}
+
+// NewSMTPMailer creates a new SMTPMailer, it authenticates only when an user is configured
+func NewSMTPMailer(config *config.Mailer) *SMTPMailer {
+	var auth smtp.Auth
+	if config.User != "" {
+		auth = smtp.PlainAuth("", config.User, config.Password, config.Host)
+	}
+	return &SMTPMailer{
+		from:    config.From,
+		address: fmt.Sprintf("%s:%d", config.Host, config.Port),
+		auth:    auth,
+	}
+}
+
+func (mailer *SMTPMailer) Send(to, subject, body string) error {
+	message := buildMessage(mailer.from, to, subject, body)
+	err := smtp.SendMail(mailer.address, mailer.auth, mailer.from, []string{to}, []byte(message))
+	if err != nil {
+		logger.GetInstance().Error("error sending email", zap.Error(err), zap.String("address", mailer.address))
+		return err
+	}
+	return nil
+}
+
+func buildMessage(from, to, subject, body string) string {
+	headers := []string{
+		fmt.Sprintf("From: %s", from),
+		fmt.Sprintf("To: %s", to),
+		fmt.Sprintf("Subject: %s", subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=\"utf-8\"",
+	}
+	return strings.Join(headers, "\r\n") + "\r\n\r\n" + body + "\r\n"
+}
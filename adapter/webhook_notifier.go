@@ -0,0 +1,92 @@
+package adapter
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the webhook body when a secret is configured
+const SignatureHeader = "X-Lahaus-Signature"
+
+// EventHeader tells the webhook which notification the body holds
+const EventHeader = "X-Lahaus-Event"
+
+const (
+	searchAlertEvent = "search_alert"
+	priceDropEvent   = "price_drop"
+	reviewEvent      = "property_review"
+	expiryEvent      = "listing_expiry"
+)
+
+// WebhookNotifier posts the notifications as JSON to an url
+type WebhookNotifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a new WebhookNotifier
+func NewWebhookNotifier(config *config.Notifications) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    config.WebhookURL,
+		secret: config.WebhookSecret,
+		client: &http.Client{Timeout: time.Duration(config.WebhookTimeoutInSeconds) * time.Second},
+	}
+}
+
+func (notifier *WebhookNotifier) Notify(alert *model.SearchAlert) error {
+	return notifier.post(searchAlertEvent, alert)
+}
+
+func (notifier *WebhookNotifier) NotifyPriceDrop(alert *model.PriceDropAlert) error {
+	return notifier.post(priceDropEvent, alert)
+}
+
+func (notifier *WebhookNotifier) NotifyReview(alert *model.ReviewAlert) error {
+	return notifier.post(reviewEvent, alert)
+}
+
+func (notifier *WebhookNotifier) NotifyExpiry(alert *model.ExpiryAlert) error {
+	return notifier.post(expiryEvent, alert)
+}
+
+func (notifier *WebhookNotifier) post(event string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, notifier.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, event)
+	if notifier.secret != "" {
+		mac := hmac.New(sha256.New, []byte(notifier.secret))
+		mac.Write(body)
+		request.Header.Set(SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	response, err := notifier.client.Do(request)
+	if err != nil {
+		logger.GetInstance().Error("error calling notifications webhook", zap.Error(err), zap.String("event", event))
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("notifications webhook responded with status %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package adapter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type WebhookNotifierSuite struct {
+	suite.Suite
+}
+
+func TestWebhookNotifierSuite(t *testing.T) {
+	suite.Run(t, new(WebhookNotifierSuite))
+}
+
+func (suite *WebhookNotifierSuite) TestWebhookNotifier_NotifySigned() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		suite.NoError(err)
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(body)
+		suite.Equal(hex.EncodeToString(mac.Sum(nil)), r.Header.Get(SignatureHeader))
+		suite.Contains(string(body), `"email":"b@b.com"`)
+		suite.Equal("search_alert", r.Header.Get(EventHeader))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(&config.Notifications{WebhookURL: server.URL, WebhookSecret: "secret", WebhookTimeoutInSeconds: 1})
+	err := notifier.Notify(&model.SearchAlert{UserID: 1, Email: "b@b.com", SavedSearch: &model.SavedSearch{ID: 2, Name: "Polanco"},
+		Properties: []*model.Property{{ID: 3}}})
+	suite.NoError(err)
+}
+
+func (suite *WebhookNotifierSuite) TestWebhookNotifier_NotifyErrorStatus() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(&config.Notifications{WebhookURL: server.URL, WebhookTimeoutInSeconds: 1})
+	err := notifier.Notify(&model.SearchAlert{SavedSearch: &model.SavedSearch{ID: 2}})
+	suite.Error(err)
+}
+
+func (suite *WebhookNotifierSuite) TestWebhookNotifier_NotifyReview() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		suite.NoError(err)
+		suite.Equal("property_review", r.Header.Get(EventHeader))
+		suite.Contains(string(body), `"decision":"REJECT"`)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	reason := "duplicated"
+	notifier := NewWebhookNotifier(&config.Notifications{WebhookURL: server.URL, WebhookTimeoutInSeconds: 1})
+	err := notifier.NotifyReview(&model.ReviewAlert{UserID: 1, Email: "b@b.com", Property: &model.Property{ID: 3},
+		Review: &model.PropertyReview{PropertyID: 3, Decision: model.REJECT, Reason: &reason, Status: model.REJECTED}})
+	suite.NoError(err)
+}
+
+func (suite *WebhookNotifierSuite) TestWebhookNotifier_NotifyExpiry() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		suite.NoError(err)
+		suite.Equal("listing_expiry", r.Header.Get(EventHeader))
+		suite.Contains(string(body), `"expired":false`)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(&config.Notifications{WebhookURL: server.URL, WebhookTimeoutInSeconds: 1})
+	err := notifier.NotifyExpiry(&model.ExpiryAlert{UserID: 1, Email: "b@b.com", Property: &model.Property{ID: 3}})
+	suite.NoError(err)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -13,8 +14,20 @@ import (
 	"io/ioutil"
 	"lahaus/adapter"
 	"lahaus/config"
+	"lahaus/domain/model"
+	ucaudits "lahaus/domain/usecases/audits"
+	uccollections "lahaus/domain/usecases/collections"
+	uccurrencies "lahaus/domain/usecases/currencies"
+	ucexpiry "lahaus/domain/usecases/expiry"
+	ucidempotency "lahaus/domain/usecases/idempotency"
+	ucjobs "lahaus/domain/usecases/jobs"
+	ucmoderation "lahaus/domain/usecases/moderation"
+	ucphotos "lahaus/domain/usecases/photos"
+	ucpricedrops "lahaus/domain/usecases/pricedrops"
 	ucproperties "lahaus/domain/usecases/properties"
 	"lahaus/domain/usecases/ruler"
+	ucsearches "lahaus/domain/usecases/searches"
+	ucstats "lahaus/domain/usecases/stats"
 	ucusers "lahaus/domain/usecases/users"
 	"lahaus/infrastructure/api"
 	"lahaus/infrastructure/api/middlewares"
@@ -22,6 +35,7 @@ import (
 	"lahaus/logger"
 	"log"
 	"net/http"
+	"time"
 )
 
 var yamlPathFlag = flag.String("config", "./config.yml", "Specify the path of config.yml file, e.g.: -config /folder/config.yml")
@@ -51,46 +65,230 @@ func main() {
 		logger.GetInstance().Fatal("failed to run migrations", zap.Error(err))
 	}
 
+	mailer := newMailer(conf.SystemSettings.Mailer)
+	notifier := newNotifier(conf.SystemSettings.Notifications, mailer)
+
+	// Match the saved searches, deliver the alerts and notify the price drops in background
+	matchSavedSearchesUseCase := ucsearches.NewMatchSavedSearchesUseCase(databaseAdapter, notifier)
+	propertyMatcher := ucsearches.NewBackgroundMatcher(matchSavedSearchesUseCase, conf.SystemSettings.Notifications.QueueSize,
+		time.Duration(conf.SystemSettings.Notifications.QueueTimeoutInSeconds)*time.Second)
+	go propertyMatcher.Run(context.Background())
+	// Match again the properties the matcher queue dropped or a restart lost, only one replica at a time
+	if interval := conf.SystemSettings.SearchAlerts.SweepIntervalInMinutes; interval > 0 {
+		go ucjobs.NewScheduledJob("search-matches-sweep", time.Duration(interval)*time.Minute, databaseAdapter,
+			ucsearches.NewSweepSearchMatchesUseCase(conf.SystemSettings.SearchAlerts, databaseAdapter, matchSavedSearchesUseCase)).Run(context.Background())
+	}
+	// Deliver the due search alerts, only one replica at a time
+	if interval := conf.SystemSettings.SearchAlerts.DigestIntervalInMinutes; interval > 0 {
+		go ucjobs.NewScheduledJob("search-alerts", time.Duration(interval)*time.Minute, databaseAdapter,
+			ucsearches.NewDeliverDueSearchAlertsUseCase(ucsearches.NewDeliverSearchAlertsUseCase(databaseAdapter, notifier))).Run(context.Background())
+	}
+	priceWatcher := ucpricedrops.NewBackgroundWatcher(ucpricedrops.NewNotifyPriceDropUseCase(databaseAdapter, notifier),
+		conf.SystemSettings.Notifications.QueueSize)
+	go priceWatcher.Run(context.Background())
+	// Expire the listings and remind the owners, only one replica at a time
+	if interval := conf.SystemSettings.ListingExpiry.IntervalInMinutes; interval > 0 {
+		go ucjobs.NewScheduledJob("listing-expiry", time.Duration(interval)*time.Minute, databaseAdapter,
+			ucexpiry.NewExpireListingsUseCase(conf.SystemSettings.ListingExpiry, databaseAdapter, notifier)).Run(context.Background())
+	}
+
 	// Create the usecases
-	rulerUserCase := ruler.NewPropertyRulerUseCase(conf)
-	createPropertyUseCase := ucproperties.NewCreatePropertyUseCase(databaseAdapter, rulerUserCase)
-	updatePropertyUseCase := ucproperties.NewUpdatePropertyUseCase(databaseAdapter, rulerUserCase)
-	searchPropertiesUseCase := ucproperties.NewSearchPropertyUseCase(databaseAdapter)
-
-	signInUserExecutor := ucusers.NewSignInUserUseCase(databaseAdapter)
-	signUpUserExecutor := ucusers.NewSignUpUserUseCase(conf.SystemSettings.Security, databaseAdapter)
-	addFavouriteUserExecutor := ucusers.NewAddFavouriteUseCase(databaseAdapter)
+	exchangeRates := uccurrencies.NewExchangeRates(conf.BusinessRules.ExchangeRates, databaseAdapter)
+	rulerUserCase := ruler.NewPropertyRulerUseCase(conf, exchangeRates, databaseAdapter)
+	createPropertyUseCase := ucproperties.NewCreatePropertyUseCase(conf.SystemSettings.EmailVerification, conf.BusinessRules.DuplicateDetection,
+		conf.SystemSettings.Moderation, databaseAdapter, rulerUserCase, propertyMatcher)
+	updatePropertyUseCase := ucproperties.NewUpdatePropertyUseCase(conf.SystemSettings.Moderation, databaseAdapter, rulerUserCase, propertyMatcher, priceWatcher)
+	searchPropertiesUseCase := ucproperties.NewSearchPropertyUseCase(databaseAdapter, exchangeRates)
+	evaluatePropertyUseCase := ucproperties.NewEvaluatePropertyUseCase(conf.SystemSettings.Moderation, databaseAdapter, rulerUserCase)
+	priceHistoryUseCase := ucproperties.NewGetPriceHistoryUseCase(databaseAdapter)
+	getPropertyUseCase := ucproperties.NewGetPropertyUseCase(databaseAdapter)
+	renewPropertyUseCase := ucproperties.NewRenewPropertyUseCase(databaseAdapter, propertyMatcher)
+	idempotentRequestUseCase := ucidempotency.NewExecuteIdempotentRequestUseCase(conf.SystemSettings.Idempotency, databaseAdapter)
+
+	signInUserExecutor := ucusers.NewSignInUserUseCase(conf.SystemSettings.Security, conf.SystemSettings.EmailVerification, databaseAdapter, mailer)
+	signUpUserExecutor := ucusers.NewSignUpUserUseCase(conf.SystemSettings.Security, conf.SystemSettings.LoginProtection, databaseAdapter, newLoginAttemptStore(conf, databaseAdapter))
+	addFavouriteUserExecutor := ucusers.NewAddFavouriteUseCase(conf.SystemSettings.EmailVerification, databaseAdapter)
+	removeFavouriteUserExecutor := ucusers.NewRemoveFavouriteUseCase(databaseAdapter)
+	favouriteIDsUserExecutor := ucusers.NewFavouriteIDsUseCase(databaseAdapter)
 	listFavouriteUserExecutor := ucusers.NewListFavouriteUseCase(databaseAdapter)
+	// Look up the emails and send the reset and verification links in background, answering at once whether the email is registered or not
+	forgotPasswordExecutor := ucusers.NewBackgroundEmailRequest("password-reset",
+		ucusers.NewForgotPasswordUseCase(conf.SystemSettings.PasswordReset, databaseAdapter, mailer), conf.SystemSettings.Notifications.QueueSize)
+	go forgotPasswordExecutor.Run(context.Background())
+	resetPasswordExecutor := ucusers.NewResetPasswordUseCase(databaseAdapter)
+	verifyEmailExecutor := ucusers.NewVerifyEmailUseCase(conf.SystemSettings.Security, databaseAdapter)
+	resendVerificationExecutor := ucusers.NewBackgroundEmailRequest("resend-verification",
+		ucusers.NewResendVerificationUseCase(conf.SystemSettings.Security, conf.SystemSettings.EmailVerification, databaseAdapter, mailer),
+		conf.SystemSettings.Notifications.QueueSize)
+	go resendVerificationExecutor.Run(context.Background())
+	updateUserRoleExecutor := ucusers.NewUpdateUserRoleUseCase(databaseAdapter)
+
+	createCollectionExecutor := uccollections.NewCreateCollectionUseCase(conf.SystemSettings.EmailVerification, databaseAdapter)
+	listCollectionsExecutor := uccollections.NewListCollectionsUseCase(databaseAdapter)
+	getCollectionExecutor := uccollections.NewGetCollectionUseCase(databaseAdapter)
+	updateCollectionExecutor := uccollections.NewUpdateCollectionUseCase(databaseAdapter)
+	deleteCollectionExecutor := uccollections.NewDeleteCollectionUseCase(databaseAdapter)
+	listCollectionItemsExecutor := uccollections.NewListCollectionItemsUseCase(databaseAdapter)
+	saveCollectionItemExecutor := uccollections.NewSaveCollectionItemUseCase(conf.SystemSettings.EmailVerification, databaseAdapter)
+	removeCollectionItemExecutor := uccollections.NewRemoveCollectionItemUseCase(databaseAdapter)
+	transferCollectionItemExecutor := uccollections.NewTransferCollectionItemUseCase(databaseAdapter)
+
+	createShareLinkExecutor := uccollections.NewCreateShareLinkUseCase(databaseAdapter)
+	listShareLinksExecutor := uccollections.NewListShareLinksUseCase(databaseAdapter)
+	revokeShareLinkExecutor := uccollections.NewRevokeShareLinkUseCase(databaseAdapter)
+	getSharedCollectionExecutor := uccollections.NewGetSharedCollectionUseCase(databaseAdapter)
+
+	createSavedSearchExecutor := ucsearches.NewCreateSavedSearchUseCase(conf.SystemSettings.EmailVerification, conf.SystemSettings.SearchAlerts, databaseAdapter)
+	listSavedSearchesExecutor := ucsearches.NewListSavedSearchesUseCase(databaseAdapter)
+	getSavedSearchExecutor := ucsearches.NewGetSavedSearchUseCase(databaseAdapter)
+	updateSavedSearchExecutor := ucsearches.NewUpdateSavedSearchUseCase(databaseAdapter)
+	deleteSavedSearchExecutor := ucsearches.NewDeleteSavedSearchUseCase(databaseAdapter)
+
+	getPriceDropSettingsExecutor := ucpricedrops.NewGetPriceDropSettingsUseCase(databaseAdapter)
+	updatePriceDropSettingsExecutor := ucpricedrops.NewUpdatePriceDropSettingsUseCase(databaseAdapter)
+
+	listAuditEntriesExecutor := ucaudits.NewListAuditEntriesUseCase(databaseAdapter)
+
+	listExchangeRatesExecutor := uccurrencies.NewListExchangeRatesUseCase(exchangeRates)
+	updateExchangeRateExecutor := uccurrencies.NewUpdateExchangeRateUseCase(databaseAdapter, exchangeRates)
+
+	marketStatsExecutor := ucstats.NewMarketStatsUseCase(conf, databaseAdapter, exchangeRates)
+
+	listModerationQueueExecutor := ucmoderation.NewListModerationQueueUseCase(databaseAdapter)
+	reviewPropertyExecutor := ucmoderation.NewReviewPropertyUseCase(databaseAdapter, rulerUserCase, propertyMatcher, notifier)
+
+	blobStore := newBlobStore(conf.SystemSettings.Photos)
+	uploadPhotoExecutor := ucphotos.NewUploadPhotoUseCase(conf.SystemSettings.Photos, databaseAdapter, blobStore, updatePropertyUseCase)
+	listPhotosExecutor := ucphotos.NewListPhotosUseCase(databaseAdapter)
+	reorderPhotosExecutor := ucphotos.NewReorderPhotosUseCase(databaseAdapter, updatePropertyUseCase)
+	setCoverPhotoExecutor := ucphotos.NewSetCoverPhotoUseCase(databaseAdapter, updatePropertyUseCase)
+	deletePhotoExecutor := ucphotos.NewDeletePhotoUseCase(databaseAdapter, blobStore, updatePropertyUseCase)
 
 	// Create handlers
-	handlerProperties := api.NewPropertyHandler(createPropertyUseCase, updatePropertyUseCase, searchPropertiesUseCase)
-	handlerUser := api.NewUserHandler(signInUserExecutor, signUpUserExecutor, addFavouriteUserExecutor, listFavouriteUserExecutor)
+	handlerProperties := api.NewPropertyHandler(createPropertyUseCase, updatePropertyUseCase, searchPropertiesUseCase, evaluatePropertyUseCase, priceHistoryUseCase,
+		getPropertyUseCase, idempotentRequestUseCase, renewPropertyUseCase)
+	handlerUser := api.NewUserHandler(signInUserExecutor, signUpUserExecutor, addFavouriteUserExecutor,
+		removeFavouriteUserExecutor, favouriteIDsUserExecutor, listFavouriteUserExecutor,
+		forgotPasswordExecutor, resetPasswordExecutor, verifyEmailExecutor, resendVerificationExecutor)
+
+	handlerCollections := api.NewCollectionHandler(createCollectionExecutor, listCollectionsExecutor, getCollectionExecutor,
+		updateCollectionExecutor, deleteCollectionExecutor, listCollectionItemsExecutor, saveCollectionItemExecutor,
+		removeCollectionItemExecutor, transferCollectionItemExecutor)
+
+	handlerShareLinks := api.NewShareLinkHandler(createShareLinkExecutor, listShareLinksExecutor, revokeShareLinkExecutor, getSharedCollectionExecutor)
+
+	handlerSavedSearches := api.NewSavedSearchHandler(createSavedSearchExecutor, listSavedSearchesExecutor, getSavedSearchExecutor,
+		updateSavedSearchExecutor, deleteSavedSearchExecutor)
+
+	handlerPriceDrops := api.NewPriceDropHandler(getPriceDropSettingsExecutor, updatePriceDropSettingsExecutor)
+
+	handlerAudit := api.NewAuditHandler(listAuditEntriesExecutor)
+	handlerExchangeRates := api.NewExchangeRateHandler(listExchangeRatesExecutor, updateExchangeRateExecutor)
+	handlerUserRoles := api.NewUserRoleHandler(updateUserRoleExecutor)
+	handlerStats := api.NewStatsHandler(marketStatsExecutor)
+	handlerModeration := api.NewModerationHandler(listModerationQueueExecutor, reviewPropertyExecutor)
+	handlerPhotos := api.NewPhotoHandler(int64(conf.SystemSettings.Photos.MaxSizeInMB)*1024*1024, uploadPhotoExecutor, listPhotosExecutor,
+		reorderPhotosExecutor, setCoverPhotoExecutor, deletePhotoExecutor)
 
 	// Create web routing
 	router := chi.NewRouter()
 	router.Use(render.SetContentType(render.ContentTypeJSON))
-	router.Use(middleware.RequestID, middleware.NoCache, middleware.Logger, middleware.Recoverer)
+	router.Use(middleware.RequestID, middlewares.NoCache, middleware.Logger, middleware.Recoverer)
 
 	authenticationMiddleware := middlewares.NewAuthenticationMiddleware(conf.SystemSettings.Security)
+	authorizationMiddleware := middlewares.NewAuthorizationMiddleware()
 
 	router.Route("/v1", func(r chi.Router) {
 		r.Route("/properties", func(r chi.Router) {
-			r.Post("/", handlerProperties.CreateProperty)
-			r.Put("/{id}", handlerProperties.UpdateProperty)
-			r.Get("/", handlerProperties.SearchProperties)
+			r.With(authenticationMiddleware.Optional).Get("/", handlerProperties.SearchProperties)
+			r.With(authenticationMiddleware.Optional).Get("/{id}", handlerProperties.GetProperty)
+			r.With(authenticationMiddleware.Optional).Get("/{id}/price-history", handlerProperties.GetPriceHistory)
+			r.With(authenticationMiddleware.Optional).Get("/{id}/photos", handlerPhotos.ListPhotos)
+			r.Group(func(r chi.Router) {
+				r.Use(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.AGENT, model.ADMIN))
+				r.Post("/", handlerProperties.CreateProperty)
+				r.Put("/{id}", handlerProperties.UpdateProperty)
+				r.Patch("/{id}", handlerProperties.PatchProperty)
+				r.Post("/{id}/renew", handlerProperties.RenewProperty)
+				r.Post("/{id}/photos", handlerPhotos.UploadPhoto)
+				r.Put("/{id}/photos/order", handlerPhotos.ReorderPhotos)
+				r.Put("/{id}/photos/{photoId}/cover", handlerPhotos.SetCoverPhoto)
+				r.Delete("/{id}/photos/{photoId}", handlerPhotos.DeletePhoto)
+			})
+			r.Group(func(r chi.Router) {
+				r.Use(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.ADMIN))
+				r.Post("/{id}/evaluate", handlerProperties.EvaluateProperty)
+			})
 		})
 
 		r.Route("/users", func(r chi.Router) {
 			r.Post("/", handlerUser.SignInUser)
 			r.Post("/login", handlerUser.SignUpUser)
+			r.Post("/password/forgot", handlerUser.ForgotPassword)
+			r.Post("/password/reset", handlerUser.ResetPassword)
+			r.Get("/verify", handlerUser.VerifyEmail)
+			r.Post("/verify/resend", handlerUser.ResendVerification)
+			r.With(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.AGENT, model.ADMIN)).
+				Get("/me/properties", handlerProperties.ListOwnProperties)
 			r.Route("/me/favourites", func(r chi.Router) {
-				r.Use(authenticationMiddleware.Execute)
+				r.Use(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.BUYER))
 				r.Post("/", handlerUser.AddFavourite)
 				r.Get("/", handlerUser.ListFavourites)
+				r.Get("/ids", handlerUser.FavouriteIDs)
+				r.Delete("/{propertyId}", handlerUser.RemoveFavourite)
+				r.Post("/shares", handlerShareLinks.CreateFavouritesShareLink)
 			})
+			r.Route("/me/collections", func(r chi.Router) {
+				r.Use(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.BUYER))
+				r.Post("/", handlerCollections.CreateCollection)
+				r.Get("/", handlerCollections.ListCollections)
+				r.Get("/{collectionId}", handlerCollections.GetCollection)
+				r.Put("/{collectionId}", handlerCollections.UpdateCollection)
+				r.Delete("/{collectionId}", handlerCollections.DeleteCollection)
+				r.Get("/{collectionId}/items", handlerCollections.ListCollectionItems)
+				r.Post("/{collectionId}/items", handlerCollections.AddCollectionItem)
+				r.Put("/{collectionId}/items/{propertyId}", handlerCollections.UpdateCollectionItem)
+				r.Delete("/{collectionId}/items/{propertyId}", handlerCollections.RemoveCollectionItem)
+				r.Post("/{collectionId}/items/{propertyId}/move", handlerCollections.MoveCollectionItem)
+				r.Post("/{collectionId}/items/{propertyId}/copy", handlerCollections.CopyCollectionItem)
+				r.Post("/{collectionId}/shares", handlerShareLinks.CreateShareLink)
+				r.Get("/{collectionId}/shares", handlerShareLinks.ListShareLinks)
+				r.Delete("/{collectionId}/shares/{shareId}", handlerShareLinks.RevokeShareLink)
+			})
+			r.Route("/me/searches", func(r chi.Router) {
+				r.Use(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.BUYER))
+				r.Post("/", handlerSavedSearches.CreateSavedSearch)
+				r.Get("/", handlerSavedSearches.ListSavedSearches)
+				r.Get("/{searchId}", handlerSavedSearches.GetSavedSearch)
+				r.Put("/{searchId}", handlerSavedSearches.UpdateSavedSearch)
+				r.Delete("/{searchId}", handlerSavedSearches.DeleteSavedSearch)
+			})
+			r.Route("/me/notifications/price-drops", func(r chi.Router) {
+				r.Use(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.BUYER))
+				r.Get("/", handlerPriceDrops.GetPriceDropSettings)
+				r.Put("/", handlerPriceDrops.UpdatePriceDropSettings)
+			})
+		})
+
+		r.Get("/shared/{token}", handlerShareLinks.GetSharedCollection)
+		r.Get("/exchange-rates", handlerExchangeRates.ListExchangeRates)
+		r.With(authenticationMiddleware.Optional).Get("/stats/market", handlerStats.MarketStats)
+
+		r.Route("/admin", func(r chi.Router) {
+			r.Use(authenticationMiddleware.Execute, authorizationMiddleware.Allow(model.ADMIN))
+			r.Get("/audit", handlerAudit.ListAuditEntries)
+			r.Get("/moderation", handlerModeration.ListModerationQueue)
+			r.Post("/moderation/{id}/approve", handlerModeration.ApproveProperty)
+			r.Post("/moderation/{id}/reject", handlerModeration.RejectProperty)
+			r.Put("/exchange-rates/{currency}", handlerExchangeRates.UpdateExchangeRate)
+			r.Put("/users/{id}/role", handlerUserRoles.UpdateUserRole)
 		})
 	})
 
+	if localStore, ok := blobStore.(*adapter.LocalBlobStore); ok {
+		router.Handle(localStore.Path()+"/*", localStore.Handler())
+	}
+
 	log.Fatal(http.ListenAndServe(":8080", router))
 
 }
@@ -112,8 +310,48 @@ func configure(path string) (*config.Config, error) {
 	return conf, err
 }
 
+func newMailer(conf *config.Mailer) ucusers.Mailer {
+	if conf.Type == "smtp" {
+		return adapter.NewSMTPMailer(conf)
+	}
+	return adapter.NewLogMailer(conf)
+}
+
+func newBlobStore(conf *config.Photos) ucphotos.BlobStore {
+	if conf.Store == "s3" {
+		return adapter.NewS3BlobStore(conf)
+	}
+	return adapter.NewLocalBlobStore(conf)
+}
+
 func setLoggingLevel(level string) {
 	l := zap.InfoLevel
 	_ = l.Set(level)
 	logger.GetAtomLevel().SetLevel(l)
 }
+
+func newLoginAttemptStore(conf *config.Config, databaseAdapter *adapter.PostgreSQLAdapter) ucusers.LoginAttemptStore {
+	if conf.SystemSettings.LoginProtection.Store == "postgres" {
+		return databaseAdapter
+	}
+	return adapter.NewMemoryLoginAttemptStore()
+}
+
+// alertNotifier delivers the search alerts, the price drops, the reviews and the listing expiries
+type alertNotifier interface {
+	ucsearches.Notifier
+	ucpricedrops.Notifier
+	ucmoderation.Notifier
+	ucexpiry.Notifier
+}
+
+func newNotifier(conf *config.Notifications, mailer ucusers.Mailer) alertNotifier {
+	switch conf.Notifier {
+	case "email":
+		return adapter.NewEmailNotifier(mailer)
+	case "webhook":
+		return adapter.NewWebhookNotifier(conf)
+	default:
+		return adapter.NewLogNotifier()
+	}
+}
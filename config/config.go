@@ -8,9 +8,20 @@ type Security struct {
 
 // SystemSettings represents the configuration of the app
 type SystemSettings struct {
-	Storage  *Storage
-	Security *Security
-	Logger   *Logger
+	Storage           *Storage
+	Security          *Security
+	Logger            *Logger
+	Mailer            *Mailer
+	PasswordReset     *PasswordReset
+	EmailVerification *EmailVerification
+	LoginProtection   *LoginProtection
+	Notifications     *Notifications
+	SearchAlerts      *SearchAlerts
+	Idempotency       *Idempotency
+	Moderation        *Moderation
+	ListingExpiry     *ListingExpiry
+	Photos            *Photos
+	MarketStats       *MarketStats
 }
 
 // Storage represents the storage used by the app
@@ -32,6 +43,112 @@ type Logger struct {
 	Level string
 }
 
+// Mailer represents the mail delivery, Type is "smtp" or "log"
+type Mailer struct {
+	Type     string
+	From     string
+	Host     string
+	Port     int
+	User     string
+	Password string
+	File     string
+}
+
+// EmailVerification represents the email verification of the new users
+type EmailVerification struct {
+	Required               bool
+	TokenDurationInMinutes int
+	VerifyURL              string
+}
+
+// LoginProtection represents the brute-force protection of the login, Store is "memory" or "postgres"
+type LoginProtection struct {
+	Store                  string
+	MaxFailuresPerEmail    int
+	MaxFailuresPerIP       int
+	BaseLockoutInSeconds   int
+	MaxLockoutInMinutes    int
+	FailureWindowInMinutes int
+}
+
+// Notifications represents the delivery of the search alerts and price drops
+type Notifications struct {
+	Notifier                string
+	WebhookURL              string
+	WebhookSecret           string
+	WebhookTimeoutInSeconds int
+	QueueSize               int
+	QueueTimeoutInSeconds   int
+}
+
+// SearchAlerts represents the alerts of the saved searches
+type SearchAlerts struct {
+	MaxSavedSearches        int
+	DigestIntervalInMinutes int
+	SweepIntervalInMinutes  int
+	SweepWindowInMinutes    int
+}
+
+// Moderation represents the review of the listings
+type Moderation struct {
+	Required bool
+}
+
+// ListingExpiry represents the lifetime of the ACTIVE listings by property type
+type ListingExpiry struct {
+	HouseLifetimeInDays     int
+	ApartmentLifetimeInDays int
+	ReminderDaysBefore      int
+	IntervalInMinutes       int
+}
+
+// Photos represents the uploaded photos of the properties, Store is "local" or "s3"
+type Photos struct {
+	Store          string
+	MaxSizeInMB    int
+	MaxPerProperty int
+	WebWidth       int
+	ThumbnailWidth int
+	LocalDir       string
+	PublicURL      string
+	S3             *S3
+}
+
+// S3 represents an S3-compatible bucket
+type S3 struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// MarketStats represents the market statistics
+type MarketStats struct {
+	CacheTTLInMinutes int
+	MaxCachedReports  int
+	GeohashPrecision  int
+	Zones             []MarketZone
+}
+
+// MarketZone represents a named box of the zone grouping of the market statistics
+type MarketZone struct {
+	Name      string
+	Longitude BetweenFloat
+	Latitude  BetweenFloat
+}
+
+// Idempotency represents the Idempotency-Key of the property creation
+type Idempotency struct {
+	KeyDurationInMinutes int
+}
+
+// PasswordReset represents the password reset flow
+type PasswordReset struct {
+	TokenDurationInMinutes int
+	ResetURL               string
+}
+
 type BetweenInt struct {
 	LowerBound int
 	UpperBound int
@@ -42,18 +159,56 @@ type BetweenFloat struct {
 	UpperBound float64
 }
 
+// PropertyTypeValidator represents the rules of a property type
 type PropertyTypeValidator struct {
 	Bedrooms     *BetweenInt
 	Bathrooms    *BetweenInt
 	Area         *BetweenInt
 	ParkingSpots int
+	Floor        *BetweenInt
+	TotalFloors  *BetweenInt
+	YearBuilt    *BetweenInt
+	Amenities    []string
 }
 
+// BundleValidator represents the price ranges inside and outside the bundle box
 type BundleValidator struct {
-	Longitude BetweenFloat
-	Latitude  BetweenFloat
-	PriceIn   BetweenInt
-	PriceOut  BetweenInt
+	Longitude   BetweenFloat
+	Latitude    BetweenFloat
+	PriceIn     BetweenInt
+	PriceOut    BetweenInt
+	RentIn      BetweenInt
+	RentOut     BetweenInt
+	CurrencyIn  string
+	CurrencyOut string
+}
+
+// ExchangeRates represents the rates of the currencies against a common reference currency
+type ExchangeRates struct {
+	Rates                    map[string]float64
+	RefreshIntervalInMinutes int
+}
+
+// DuplicateDetection represents the search of duplicates of the new properties
+type DuplicateDetection struct {
+	Action               string
+	RadiusInMeters       float64
+	AreaTolerancePercent int
+	MinTitleSimilarity   float64
+}
+
+// PhotoPolicy represents the rules of the photo URLs of the properties
+type PhotoPolicy struct {
+	AllowedHosts   []string
+	HTTPSOnly      bool
+	MaxCount       int
+	MinActiveCount int
+}
+
+// PriceOutliers represents the check of the price per square meter against the similar ACTIVE properties
+type PriceOutliers struct {
+	IQRMultiplier float64
+	MinSamples    int
 }
 
 // BusinessRules represents the business rules
@@ -61,6 +216,10 @@ type BusinessRules struct {
 	HouseValidator     *PropertyTypeValidator
 	ApartmentValidator *PropertyTypeValidator
 	BundleValidator    *BundleValidator
+	DuplicateDetection *DuplicateDetection
+	PhotoPolicy        *PhotoPolicy
+	ExchangeRates      *ExchangeRates
+	PriceOutliers      *PriceOutliers
 }
 
 // Config represents the configuration of system
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+type AuditEntity string
+
+const (
+	PROPERTY      AuditEntity = "property"
+	USER          AuditEntity = "user"
+	EXCHANGE_RATE AuditEntity = "exchange_rate"
+)
+
+type AuditAction string
+
+const (
+	CREATE           AuditAction = "CREATE"
+	UPDATE           AuditAction = "UPDATE"
+	STATUS_CHANGE    AuditAction = "STATUS_CHANGE"
+	FAVOURITE_ADD    AuditAction = "FAVOURITE_ADD"
+	FAVOURITE_REMOVE AuditAction = "FAVOURITE_REMOVE"
+	LOGIN            AuditAction = "LOGIN"
+	LOGIN_LOCKOUT    AuditAction = "LOGIN_LOCKOUT"
+	RENEW            AuditAction = "RENEW"
+)
+
+// auditIgnoredFields are left out of the changes, the storage sets them on every write and the metrics follow the pricing
+var auditIgnoredFields = map[string]bool{"createdAt": true, "updatedAt": true, "listedAt": true, "pricePerSquareMeter": true, "monthlyCost": true}
+
+// Actor is who performs a mutation, taken from the JWT claims, and the request that performed it
+type Actor struct {
+	UserID    int64
+	Role      Role
+	RequestID string
+}
+
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditEntry is an append-only record of a mutation, it is written in the same transaction as the mutation
+type AuditEntry struct {
+	ID        int64                  `json:"id"`
+	Entity    AuditEntity            `json:"entity"`
+	EntityID  int64                  `json:"entityId"`
+	Action    AuditAction            `json:"action"`
+	ActorID   *int64                 `json:"actorId"`
+	ActorRole Role                   `json:"actorRole,omitempty"`
+	RequestID string                 `json:"requestId,omitempty"`
+	Changes   map[string]AuditChange `json:"changes"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+type AuditPaging struct {
+	Page       int64         `json:"page"`
+	PageSize   int64         `json:"pageSize"`
+	TotalPages int64         `json:"totalPages"`
+	Total      int64         `json:"total"`
+	Data       []*AuditEntry `json:"data"`
+}
+
+// NewAuditEntry creates the entry of a mutation done by the actor, the storage sets the entity id of the created entities
+func NewAuditEntry(entity AuditEntity, entityID int64, action AuditAction, actor *Actor, changes map[string]AuditChange) *AuditEntry {
+	entry := &AuditEntry{
+		Entity:   entity,
+		EntityID: entityID,
+		Action:   action,
+		Changes:  changes,
+	}
+	if entry.Changes == nil {
+		entry.Changes = map[string]AuditChange{}
+	}
+	if actor != nil {
+		if actor.UserID != 0 {
+			actorID := actor.UserID
+			entry.ActorID = &actorID
+		}
+		entry.ActorRole = actor.Role
+		entry.RequestID = actor.RequestID
+	}
+	return entry
+}
+
+// AuditChanges compares the JSON fields of before and after and returns the ones that differ, before is nil for created entities
+func AuditChanges(before, after interface{}) (map[string]AuditChange, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]AuditChange{}
+	for name, value := range afterFields {
+		if !reflect.DeepEqual(beforeFields[name], value) {
+			changes[name] = AuditChange{Before: beforeFields[name], After: value}
+		}
+	}
+	for name, value := range beforeFields {
+		if _, found := afterFields[name]; !found {
+			changes[name] = AuditChange{Before: value}
+		}
+	}
+	return changes, nil
+}
+
+func auditFields(value interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if value == nil || (reflect.ValueOf(value).Kind() == reflect.Ptr && reflect.ValueOf(value).IsNil()) {
+		return fields, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name := range auditIgnoredFields {
+		delete(fields, name)
+	}
+	return fields, nil
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// DefaultCollectionName is the name of the collection every user has, it backs the favourites
+const DefaultCollectionName = "Favourites"
+
+type Collection struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"-"`
+	Name       string    `json:"name"`
+	IsDefault  bool      `json:"isDefault"`
+	ItemsCount int64     `json:"itemsCount"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type CollectionItem struct {
+	Property *Property `json:"property"`
+	Note     *string   `json:"note,omitempty"`
+	SavedAt  time.Time `json:"savedAt"`
+}
+
+type CollectionItemsPaging struct {
+	Page       int64             `json:"page"`
+	PageSize   int64             `json:"pageSize"`
+	TotalPages int64             `json:"totalPages"`
+	Total      int64             `json:"total"`
+	Data       []*CollectionItem `json:"data"`
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+const (
+	COP Currency = "COP"
+	MXN Currency = "MXN"
+	USD Currency = "USD"
+)
+
+// MinorUnitsPerUnit is the minor units of a unit of the currencies, the supported ones have two decimals
+const MinorUnitsPerUnit = 100
+
+// maxAmountUnits bounds the amounts so their minor units are exact in a float64
+const maxAmountUnits = 1e13
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Amount is an amount in minor units, it is written in JSON in units of its currency, like 4500.5
+type Amount int64
+
+func (amount Amount) MarshalJSON() ([]byte, error) {
+	sign := ""
+	value := int64(amount)
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	units, minor := value/MinorUnitsPerUnit, value%MinorUnitsPerUnit
+	if minor == 0 {
+		return []byte(fmt.Sprintf("%s%d", sign, units)), nil
+	}
+	return []byte(strings.TrimRight(fmt.Sprintf("%s%d.%02d", sign, units, minor), "0")), nil
+}
+
+func (amount *Amount) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseAmount(string(data))
+	if err != nil {
+		return err
+	}
+	*amount = parsed
+	return nil
+}
+
+// ParseAmount reads an amount written in units of its currency with at most two decimals
+func ParseAmount(value string) (Amount, error) {
+	units, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.Abs(units) >= maxAmountUnits {
+		return 0, fmt.Errorf("invalid amount [%s]", value)
+	}
+	minor := math.Round(units * MinorUnitsPerUnit)
+	if math.Abs(units*MinorUnitsPerUnit-minor) > 1e-6 {
+		return 0, fmt.Errorf("the amount [%s] has more than two decimals", value)
+	}
+	return Amount(minor), nil
+}
+
+// IsValid reports whether the currency is written as an ISO 4217 code, it does not mean there is a rate for it
+func (currency Currency) IsValid() bool {
+	return currencyCode.MatchString(string(currency))
+}
+
+// FormatAmount writes an amount in minor units with its currency, like 4500.50 USD
+func FormatAmount(amount Amount, currency Currency) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s%d.%02d %s", sign, amount/MinorUnitsPerUnit, amount%MinorUnitsPerUnit, currency))
+}
+
+// ExchangeRate is how many units of the currency buy one unit of the reference currency
+type ExchangeRate struct {
+	Currency  Currency   `json:"currency"`
+	Rate      float64    `json:"rate"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ExchangeRates are the rates by currency, all of them against the same reference currency
+type ExchangeRates map[Currency]float64
+
+// Convert changes an amount in minor units from one currency to the other, rounding to the closest minor unit
+func (rates ExchangeRates) Convert(amount Amount, from, to Currency) (Amount, error) {
+	if from == to {
+		return amount, nil
+	}
+	fromRate, found := rates[from]
+	if !found || fromRate <= 0 {
+		return 0, fmt.Errorf("there is no exchange rate for the currency %q", from)
+	}
+	toRate, found := rates[to]
+	if !found || toRate <= 0 {
+		return 0, fmt.Errorf("there is no exchange rate for the currency %q", to)
+	}
+	return Amount(math.Round(float64(amount) * toRate / fromRate)), nil
+}
+
+// ConvertPricing changes all the amounts of the pricing to the currency
+func (rates ExchangeRates) ConvertPricing(pricing Pricing, to Currency) (*Pricing, error) {
+	converted := &Pricing{Currency: to}
+	var err error
+	converted.SalePrice, err = rates.Convert(pricing.SalePrice, pricing.Currency, to)
+	if err != nil {
+		return nil, err
+	}
+	if pricing.AdministrativeFee != nil {
+		fee, err := rates.Convert(*pricing.AdministrativeFee, pricing.Currency, to)
+		if err != nil {
+			return nil, err
+		}
+		converted.AdministrativeFee = &fee
+	}
+	if pricing.Rent != nil {
+		rent := *pricing.Rent
+		rent.MonthlyRent, err = rates.Convert(pricing.Rent.MonthlyRent, pricing.Currency, to)
+		if err != nil {
+			return nil, err
+		}
+		converted.Rent = &rent
+	}
+	return converted, nil
+}
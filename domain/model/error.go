@@ -73,3 +73,157 @@ func NewUnauthorizedError(err error) *UnauthorizedError {
 		Details:     err.Error(),
 	}
 }
+
+type ForbiddenError struct {
+	Code        int64  `json:"code"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+}
+
+func (d *ForbiddenError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewForbiddenError(err error) *ForbiddenError {
+	return &ForbiddenError{
+		Code:        40,
+		Description: "Forbidden",
+		Details:     err.Error(),
+	}
+}
+
+type TooManyRequestsError struct {
+	Code        int64  `json:"code"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+	RetryAfter  int64  `json:"retryAfter"`
+}
+
+func (d *TooManyRequestsError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewTooManyRequestsError(err error, retryAfterInSeconds int64) *TooManyRequestsError {
+	return &TooManyRequestsError{
+		Code:        50,
+		Description: "Too many requests",
+		Details:     err.Error(),
+		RetryAfter:  retryAfterInSeconds,
+	}
+}
+
+// PreconditionFailedError is returned when the entity changed after the version the client read
+type PreconditionFailedError struct {
+	Code        int64  `json:"code"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+}
+
+func (d *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewPreconditionFailedError(err error) *PreconditionFailedError {
+	return &PreconditionFailedError{
+		Code:        60,
+		Description: "Precondition failed",
+		Details:     err.Error(),
+	}
+}
+
+// UnprocessableEntityError is returned when a request conflicts with a previous one
+type UnprocessableEntityError struct {
+	Code        int64  `json:"code"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+}
+
+func (d *UnprocessableEntityError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewUnprocessableEntityError(err error) *UnprocessableEntityError {
+	return &UnprocessableEntityError{
+		Code:        80,
+		Description: "Unprocessable entity",
+		Details:     err.Error(),
+	}
+}
+
+// DuplicatePropertyError is returned when a new property looks like the Candidates already listed
+type DuplicatePropertyError struct {
+	Code        int64       `json:"code"`
+	Description string      `json:"description"`
+	Details     string      `json:"details"`
+	Candidates  []*Property `json:"candidates"`
+}
+
+func (d *DuplicatePropertyError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewDuplicatePropertyError(err error, candidates []*Property) *DuplicatePropertyError {
+	return &DuplicatePropertyError{
+		Code:        90,
+		Description: "Duplicate property",
+		Details:     err.Error(),
+		Candidates:  candidates,
+	}
+}
+
+// PreconditionRequiredError is returned when a conditional request does not send the version it read
+type PreconditionRequiredError struct {
+	Code        int64  `json:"code"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+}
+
+func (d *PreconditionRequiredError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewPreconditionRequiredError(err error) *PreconditionRequiredError {
+	return &PreconditionRequiredError{
+		Code:        70,
+		Description: "Precondition required",
+		Details:     err.Error(),
+	}
+}
+
+// UnsupportedMediaTypeError is returned when an upload is not of an accepted content type
+type UnsupportedMediaTypeError struct {
+	Code        int64  `json:"code"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+}
+
+func (d *UnsupportedMediaTypeError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewUnsupportedMediaTypeError(err error) *UnsupportedMediaTypeError {
+	return &UnsupportedMediaTypeError{
+		Code:        100,
+		Description: "Unsupported media type",
+		Details:     err.Error(),
+	}
+}
+
+// PayloadTooLargeError is returned when an upload exceeds the maximum size
+type PayloadTooLargeError struct {
+	Code        int64  `json:"code"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+}
+
+func (d *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("code: %d, description: %s, details: %s", d.Code, d.Description, d.Details)
+}
+
+func NewPayloadTooLargeError(err error) *PayloadTooLargeError {
+	return &PayloadTooLargeError{
+		Code:        110,
+		Description: "Payload too large",
+		Details:     err.Error(),
+	}
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// ExpiryAlert tells the owner that the listing is about to expire, or that it expired when Expired is set
+type ExpiryAlert struct {
+	UserID    int64     `json:"userId"`
+	Email     string    `json:"email"`
+	Property  *Property `json:"property"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Expired   bool      `json:"expired"`
+}
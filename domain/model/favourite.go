@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Favourite is a property of the default collection with the price it had when it was saved
+type Favourite struct {
+	*Property
+	SavedAt      time.Time `json:"savedAt"`
+	SavedPrice   *Amount   `json:"savedPrice,omitempty"`
+	PriceChanged bool      `json:"priceChanged"`
+}
+
+type FavouritesPaging struct {
+	Page       int64        `json:"page"`
+	PageSize   int64        `json:"pageSize"`
+	TotalPages int64        `json:"totalPages"`
+	Total      int64        `json:"total"`
+	Data       []*Favourite `json:"data"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// IdempotentRequest is a request sent with an Idempotency-Key header, the key is scoped to the user
+type IdempotentRequest struct {
+	UserID int64
+	Key    string
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// StoredResponse is the response replayed to the requests that repeat an idempotency key
+type StoredResponse struct {
+	StatusCode int
+	Header     map[string]string
+	Body       []byte
+}
+
+type IdempotencyKey struct {
+	UserID      int64
+	Key         string
+	RequestHash string
+	Response    StoredResponse
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,69 @@
+package model
+
+import "time"
+
+// MarketGroupBy is how the market statistics are grouped
+type MarketGroupBy string
+
+const (
+	// GROUP_BY_ZONE groups the properties by the configured zone they are in
+	GROUP_BY_ZONE          MarketGroupBy = "zone"
+	GROUP_BY_PROPERTY_TYPE MarketGroupBy = "propertyType"
+	GROUP_BY_BEDROOMS      MarketGroupBy = "bedrooms"
+	GROUP_BY_GEOHASH       MarketGroupBy = "geohash"
+)
+
+// OTHER_ZONE is the zone group of the properties outside of every configured zone
+const OTHER_ZONE = "OTHER"
+
+// MinGeohashPrecision and MaxGeohashPrecision bound the length of the geohashes of the groups
+const (
+	MinGeohashPrecision = 1
+	MaxGeohashPrecision = 12
+)
+
+func (groupBy MarketGroupBy) IsValid() bool {
+	switch groupBy {
+	case GROUP_BY_ZONE, GROUP_BY_PROPERTY_TYPE, GROUP_BY_BEDROOMS, GROUP_BY_GEOHASH:
+		return true
+	}
+	return false
+}
+
+// MarketZone is a named area of the zone grouping, a property in several zones belongs to the first one
+type MarketZone struct {
+	Name string
+	Box  BoundingBox
+}
+
+// MarketGrouping is the grouping of the statistics, Zones are used by the zone grouping and GeohashPrecision by the geohash one
+type MarketGrouping struct {
+	By               MarketGroupBy
+	Zones            []MarketZone
+	GeohashPrecision int
+}
+
+// Distribution summarizes the amounts of a group, it is nil when none of its properties has the amount
+type Distribution struct {
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	P10    float64 `json:"p10"`
+	P90    float64 `json:"p90"`
+}
+
+// MarketStats are the statistics of a group of properties, the amounts are in units of the currency of the report
+type MarketStats struct {
+	Group               string        `json:"group"`
+	Count               int64         `json:"count"`
+	Price               *Distribution `json:"price,omitempty"`
+	PricePerSquareMeter *Distribution `json:"pricePerSquareMeter,omitempty"`
+	AverageArea         float64       `json:"averageArea"`
+}
+
+// MarketReport holds the statistics of every group, GeneratedAt tells how old a cached report is
+type MarketReport struct {
+	GroupBy     MarketGroupBy  `json:"groupBy"`
+	Currency    Currency       `json:"currency"`
+	Groups      []*MarketStats `json:"groups"`
+	GeneratedAt time.Time      `json:"generatedAt"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+type ReviewDecision string
+
+const (
+	APPROVE ReviewDecision = "APPROVE"
+	REJECT  ReviewDecision = "REJECT"
+)
+
+// PropertyReview is the decision of a moderator over a property waiting for review, Status is the one the property moved to
+type PropertyReview struct {
+	ID         int64          `json:"id"`
+	PropertyID int64          `json:"propertyId"`
+	ReviewerID int64          `json:"reviewerId"`
+	Decision   ReviewDecision `json:"decision"`
+	Reason     *string        `json:"reason,omitempty"`
+	Status     PropertyStatus `json:"status"`
+	CreatedAt  time.Time      `json:"createdAt"`
+}
+
+// ReviewAlert tells the owner about the review of the property
+type ReviewAlert struct {
+	UserID   int64           `json:"userId"`
+	Email    string          `json:"email"`
+	Property *Property       `json:"property"`
+	Review   *PropertyReview `json:"review"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Photo is a photo uploaded to a property with the keys of its files in the blob store
+type Photo struct {
+	ID           int64     `json:"id"`
+	PropertyID   int64     `json:"propertyId"`
+	Position     int       `json:"position"`
+	Cover        bool      `json:"cover"`
+	ContentType  string    `json:"contentType"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnailUrl"`
+	OriginalURL  string    `json:"originalUrl"`
+	Keys         []string  `json:"-"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// PriceChange is a change of the sale price of a property, ID is its entry in the price history
+type PriceChange struct {
+	ID            int64     `json:"id"`
+	PropertyID    int64     `json:"propertyId"`
+	PreviousPrice Amount    `json:"previousPrice"`
+	NewPrice      Amount    `json:"newPrice"`
+	ChangedAt     time.Time `json:"changedAt"`
+}
+
+// PriceDropSettings are the price drop preferences of a user, users without settings are notified of every drop
+type PriceDropSettings struct {
+	UserID         int64 `json:"-"`
+	Enabled        bool  `json:"enabled"`
+	MinDropPercent int   `json:"minDropPercent"`
+}
+
+// PriceDropWatcher is a user that saved the property in one of the collections
+type PriceDropWatcher struct {
+	PriceDropSettings
+	Email string
+}
+
+type PriceDropAlert struct {
+	UserID        int64     `json:"userId"`
+	Email         string    `json:"email"`
+	Property      *Property `json:"property"`
+	PreviousPrice Amount    `json:"previousPrice"`
+	NewPrice      Amount    `json:"newPrice"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// PriceHistoryEntry is a change of the sale price or the administrative fee of a property
+type PriceHistoryEntry struct {
+	ID         int64     `json:"id"`
+	PropertyID int64     `json:"propertyId"`
+	Previous   Pricing   `json:"previous"`
+	Current    Pricing   `json:"current"`
+	ChangedAt  time.Time `json:"changedAt"`
+}
@@ -0,0 +1,8 @@
+package model
+
+// PriceQuartiles are the quartiles of the price per square meter of the properties compared
+type PriceQuartiles struct {
+	FirstQuartile float64
+	ThirdQuartile float64
+	Samples       int
+}
@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type PropertyStatus string
 
@@ -8,8 +11,64 @@ const (
 	ACTIVE   PropertyStatus = "ACTIVE"
 	INACTIVE PropertyStatus = "INACTIVE"
 	INVALID  PropertyStatus = "INVALID"
+	// DUPLICATE_SUSPECT is a new property that looks like another one, it waits for moderation
+	DUPLICATE_SUSPECT PropertyStatus = "DUPLICATE_SUSPECT"
+	// PENDING_REVIEW is a new or materially edited property waiting for moderation
+	PENDING_REVIEW PropertyStatus = "PENDING_REVIEW"
+	// REJECTED is a property rejected by a moderator, it goes back to review when the owner edits it
+	REJECTED PropertyStatus = "REJECTED"
+	// EXPIRED is a listing that outlived the lifetime of its type, the owner renews it to list it again
+	EXPIRED PropertyStatus = "EXPIRED"
 )
 
+// statusTransitions are the statuses each status can move to
+var statusTransitions = map[PropertyStatus][]PropertyStatus{
+	"":                {ACTIVE, INACTIVE, INVALID, DUPLICATE_SUSPECT, PENDING_REVIEW},
+	ACTIVE:            {INACTIVE, INVALID, PENDING_REVIEW, EXPIRED},
+	INACTIVE:          {ACTIVE, INVALID, PENDING_REVIEW},
+	INVALID:           {ACTIVE, INACTIVE, PENDING_REVIEW},
+	PENDING_REVIEW:    {ACTIVE, INACTIVE, INVALID, REJECTED},
+	DUPLICATE_SUSPECT: {ACTIVE, INACTIVE, INVALID, REJECTED},
+	REJECTED:          {PENDING_REVIEW},
+	EXPIRED:           {ACTIVE, INVALID, PENDING_REVIEW},
+}
+
+// Hidden reports whether the properties with the status are left out of the public listings
+func (status PropertyStatus) Hidden() bool {
+	switch status {
+	case INVALID, DUPLICATE_SUSPECT, PENDING_REVIEW, REJECTED, EXPIRED:
+		return true
+	}
+	return false
+}
+
+// AwaitingReview reports whether the properties with the status are in the moderation queue
+func (status PropertyStatus) AwaitingReview() bool {
+	return status == PENDING_REVIEW || status == DUPLICATE_SUSPECT
+}
+
+// CanTransitionTo reports whether the state machine lets the status move to the next one
+func (status PropertyStatus) CanTransitionTo(next PropertyStatus) bool {
+	if status == next {
+		return true
+	}
+	for _, allowed := range statusTransitions[status] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo moves the property to the next status, it returns a domain error when the state machine does not allow it
+func (property *Property) TransitionTo(next PropertyStatus) error {
+	if !property.Status.CanTransitionTo(next) {
+		return NewDomainError(fmt.Errorf("the property cannot move from %s to %s", property.Status, next))
+	}
+	property.Status = next
+	return nil
+}
+
 type PropertyType string
 
 const (
@@ -17,35 +76,120 @@ const (
 	APARTMENT PropertyType = "APARTMENT"
 )
 
+// OperationType is how the property is offered, SALE_AND_RENT offers it both ways
+type OperationType string
+
+const (
+	SALE          OperationType = "SALE"
+	RENT          OperationType = "RENT"
+	SALE_AND_RENT OperationType = "SALE_AND_RENT"
+)
+
+// OperationTypes are the operation types in the order they are listed
+var OperationTypes = []OperationType{SALE, RENT, SALE_AND_RENT}
+
+// Offers reports whether the properties with the operation type can be found by the operation
+func (operation OperationType) Offers(wanted OperationType) bool {
+	if operation == "" {
+		operation = SALE
+	}
+	return operation == wanted || (operation == SALE_AND_RENT && (wanted == SALE || wanted == RENT))
+}
+
 type Photos []string
 
 type ParkingSpots *int
-type AdministrativeFee *int
+type AdministrativeFee *Amount
 type Description *string
 
 type Property struct {
-	ID           int64          `json:"id"`
-	Title        string         `json:"title"`
-	Description  Description    `json:"description,omitempty"`
-	Location     Location       `json:"location"`
-	Pricing      Pricing        `json:"pricing"`
-	PropertyType PropertyType   `json:"propertyType"`
-	Bedrooms     int            `json:"bedrooms"`
-	Bathrooms    int            `json:"bathrooms"`
-	ParkingSpots ParkingSpots   `json:"parkingSpots,omitempty"`
-	Area         int            `json:"area"`
-	Photos       Photos         `json:"photos,omitempty"`
-	CreatedAt    time.Time      `json:"createdAt"`
-	UpdatedAt    time.Time      `json:"updatedAt"`
-	Status       PropertyStatus `json:"status"`
+	ID           int64        `json:"id"`
+	Title        string       `json:"title"`
+	Description  Description  `json:"description,omitempty"`
+	Location     Location     `json:"location"`
+	Pricing      Pricing      `json:"pricing"`
+	PropertyType PropertyType `json:"propertyType"`
+	// OperationType says which prices apply, the sale price for SALE and the rent for RENT
+	OperationType OperationType `json:"operationType"`
+	Bedrooms      int           `json:"bedrooms"`
+	Bathrooms     int           `json:"bathrooms"`
+	ParkingSpots  ParkingSpots  `json:"parkingSpots,omitempty"`
+	Area          int           `json:"area"`
+	Photos        Photos        `json:"photos,omitempty"`
+	Amenities     []Amenity     `json:"amenities,omitempty"`
+	// Floor is the floor the property is on, TotalFloors the floors of its building or of the house
+	Floor       *int              `json:"floor,omitempty"`
+	TotalFloors *int              `json:"totalFloors,omitempty"`
+	YearBuilt   *int              `json:"yearBuilt,omitempty"`
+	Condition   PropertyCondition `json:"condition,omitempty"`
+	Orientation Orientation       `json:"orientation,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+	Status      PropertyStatus    `json:"status"`
+	OwnerID     int64             `json:"ownerId,omitempty"`
+	// InvalidReason is the rule the property broke when the rules last made it INVALID
+	InvalidReason *string `json:"invalidReason,omitempty"`
+	// SuspectReason is why the rules last flagged the property as suspicious, it does not change the status
+	SuspectReason *string `json:"suspectReason,omitempty"`
+	// PricePerSquareMeter and MonthlyCost are derived from the pricing and the area by UpdateMetrics, in minor units of the currency
+	PricePerSquareMeter *Amount `json:"pricePerSquareMeter,omitempty"`
+	MonthlyCost         *Amount `json:"monthlyCost,omitempty"`
+	// ListedAt is when the listing last became ACTIVE or was renewed, it expires after the lifetime of its type
+	ListedAt time.Time `json:"listedAt"`
+	// Version increments on every write, it is sent in the ETag header
+	Version int64 `json:"-"`
+	// PriceChangedAt and PreviousPrice summarize the last change of the sale price, they are only filled in the search results
+	PriceChangedAt *time.Time `json:"priceChangedAt,omitempty"`
+	PreviousPrice  *Amount    `json:"previousPrice,omitempty"`
+	// PriceChangeID is the price history entry recorded by the update, 0 when the pricing did not change
+	PriceChangeID int64 `json:"-"`
+	// ConvertedPricing is the pricing in the currency asked in the search, it is only filled in the search results
+	ConvertedPricing *Pricing `json:"convertedPricing,omitempty"`
+}
+
+// UpdateMetrics derives the price per square meter and the monthly cost, nil when they do not apply
+func (property *Property) UpdateMetrics() {
+	property.PricePerSquareMeter = nil
+	if property.OperationType.Offers(SALE) && property.Area > 0 && property.Pricing.SalePrice > 0 {
+		area := Amount(property.Area)
+		pricePerSquareMeter := (property.Pricing.SalePrice + area/2) / area
+		property.PricePerSquareMeter = &pricePerSquareMeter
+	}
+
+	property.MonthlyCost = nil
+	var monthlyCost Amount
+	hasCost := false
+	if property.OperationType.Offers(RENT) && property.Pricing.Rent != nil {
+		monthlyCost += property.Pricing.Rent.MonthlyRent
+		hasCost = true
+	}
+	if property.Pricing.AdministrativeFee != nil {
+		monthlyCost += *property.Pricing.AdministrativeFee
+		hasCost = true
+	}
+	if hasCost {
+		property.MonthlyCost = &monthlyCost
+	}
 }
+
 type Location struct {
 	Longitude float64 `json:"longitude"`
 	Latitude  float64 `json:"latitude"`
 }
+
+// Pricing holds the amounts in minor units of its currency, the cents of a dollar or the centavos of a peso
 type Pricing struct {
-	SalePrice         int               `json:"salePrice"`
+	Currency          Currency          `json:"currency,omitempty"`
+	SalePrice         Amount            `json:"salePrice"`
 	AdministrativeFee AdministrativeFee `json:"administrativeFee,omitempty"`
+	Rent              *RentPricing      `json:"rent,omitempty"`
+}
+
+// RentPricing is the price of the properties offered for rent, the deposit is a number of monthly rents
+type RentPricing struct {
+	MonthlyRent       Amount `json:"monthlyRent"`
+	DepositMonths     int    `json:"depositMonths"`
+	MinContractMonths int    `json:"minContractMonths"`
 }
 
 type PropertiesPaging struct {
@@ -0,0 +1,88 @@
+package model
+
+// Amenity is a feature of the property or of its building
+type Amenity string
+
+const (
+	POOL         Amenity = "POOL"
+	GYM          Amenity = "GYM"
+	ELEVATOR     Amenity = "ELEVATOR"
+	DOORMAN      Amenity = "DOORMAN"
+	PET_FRIENDLY Amenity = "PET_FRIENDLY"
+	FURNISHED    Amenity = "FURNISHED"
+)
+
+// Amenities are the amenities in the order they are listed
+var Amenities = []Amenity{POOL, GYM, ELEVATOR, DOORMAN, PET_FRIENDLY, FURNISHED}
+
+func (amenity Amenity) IsValid() bool {
+	for _, known := range Amenities {
+		if amenity == known {
+			return true
+		}
+	}
+	return false
+}
+
+// PropertyCondition is the state of conservation of the property
+type PropertyCondition string
+
+const (
+	NEW              PropertyCondition = "NEW"
+	REFURBISHED      PropertyCondition = "REFURBISHED"
+	GOOD             PropertyCondition = "GOOD"
+	NEEDS_RENOVATION PropertyCondition = "NEEDS_RENOVATION"
+)
+
+var PropertyConditions = []PropertyCondition{NEW, REFURBISHED, GOOD, NEEDS_RENOVATION}
+
+func (condition PropertyCondition) IsValid() bool {
+	for _, known := range PropertyConditions {
+		if condition == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Orientation is where the main facade of the property faces
+type Orientation string
+
+const (
+	NORTH     Orientation = "NORTH"
+	NORTHEAST Orientation = "NORTHEAST"
+	EAST      Orientation = "EAST"
+	SOUTHEAST Orientation = "SOUTHEAST"
+	SOUTH     Orientation = "SOUTH"
+	SOUTHWEST Orientation = "SOUTHWEST"
+	WEST      Orientation = "WEST"
+	NORTHWEST Orientation = "NORTHWEST"
+)
+
+var Orientations = []Orientation{NORTH, NORTHEAST, EAST, SOUTHEAST, SOUTH, SOUTHWEST, WEST, NORTHWEST}
+
+func (orientation Orientation) IsValid() bool {
+	for _, known := range Orientations {
+		if orientation == known {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAmenities reports whether the property has all the amenities
+func (property *Property) HasAmenities(amenities []Amenity) bool {
+	for _, wanted := range amenities {
+		found := false
+		for _, amenity := range property.Amenities {
+			if amenity == wanted {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
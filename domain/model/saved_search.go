@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type AlertFrequency string
+
+const (
+	INSTANT AlertFrequency = "INSTANT"
+	DAILY   AlertFrequency = "DAILY"
+	WEEKLY  AlertFrequency = "WEEKLY"
+)
+
+type SavedSearch struct {
+	ID            int64          `json:"id"`
+	UserID        int64          `json:"-"`
+	Name          string         `json:"name"`
+	Filters       SearchFilters  `json:"filters"`
+	Frequency     AlertFrequency `json:"frequency"`
+	LastAlertedAt *time.Time     `json:"lastAlertedAt,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+}
+
+// SearchAlert holds the new properties that matched a saved search since the last alert
+type SearchAlert struct {
+	UserID      int64        `json:"userId"`
+	Email       string       `json:"email"`
+	SavedSearch *SavedSearch `json:"savedSearch"`
+	Properties  []*Property  `json:"properties"`
+}
@@ -0,0 +1,28 @@
+package model
+
+// MinPolygonVertices and MaxPolygonVertices bound the size of the polygon filter
+const (
+	MinPolygonVertices = 3
+	MaxPolygonVertices = 100
+)
+
+// SearchFilters are the filters of the property search, also stored with the saved searches
+type SearchFilters struct {
+	Bbox         *BoundingBox      `json:"bbox,omitempty"`
+	Polygon      []Location        `json:"polygon,omitempty"`
+	Operation    OperationType     `json:"operation,omitempty"`
+	Amenities    []Amenity         `json:"amenities,omitempty"`
+	MinFloor     *int              `json:"minFloor,omitempty"`
+	MaxFloor     *int              `json:"maxFloor,omitempty"`
+	MinYearBuilt *int              `json:"minYearBuilt,omitempty"`
+	MaxYearBuilt *int              `json:"maxYearBuilt,omitempty"`
+	Condition    PropertyCondition `json:"condition,omitempty"`
+	Orientation  Orientation       `json:"orientation,omitempty"`
+}
+
+type BoundingBox struct {
+	MinLongitude float64 `json:"minLongitude"`
+	MinLatitude  float64 `json:"minLatitude"`
+	MaxLongitude float64 `json:"maxLongitude"`
+	MaxLatitude  float64 `json:"maxLatitude"`
+}
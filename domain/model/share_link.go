@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// ShareLink gives read only access to a collection without an account, only the hash of the token is stored
+type ShareLink struct {
+	ID           int64      `json:"id"`
+	CollectionID int64      `json:"collectionId"`
+	Token        string     `json:"token,omitempty"`
+	TokenHash    string     `json:"-"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt    *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
@@ -1,7 +1,19 @@
 package model
 
+import "time"
+
+type Role string
+
+const (
+	BUYER Role = "BUYER"
+	AGENT Role = "AGENT"
+	ADMIN Role = "ADMIN"
+)
+
 type User struct {
-	ID       int64  `json:"id"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	ID              int64      `json:"id"`
+	Email           string     `json:"email"`
+	Password        string     `json:"password"`
+	Role            Role       `json:"role"`
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
 }
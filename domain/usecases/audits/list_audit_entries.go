@@ -0,0 +1,46 @@
+package audits
+
+import (
+	"fmt"
+	"lahaus/domain/model"
+	"math"
+)
+
+//go:generate mockgen -destination=./mocks/mock_audit.go -package=mocks -source=./list_audit_entries.go
+
+type StorageManager interface {
+	ListAuditEntries(search AuditSearchParams) (*model.AuditPaging, error)
+}
+
+type AuditSearchParams struct {
+	Entity model.AuditEntity
+	// EntityID is nil to list the entries of every entity of the type
+	EntityID *int64
+	Page     int64
+	PageSize int64
+}
+
+type ListAuditEntriesUseCase struct {
+	database StorageManager
+}
+
+func NewListAuditEntriesUseCase(database StorageManager) *ListAuditEntriesUseCase {
+	return &ListAuditEntriesUseCase{
+		database: database,
+	}
+}
+
+// Execute lists the audit entries of the entity, newest first
+func (uc *ListAuditEntriesUseCase) Execute(search AuditSearchParams) (*model.AuditPaging, error) {
+	switch search.Entity {
+	case model.PROPERTY, model.USER, model.EXCHANGE_RATE:
+	default:
+		return nil, model.NewDomainError(fmt.Errorf("invalid entity [%v]", search.Entity))
+	}
+	results, err := uc.database.ListAuditEntries(search)
+	if err != nil {
+		return nil, err
+	}
+	results.TotalPages = int64(math.Ceil(float64(results.Total) / float64(results.PageSize)))
+	return results, nil
+}
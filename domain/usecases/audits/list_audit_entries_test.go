@@ -0,0 +1,51 @@
+package audits_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/audits"
+	"lahaus/domain/usecases/audits/mocks"
+	"testing"
+)
+
+type ListAuditEntriesSuite struct {
+	suite.Suite
+	mockCtrl    *gomock.Controller
+	database    *mocks.MockStorageManager
+	listUseCase *audits.ListAuditEntriesUseCase
+}
+
+func TestListAuditEntriesSuite(t *testing.T) {
+	suite.Run(t, new(ListAuditEntriesSuite))
+}
+
+func (suite *ListAuditEntriesSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.listUseCase = audits.NewListAuditEntriesUseCase(suite.database)
+}
+
+func (suite *ListAuditEntriesSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ListAuditEntriesSuite) TestListAuditEntriesUseCase_ExecuteSuccess() {
+	search := audits.AuditSearchParams{Entity: model.PROPERTY, Page: 1, PageSize: 10}
+	suite.database.EXPECT().ListAuditEntries(search).Return(&model.AuditPaging{Page: 1, PageSize: 10, Total: 11}, nil)
+	result, err := suite.listUseCase.Execute(search)
+	suite.NoError(err)
+	suite.Equal(int64(2), result.TotalPages)
+}
+
+func (suite *ListAuditEntriesSuite) TestListAuditEntriesUseCase_ExecuteError_InvalidEntity() {
+	_, err := suite.listUseCase.Execute(audits.AuditSearchParams{Entity: "collection", Page: 1, PageSize: 10})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ListAuditEntriesSuite) TestListAuditEntriesUseCase_ExecuteError() {
+	suite.database.EXPECT().ListAuditEntries(gomock.Any()).Return(nil, errors.New("fail"))
+	_, err := suite.listUseCase.Execute(audits.AuditSearchParams{Entity: model.USER, Page: 1, PageSize: 10})
+	suite.Error(err)
+}
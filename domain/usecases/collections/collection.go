@@ -0,0 +1,56 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/domain/model"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxCollectionNameLength = 100
+const maxNoteLength = 1000
+
+// getOwnedCollection returns not found when the collection belongs to another user, collections are private
+func getOwnedCollection(database StorageManager, userID, collectionID int64) (*model.Collection, error) {
+	collection, found, err := database.GetCollection(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if !found || collection.UserID != userID {
+		return nil, model.NewEntityNotFoundError(errors.New("collection not found"))
+	}
+	return collection, nil
+}
+
+// validateCollectionName returns the trimmed name, collectionID is the collection being renamed or 0 when creating
+func validateCollectionName(database StorageManager, userID, collectionID int64, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", model.NewDomainError(errors.New("name field is a must"))
+	}
+	if utf8.RuneCountInString(name) > maxCollectionNameLength {
+		return "", model.NewDomainError(fmt.Errorf("name can not have more than %d characters", maxCollectionNameLength))
+	}
+	if strings.EqualFold(name, model.DefaultCollectionName) {
+		return "", model.NewDomainError(fmt.Errorf("the name %s is reserved", model.DefaultCollectionName))
+	}
+
+	collections, err := database.ListCollections(userID)
+	if err != nil {
+		return "", err
+	}
+	for _, collection := range collections {
+		if collection.ID != collectionID && strings.EqualFold(collection.Name, name) {
+			return "", model.NewDomainError(errors.New("a collection with the same name already exists"))
+		}
+	}
+	return name, nil
+}
+
+func validateNote(note *string) error {
+	if note != nil && utf8.RuneCountInString(*note) > maxNoteLength {
+		return model.NewDomainError(fmt.Errorf("note can not have more than %d characters", maxNoteLength))
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/config"
+	"lahaus/domain/model"
+)
+
+//go:generate mockgen -destination=./mocks/mock_collection.go -package=mocks -source=./create_collection.go
+
+type StorageManager interface {
+	SaveCollection(collection *model.Collection) (*model.Collection, error)
+	UpdateCollection(collection *model.Collection) (*model.Collection, error)
+	DeleteCollection(collectionID int64) error
+	GetCollection(collectionID int64) (*model.Collection, bool, error)
+	GetDefaultCollection(userID int64) (*model.Collection, bool, error)
+	ListCollections(userID int64) ([]*model.Collection, error)
+	SaveCollectionItem(collectionID, propertyID int64, note *string) (bool, error)
+	RemoveCollectionItem(collectionID, propertyID int64) (bool, error)
+	TransferCollectionItem(fromCollectionID, toCollectionID, propertyID int64, keepSource bool) (bool, error)
+	ListCollectionItems(search CollectionItemsSearchParams) (*model.CollectionItemsPaging, error)
+	SaveShareLink(link *model.ShareLink) (*model.ShareLink, error)
+	GetShareLink(tokenHash string) (*model.ShareLink, bool, error)
+	ListShareLinks(collectionID int64) ([]*model.ShareLink, error)
+	RevokeShareLink(collectionID, shareLinkID int64) (bool, error)
+	GetProperty(propertyID int64) (*model.Property, bool, error)
+	GetUserByID(userID int64) (*model.User, bool, error)
+}
+
+type CreateCollectionUseCase struct {
+	database     StorageManager
+	verification *config.EmailVerification
+}
+
+func NewCreateCollectionUseCase(verification *config.EmailVerification, database StorageManager) *CreateCollectionUseCase {
+	return &CreateCollectionUseCase{
+		database:     database,
+		verification: verification,
+	}
+}
+
+func (uc *CreateCollectionUseCase) Execute(userID int64, name string) (*model.Collection, error) {
+	if uc.verification.Required {
+		if err := checkEmailVerified(uc.database, userID); err != nil {
+			return nil, err
+		}
+	}
+	name, err := validateCollectionName(uc.database, userID, 0, name)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := uc.database.SaveCollection(&model.Collection{UserID: userID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func checkEmailVerified(database StorageManager, userID int64) error {
+	user, found, err := database.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewUnauthorizedError(errors.New("user not found"))
+	}
+	if user.EmailVerifiedAt == nil {
+		return model.NewForbiddenError(errors.New("the email must be verified"))
+	}
+	return nil
+}
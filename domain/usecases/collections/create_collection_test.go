@@ -0,0 +1,93 @@
+package collections_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/domain/usecases/collections/mocks"
+	"strings"
+	"testing"
+	"time"
+)
+
+type CreateCollectionSuite struct {
+	suite.Suite
+	mockCtrl                *gomock.Controller
+	database                *mocks.MockStorageManager
+	createCollectionUseCase *collections.CreateCollectionUseCase
+}
+
+func TestCreateCollectionSuite(t *testing.T) {
+	suite.Run(t, new(CreateCollectionSuite))
+}
+
+func (suite *CreateCollectionSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.createCollectionUseCase = collections.NewCreateCollectionUseCase(&config.EmailVerification{}, suite.database)
+}
+
+func (suite *CreateCollectionSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().ListCollections(int64(1)).Return([]*model.Collection{
+		{ID: 1, UserID: 1, Name: model.DefaultCollectionName, IsDefault: true},
+	}, nil)
+	suite.database.EXPECT().SaveCollection(&model.Collection{UserID: 1, Name: "Polanco options"}).
+		Return(&model.Collection{ID: 2, UserID: 1, Name: "Polanco options"}, nil)
+	collection, err := suite.createCollectionUseCase.Execute(1, "  Polanco options ")
+	suite.NoError(err)
+	suite.Equal(int64(2), collection.ID)
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteError_EmptyName() {
+	_, err := suite.createCollectionUseCase.Execute(1, "  ")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteError_NameTooLong() {
+	_, err := suite.createCollectionUseCase.Execute(1, strings.Repeat("a", 101))
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteError_ReservedName() {
+	_, err := suite.createCollectionUseCase.Execute(1, "favourites")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteError_DuplicatedName() {
+	suite.database.EXPECT().ListCollections(int64(1)).Return([]*model.Collection{
+		{ID: 2, UserID: 1, Name: "For parents"},
+	}, nil)
+	_, err := suite.createCollectionUseCase.Execute(1, "for PARENTS")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteError_Save() {
+	suite.database.EXPECT().ListCollections(int64(1)).Return(nil, nil)
+	suite.database.EXPECT().SaveCollection(gomock.Any()).Return(nil, errors.New("fail"))
+	_, err := suite.createCollectionUseCase.Execute(1, "Polanco options")
+	suite.Error(err)
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteError_EmailNotVerified() {
+	createCollectionUseCase := collections.NewCreateCollectionUseCase(&config.EmailVerification{Required: true}, suite.database)
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1}, true, nil)
+	_, err := createCollectionUseCase.Execute(1, "Polanco options")
+	suite.IsType(&model.ForbiddenError{}, err)
+}
+
+func (suite *CreateCollectionSuite) TestCreateCollectionUseCase_ExecuteSuccess_EmailVerified() {
+	createCollectionUseCase := collections.NewCreateCollectionUseCase(&config.EmailVerification{Required: true}, suite.database)
+	verifiedAt := time.Now()
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, EmailVerifiedAt: &verifiedAt}, true, nil)
+	suite.database.EXPECT().ListCollections(int64(1)).Return(nil, nil)
+	suite.database.EXPECT().SaveCollection(gomock.Any()).Return(&model.Collection{ID: 2, UserID: 1, Name: "Polanco options"}, nil)
+	_, err := createCollectionUseCase.Execute(1, "Polanco options")
+	suite.NoError(err)
+}
@@ -0,0 +1,62 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/internal/token"
+	"time"
+)
+
+type CreateShareLinkUseCase struct {
+	database StorageManager
+}
+
+func NewCreateShareLinkUseCase(database StorageManager) *CreateShareLinkUseCase {
+	return &CreateShareLinkUseCase{
+		database: database,
+	}
+}
+
+// Execute creates a share link for the collection, collectionID 0 shares the favourites
+func (uc *CreateShareLinkUseCase) Execute(userID, collectionID int64, expiresAt *time.Time) (*model.ShareLink, error) {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, model.NewDomainError(errors.New("expiresAt must be in the future"))
+	}
+
+	var collection *model.Collection
+	var err error
+	if collectionID == 0 {
+		var found bool
+		collection, found, err = uc.database.GetDefaultCollection(userID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, model.NewEntityNotFoundError(errors.New("collection not found"))
+		}
+	} else {
+		collection, err = getOwnedCollection(uc.database, userID, collectionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	shareToken, err := token.Generate()
+	if err != nil {
+		return nil, err
+	}
+	link := &model.ShareLink{
+		CollectionID: collection.ID,
+		TokenHash:    token.Hash(shareToken),
+	}
+	if expiresAt != nil {
+		expiresAtUTC := expiresAt.UTC()
+		link.ExpiresAt = &expiresAtUTC
+	}
+	link, err = uc.database.SaveShareLink(link)
+	if err != nil {
+		return nil, err
+	}
+	link.Token = shareToken
+	return link, nil
+}
@@ -0,0 +1,138 @@
+package collections_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/domain/usecases/collections/mocks"
+	"testing"
+	"time"
+)
+
+type ShareLinkSuite struct {
+	suite.Suite
+	mockCtrl                   *gomock.Controller
+	database                   *mocks.MockStorageManager
+	createShareLinkUseCase     *collections.CreateShareLinkUseCase
+	revokeShareLinkUseCase     *collections.RevokeShareLinkUseCase
+	listShareLinksUseCase      *collections.ListShareLinksUseCase
+	getSharedCollectionUseCase *collections.GetSharedCollectionUseCase
+}
+
+func TestShareLinkSuite(t *testing.T) {
+	suite.Run(t, new(ShareLinkSuite))
+}
+
+func (suite *ShareLinkSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.createShareLinkUseCase = collections.NewCreateShareLinkUseCase(suite.database)
+	suite.revokeShareLinkUseCase = collections.NewRevokeShareLinkUseCase(suite.database)
+	suite.listShareLinksUseCase = collections.NewListShareLinksUseCase(suite.database)
+	suite.getSharedCollectionUseCase = collections.NewGetSharedCollectionUseCase(suite.database)
+}
+
+func (suite *ShareLinkSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+// createToken creates a share link and returns the token handed to the user together with the stored link
+func (suite *ShareLinkSuite) createToken(expiresAt *time.Time) (string, *model.ShareLink) {
+	var stored *model.ShareLink
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().SaveShareLink(gomock.Any()).DoAndReturn(func(link *model.ShareLink) (*model.ShareLink, error) {
+		stored = &model.ShareLink{ID: 5, CollectionID: link.CollectionID, TokenHash: link.TokenHash, ExpiresAt: link.ExpiresAt}
+		return &model.ShareLink{ID: 5, CollectionID: link.CollectionID, TokenHash: link.TokenHash, ExpiresAt: link.ExpiresAt}, nil
+	})
+	link, err := suite.createShareLinkUseCase.Execute(1, 2, expiresAt)
+	suite.Require().NoError(err)
+	return link.Token, stored
+}
+
+func (suite *ShareLinkSuite) TestCreateShareLinkUseCase_ExecuteSuccess() {
+	token, stored := suite.createToken(nil)
+	suite.NotEmpty(token)
+	suite.NotEqual(token, stored.TokenHash)
+	suite.Equal(int64(2), stored.CollectionID)
+	suite.Nil(stored.ExpiresAt)
+}
+
+func (suite *ShareLinkSuite) TestCreateShareLinkUseCase_ExecuteSuccess_Favourites() {
+	suite.database.EXPECT().GetDefaultCollection(int64(1)).Return(&model.Collection{ID: 1, UserID: 1, IsDefault: true}, true, nil)
+	suite.database.EXPECT().SaveShareLink(gomock.Any()).DoAndReturn(func(link *model.ShareLink) (*model.ShareLink, error) {
+		return link, nil
+	})
+	link, err := suite.createShareLinkUseCase.Execute(1, 0, nil)
+	suite.NoError(err)
+	suite.Equal(int64(1), link.CollectionID)
+}
+
+func (suite *ShareLinkSuite) TestCreateShareLinkUseCase_ExecuteError_ExpiresInThePast() {
+	expiresAt := time.Now().Add(-time.Hour)
+	_, err := suite.createShareLinkUseCase.Execute(1, 2, &expiresAt)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ShareLinkSuite) TestCreateShareLinkUseCase_ExecuteError_NotOwner() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 5}, true, nil)
+	_, err := suite.createShareLinkUseCase.Execute(1, 2, nil)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *ShareLinkSuite) TestGetSharedCollectionUseCase_ExecuteSuccess() {
+	expiresAt := time.Now().Add(time.Hour)
+	token, stored := suite.createToken(&expiresAt)
+	note := "private note"
+	suite.database.EXPECT().GetShareLink(stored.TokenHash).Return(stored, true, nil)
+	suite.database.EXPECT().ListCollectionItems(collections.CollectionItemsSearchParams{Page: 1, PageSize: 10, CollectionID: 2}).
+		Return(&model.CollectionItemsPaging{Page: 1, PageSize: 10, Total: 1, Data: []*model.CollectionItem{
+			{Property: &model.Property{ID: 3, OwnerID: 7}, Note: &note},
+		}}, nil)
+
+	results, err := suite.getSharedCollectionUseCase.Execute(collections.SharedCollectionSearchParams{Page: 1, PageSize: 10, Token: token})
+	suite.NoError(err)
+	suite.Equal(int64(1), results.TotalPages)
+	suite.Len(results.Data, 1)
+	suite.Equal(int64(3), results.Data[0].ID)
+	suite.Zero(results.Data[0].OwnerID)
+}
+
+func (suite *ShareLinkSuite) TestGetSharedCollectionUseCase_ExecuteError_Unknown() {
+	suite.database.EXPECT().GetShareLink(gomock.Any()).Return(nil, false, nil)
+	_, err := suite.getSharedCollectionUseCase.Execute(collections.SharedCollectionSearchParams{Page: 1, PageSize: 10, Token: "nope"})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *ShareLinkSuite) TestGetSharedCollectionUseCase_ExecuteError_Revoked() {
+	token, stored := suite.createToken(nil)
+	revokedAt := time.Now().UTC()
+	stored.RevokedAt = &revokedAt
+	suite.database.EXPECT().GetShareLink(stored.TokenHash).Return(stored, true, nil)
+	_, err := suite.getSharedCollectionUseCase.Execute(collections.SharedCollectionSearchParams{Page: 1, PageSize: 10, Token: token})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *ShareLinkSuite) TestGetSharedCollectionUseCase_ExecuteError_Expired() {
+	token, stored := suite.createToken(nil)
+	expiredAt := time.Now().UTC().Add(-time.Minute)
+	stored.ExpiresAt = &expiredAt
+	suite.database.EXPECT().GetShareLink(stored.TokenHash).Return(stored, true, nil)
+	_, err := suite.getSharedCollectionUseCase.Execute(collections.SharedCollectionSearchParams{Page: 1, PageSize: 10, Token: token})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *ShareLinkSuite) TestRevokeShareLinkUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().RevokeShareLink(int64(2), int64(5)).Return(false, nil)
+	err := suite.revokeShareLinkUseCase.Execute(1, 2, 5)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *ShareLinkSuite) TestListShareLinksUseCase_ExecuteError() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().ListShareLinks(int64(2)).Return(nil, errors.New("fail"))
+	_, err := suite.listShareLinksUseCase.Execute(1, 2)
+	suite.Error(err)
+}
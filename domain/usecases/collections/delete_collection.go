@@ -0,0 +1,28 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type DeleteCollectionUseCase struct {
+	database StorageManager
+}
+
+func NewDeleteCollectionUseCase(database StorageManager) *DeleteCollectionUseCase {
+	return &DeleteCollectionUseCase{
+		database: database,
+	}
+}
+
+// Execute deletes the collection and its items, the default collection can not be deleted
+func (uc *DeleteCollectionUseCase) Execute(userID, collectionID int64) error {
+	collection, err := getOwnedCollection(uc.database, userID, collectionID)
+	if err != nil {
+		return err
+	}
+	if collection.IsDefault {
+		return model.NewDomainError(errors.New("the default collection can not be deleted"))
+	}
+	return uc.database.DeleteCollection(collectionID)
+}
@@ -0,0 +1,19 @@
+package collections
+
+import (
+	"lahaus/domain/model"
+)
+
+type GetCollectionUseCase struct {
+	database StorageManager
+}
+
+func NewGetCollectionUseCase(database StorageManager) *GetCollectionUseCase {
+	return &GetCollectionUseCase{
+		database: database,
+	}
+}
+
+func (uc *GetCollectionUseCase) Execute(userID, collectionID int64) (*model.Collection, error) {
+	return getOwnedCollection(uc.database, userID, collectionID)
+}
@@ -0,0 +1,58 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/internal/token"
+	"math"
+	"time"
+)
+
+type GetSharedCollectionUseCase struct {
+	database StorageManager
+}
+
+type SharedCollectionSearchParams struct {
+	Page     int64
+	PageSize int64
+	Token    string
+}
+
+func NewGetSharedCollectionUseCase(database StorageManager) *GetSharedCollectionUseCase {
+	return &GetSharedCollectionUseCase{
+		database: database,
+	}
+}
+
+// Execute returns the properties of the shared collection without the notes and the owner
+func (uc *GetSharedCollectionUseCase) Execute(search SharedCollectionSearchParams) (*model.PropertiesPaging, error) {
+	link, found, err := uc.database.GetShareLink(token.Hash(search.Token))
+	if err != nil {
+		return nil, err
+	}
+	if !found || link.RevokedAt != nil || (link.ExpiresAt != nil && time.Now().UTC().After(*link.ExpiresAt)) {
+		return nil, model.NewEntityNotFoundError(errors.New("share link not found"))
+	}
+
+	items, err := uc.database.ListCollectionItems(CollectionItemsSearchParams{
+		Page:         search.Page,
+		PageSize:     search.PageSize,
+		CollectionID: link.CollectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := &model.PropertiesPaging{
+		Page:     items.Page,
+		PageSize: items.PageSize,
+		Total:    items.Total,
+		Data:     make([]*model.Property, 0, len(items.Data)),
+	}
+	for _, item := range items.Data {
+		item.Property.OwnerID = 0
+		results.Data = append(results.Data, item.Property)
+	}
+	results.TotalPages = int64(math.Ceil(float64(results.Total) / float64(results.PageSize)))
+	return results, nil
+}
@@ -0,0 +1,35 @@
+package collections
+
+import (
+	"lahaus/domain/model"
+	"math"
+)
+
+type ListCollectionItemsUseCase struct {
+	database StorageManager
+}
+
+type CollectionItemsSearchParams struct {
+	Page         int64
+	PageSize     int64
+	UserID       int64
+	CollectionID int64
+}
+
+func NewListCollectionItemsUseCase(database StorageManager) *ListCollectionItemsUseCase {
+	return &ListCollectionItemsUseCase{
+		database: database,
+	}
+}
+
+func (uc *ListCollectionItemsUseCase) Execute(search CollectionItemsSearchParams) (*model.CollectionItemsPaging, error) {
+	if _, err := getOwnedCollection(uc.database, search.UserID, search.CollectionID); err != nil {
+		return nil, err
+	}
+	results, err := uc.database.ListCollectionItems(search)
+	if err != nil {
+		return nil, err
+	}
+	results.TotalPages = int64(math.Ceil(float64(results.Total) / float64(results.PageSize)))
+	return results, nil
+}
@@ -0,0 +1,26 @@
+package collections
+
+import (
+	"lahaus/domain/model"
+)
+
+type ListCollectionsUseCase struct {
+	database StorageManager
+}
+
+func NewListCollectionsUseCase(database StorageManager) *ListCollectionsUseCase {
+	return &ListCollectionsUseCase{
+		database: database,
+	}
+}
+
+func (uc *ListCollectionsUseCase) Execute(userID int64) ([]*model.Collection, error) {
+	collections, err := uc.database.ListCollections(userID)
+	if err != nil {
+		return nil, err
+	}
+	if collections == nil {
+		collections = []*model.Collection{}
+	}
+	return collections, nil
+}
@@ -0,0 +1,29 @@
+package collections
+
+import (
+	"lahaus/domain/model"
+)
+
+type ListShareLinksUseCase struct {
+	database StorageManager
+}
+
+func NewListShareLinksUseCase(database StorageManager) *ListShareLinksUseCase {
+	return &ListShareLinksUseCase{
+		database: database,
+	}
+}
+
+func (uc *ListShareLinksUseCase) Execute(userID, collectionID int64) ([]*model.ShareLink, error) {
+	if _, err := getOwnedCollection(uc.database, userID, collectionID); err != nil {
+		return nil, err
+	}
+	links, err := uc.database.ListShareLinks(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if links == nil {
+		links = []*model.ShareLink{}
+	}
+	return links, nil
+}
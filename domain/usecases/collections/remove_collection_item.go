@@ -0,0 +1,23 @@
+package collections
+
+type RemoveCollectionItemUseCase struct {
+	database StorageManager
+}
+
+func NewRemoveCollectionItemUseCase(database StorageManager) *RemoveCollectionItemUseCase {
+	return &RemoveCollectionItemUseCase{
+		database: database,
+	}
+}
+
+// Execute removes the property from the collection, removing a property that is not in the collection is not an error
+func (uc *RemoveCollectionItemUseCase) Execute(userID, collectionID, propertyID int64) error {
+	if _, err := getOwnedCollection(uc.database, userID, collectionID); err != nil {
+		return err
+	}
+	_, err := uc.database.RemoveCollectionItem(collectionID, propertyID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
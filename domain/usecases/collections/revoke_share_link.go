@@ -0,0 +1,30 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type RevokeShareLinkUseCase struct {
+	database StorageManager
+}
+
+func NewRevokeShareLinkUseCase(database StorageManager) *RevokeShareLinkUseCase {
+	return &RevokeShareLinkUseCase{
+		database: database,
+	}
+}
+
+func (uc *RevokeShareLinkUseCase) Execute(userID, collectionID, shareLinkID int64) error {
+	if _, err := getOwnedCollection(uc.database, userID, collectionID); err != nil {
+		return err
+	}
+	found, err := uc.database.RevokeShareLink(collectionID, shareLinkID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewEntityNotFoundError(errors.New("share link not found"))
+	}
+	return nil
+}
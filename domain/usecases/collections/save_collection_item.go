@@ -0,0 +1,46 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/config"
+	"lahaus/domain/model"
+)
+
+type SaveCollectionItemUseCase struct {
+	database     StorageManager
+	verification *config.EmailVerification
+}
+
+func NewSaveCollectionItemUseCase(verification *config.EmailVerification, database StorageManager) *SaveCollectionItemUseCase {
+	return &SaveCollectionItemUseCase{
+		database:     database,
+		verification: verification,
+	}
+}
+
+// Execute adds the property to the collection or updates its note, it returns false when it was already there
+func (uc *SaveCollectionItemUseCase) Execute(userID, collectionID, propertyID int64, note *string) (bool, error) {
+	if uc.verification.Required {
+		if err := checkEmailVerified(uc.database, userID); err != nil {
+			return false, err
+		}
+	}
+	if err := validateNote(note); err != nil {
+		return false, err
+	}
+	if _, err := getOwnedCollection(uc.database, userID, collectionID); err != nil {
+		return false, err
+	}
+	_, found, err := uc.database.GetProperty(propertyID)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	created, err := uc.database.SaveCollectionItem(collectionID, propertyID, note)
+	if err != nil {
+		return false, err
+	}
+	return created, nil
+}
@@ -0,0 +1,97 @@
+package collections_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/domain/usecases/collections/mocks"
+	"strings"
+	"testing"
+)
+
+type SaveCollectionItemSuite struct {
+	suite.Suite
+	mockCtrl                    *gomock.Controller
+	database                    *mocks.MockStorageManager
+	saveCollectionItemUseCase   *collections.SaveCollectionItemUseCase
+	removeCollectionItemUseCase *collections.RemoveCollectionItemUseCase
+	listCollectionItemsUseCase  *collections.ListCollectionItemsUseCase
+}
+
+func TestSaveCollectionItemSuite(t *testing.T) {
+	suite.Run(t, new(SaveCollectionItemSuite))
+}
+
+func (suite *SaveCollectionItemSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.saveCollectionItemUseCase = collections.NewSaveCollectionItemUseCase(&config.EmailVerification{}, suite.database)
+	suite.removeCollectionItemUseCase = collections.NewRemoveCollectionItemUseCase(suite.database)
+	suite.listCollectionItemsUseCase = collections.NewListCollectionItemsUseCase(suite.database)
+}
+
+func (suite *SaveCollectionItemSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *SaveCollectionItemSuite) TestSaveCollectionItemUseCase_ExecuteSuccess() {
+	note := "close to the park"
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().GetProperty(int64(3)).Return(&model.Property{ID: 3}, true, nil)
+	suite.database.EXPECT().SaveCollectionItem(int64(2), int64(3), &note).Return(true, nil)
+	created, err := suite.saveCollectionItemUseCase.Execute(1, 2, 3, &note)
+	suite.NoError(err)
+	suite.True(created)
+}
+
+func (suite *SaveCollectionItemSuite) TestSaveCollectionItemUseCase_ExecuteError_NoteTooLong() {
+	note := strings.Repeat("a", 1001)
+	_, err := suite.saveCollectionItemUseCase.Execute(1, 2, 3, &note)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *SaveCollectionItemSuite) TestSaveCollectionItemUseCase_ExecuteError_NotOwner() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 5}, true, nil)
+	_, err := suite.saveCollectionItemUseCase.Execute(1, 2, 3, nil)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *SaveCollectionItemSuite) TestSaveCollectionItemUseCase_ExecuteError_PropertyNotFound() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().GetProperty(int64(3)).Return(nil, false, nil)
+	_, err := suite.saveCollectionItemUseCase.Execute(1, 2, 3, nil)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *SaveCollectionItemSuite) TestSaveCollectionItemUseCase_ExecuteError_Save() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().GetProperty(int64(3)).Return(&model.Property{ID: 3}, true, nil)
+	suite.database.EXPECT().SaveCollectionItem(int64(2), int64(3), nil).Return(false, errors.New("fail"))
+	_, err := suite.saveCollectionItemUseCase.Execute(1, 2, 3, nil)
+	suite.Error(err)
+}
+
+func (suite *SaveCollectionItemSuite) TestRemoveCollectionItemUseCase_ExecuteSuccess_NotInCollection() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().RemoveCollectionItem(int64(2), int64(3)).Return(false, nil)
+	err := suite.removeCollectionItemUseCase.Execute(1, 2, 3)
+	suite.NoError(err)
+}
+
+func (suite *SaveCollectionItemSuite) TestListCollectionItemsUseCase_ExecuteSuccess() {
+	search := collections.CollectionItemsSearchParams{Page: 1, PageSize: 10, UserID: 1, CollectionID: 2}
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().ListCollectionItems(search).Return(&model.CollectionItemsPaging{Page: 1, PageSize: 10, Total: 11}, nil)
+	results, err := suite.listCollectionItemsUseCase.Execute(search)
+	suite.NoError(err)
+	suite.Equal(int64(2), results.TotalPages)
+}
+
+func (suite *SaveCollectionItemSuite) TestListCollectionItemsUseCase_ExecuteError_NotOwner() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 5}, true, nil)
+	_, err := suite.listCollectionItemsUseCase.Execute(collections.CollectionItemsSearchParams{Page: 1, PageSize: 10, UserID: 1, CollectionID: 2})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
@@ -0,0 +1,37 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type TransferCollectionItemUseCase struct {
+	database StorageManager
+}
+
+func NewTransferCollectionItemUseCase(database StorageManager) *TransferCollectionItemUseCase {
+	return &TransferCollectionItemUseCase{
+		database: database,
+	}
+}
+
+// Execute copies the property with its note to the target collection, move removes it from the source
+func (uc *TransferCollectionItemUseCase) Execute(userID, fromCollectionID, toCollectionID, propertyID int64, move bool) error {
+	if fromCollectionID == toCollectionID {
+		return model.NewDomainError(errors.New("the target collection must be different from the source collection"))
+	}
+	if _, err := getOwnedCollection(uc.database, userID, fromCollectionID); err != nil {
+		return err
+	}
+	if _, err := getOwnedCollection(uc.database, userID, toCollectionID); err != nil {
+		return err
+	}
+	found, err := uc.database.TransferCollectionItem(fromCollectionID, toCollectionID, propertyID, !move)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewEntityNotFoundError(errors.New("property not found in the collection"))
+	}
+	return nil
+}
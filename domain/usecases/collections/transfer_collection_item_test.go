@@ -0,0 +1,67 @@
+package collections_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/domain/usecases/collections/mocks"
+	"testing"
+)
+
+type TransferCollectionItemSuite struct {
+	suite.Suite
+	mockCtrl        *gomock.Controller
+	database        *mocks.MockStorageManager
+	transferUseCase *collections.TransferCollectionItemUseCase
+}
+
+func TestTransferCollectionItemSuite(t *testing.T) {
+	suite.Run(t, new(TransferCollectionItemSuite))
+}
+
+func (suite *TransferCollectionItemSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.transferUseCase = collections.NewTransferCollectionItemUseCase(suite.database)
+}
+
+func (suite *TransferCollectionItemSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *TransferCollectionItemSuite) TestTransferCollectionItemUseCase_ExecuteSuccess_Move() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().GetCollection(int64(3)).Return(&model.Collection{ID: 3, UserID: 1}, true, nil)
+	suite.database.EXPECT().TransferCollectionItem(int64(2), int64(3), int64(9), false).Return(true, nil)
+	err := suite.transferUseCase.Execute(1, 2, 3, 9, true)
+	suite.NoError(err)
+}
+
+func (suite *TransferCollectionItemSuite) TestTransferCollectionItemUseCase_ExecuteSuccess_Copy() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().GetCollection(int64(3)).Return(&model.Collection{ID: 3, UserID: 1}, true, nil)
+	suite.database.EXPECT().TransferCollectionItem(int64(2), int64(3), int64(9), true).Return(true, nil)
+	err := suite.transferUseCase.Execute(1, 2, 3, 9, false)
+	suite.NoError(err)
+}
+
+func (suite *TransferCollectionItemSuite) TestTransferCollectionItemUseCase_ExecuteError_SameCollection() {
+	err := suite.transferUseCase.Execute(1, 2, 2, 9, true)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *TransferCollectionItemSuite) TestTransferCollectionItemUseCase_ExecuteError_TargetNotOwned() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().GetCollection(int64(3)).Return(&model.Collection{ID: 3, UserID: 5}, true, nil)
+	err := suite.transferUseCase.Execute(1, 2, 3, 9, true)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *TransferCollectionItemSuite) TestTransferCollectionItemUseCase_ExecuteError_ItemNotFound() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1}, true, nil)
+	suite.database.EXPECT().GetCollection(int64(3)).Return(&model.Collection{ID: 3, UserID: 1}, true, nil)
+	suite.database.EXPECT().TransferCollectionItem(int64(2), int64(3), int64(9), false).Return(false, nil)
+	err := suite.transferUseCase.Execute(1, 2, 3, 9, true)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
@@ -0,0 +1,37 @@
+package collections
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type UpdateCollectionUseCase struct {
+	database StorageManager
+}
+
+func NewUpdateCollectionUseCase(database StorageManager) *UpdateCollectionUseCase {
+	return &UpdateCollectionUseCase{
+		database: database,
+	}
+}
+
+// Execute renames the collection, the default collection can not be renamed
+func (uc *UpdateCollectionUseCase) Execute(userID, collectionID int64, name string) (*model.Collection, error) {
+	collection, err := getOwnedCollection(uc.database, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.IsDefault {
+		return nil, model.NewDomainError(errors.New("the default collection can not be renamed"))
+	}
+	name, err = validateCollectionName(uc.database, userID, collectionID, name)
+	if err != nil {
+		return nil, err
+	}
+	collection.Name = name
+	collection, err = uc.database.UpdateCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
@@ -0,0 +1,92 @@
+package collections_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/domain/usecases/collections/mocks"
+	"testing"
+)
+
+type UpdateCollectionSuite struct {
+	suite.Suite
+	mockCtrl                *gomock.Controller
+	database                *mocks.MockStorageManager
+	updateCollectionUseCase *collections.UpdateCollectionUseCase
+	deleteCollectionUseCase *collections.DeleteCollectionUseCase
+	getCollectionUseCase    *collections.GetCollectionUseCase
+}
+
+func TestUpdateCollectionSuite(t *testing.T) {
+	suite.Run(t, new(UpdateCollectionSuite))
+}
+
+func (suite *UpdateCollectionSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.updateCollectionUseCase = collections.NewUpdateCollectionUseCase(suite.database)
+	suite.deleteCollectionUseCase = collections.NewDeleteCollectionUseCase(suite.database)
+	suite.getCollectionUseCase = collections.NewGetCollectionUseCase(suite.database)
+}
+
+func (suite *UpdateCollectionSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *UpdateCollectionSuite) TestUpdateCollectionUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1, Name: "Polanco"}, true, nil)
+	suite.database.EXPECT().ListCollections(int64(1)).Return([]*model.Collection{{ID: 2, UserID: 1, Name: "Polanco"}}, nil)
+	suite.database.EXPECT().UpdateCollection(&model.Collection{ID: 2, UserID: 1, Name: "POLANCO"}).
+		Return(&model.Collection{ID: 2, UserID: 1, Name: "POLANCO"}, nil)
+	collection, err := suite.updateCollectionUseCase.Execute(1, 2, "POLANCO")
+	suite.NoError(err)
+	suite.Equal("POLANCO", collection.Name)
+}
+
+func (suite *UpdateCollectionSuite) TestUpdateCollectionUseCase_ExecuteError_NotOwner() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 5, Name: "Polanco"}, true, nil)
+	_, err := suite.updateCollectionUseCase.Execute(1, 2, "Roma")
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *UpdateCollectionSuite) TestUpdateCollectionUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(nil, false, nil)
+	_, err := suite.updateCollectionUseCase.Execute(1, 2, "Roma")
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *UpdateCollectionSuite) TestUpdateCollectionUseCase_ExecuteError_Default() {
+	suite.database.EXPECT().GetCollection(int64(1)).Return(&model.Collection{ID: 1, UserID: 1, Name: model.DefaultCollectionName, IsDefault: true}, true, nil)
+	_, err := suite.updateCollectionUseCase.Execute(1, 1, "Roma")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *UpdateCollectionSuite) TestUpdateCollectionUseCase_ExecuteError_DuplicatedName() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1, Name: "Polanco"}, true, nil)
+	suite.database.EXPECT().ListCollections(int64(1)).Return([]*model.Collection{
+		{ID: 2, UserID: 1, Name: "Polanco"},
+		{ID: 3, UserID: 1, Name: "Roma"},
+	}, nil)
+	_, err := suite.updateCollectionUseCase.Execute(1, 2, "roma")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *UpdateCollectionSuite) TestDeleteCollectionUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 1, Name: "Polanco"}, true, nil)
+	suite.database.EXPECT().DeleteCollection(int64(2)).Return(nil)
+	err := suite.deleteCollectionUseCase.Execute(1, 2)
+	suite.NoError(err)
+}
+
+func (suite *UpdateCollectionSuite) TestDeleteCollectionUseCase_ExecuteError_Default() {
+	suite.database.EXPECT().GetCollection(int64(1)).Return(&model.Collection{ID: 1, UserID: 1, IsDefault: true}, true, nil)
+	err := suite.deleteCollectionUseCase.Execute(1, 1)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *UpdateCollectionSuite) TestGetCollectionUseCase_ExecuteError_NotOwner() {
+	suite.database.EXPECT().GetCollection(int64(2)).Return(&model.Collection{ID: 2, UserID: 5}, true, nil)
+	_, err := suite.getCollectionUseCase.Execute(1, 2)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
@@ -0,0 +1,107 @@
+package currencies
+
+import (
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"sort"
+	"sync"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_currencies.go -package=mocks -source=./exchange_rates.go
+
+type StorageManager interface {
+	ListExchangeRates() ([]*model.ExchangeRate, error)
+	SaveExchangeRate(rate *model.ExchangeRate, audit *model.AuditEntry) error
+}
+
+// ExchangeRates are the rates of the config with the ones updated by the admins on top
+type ExchangeRates struct {
+	config   *config.ExchangeRates
+	database StorageManager
+	mutex    sync.Mutex
+	rates    []*model.ExchangeRate
+	loadedAt time.Time
+	now      func() time.Time
+}
+
+func NewExchangeRates(config *config.ExchangeRates, database StorageManager) *ExchangeRates {
+	return &ExchangeRates{
+		config:   config,
+		database: database,
+		now:      time.Now,
+	}
+}
+
+// List returns the rates sorted by currency
+func (r *ExchangeRates) List() []*model.ExchangeRate {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.rates == nil || r.stale() {
+		r.load()
+	}
+	return r.rates
+}
+
+// Current returns the rates by currency
+func (r *ExchangeRates) Current() model.ExchangeRates {
+	rates := model.ExchangeRates{}
+	for _, rate := range r.List() {
+		rates[rate.Currency] = rate.Rate
+	}
+	return rates
+}
+
+// Convert changes an amount in minor units from one currency to the other with the current rates
+func (r *ExchangeRates) Convert(amount model.Amount, from, to model.Currency) (model.Amount, error) {
+	return r.Current().Convert(amount, from, to)
+}
+
+// Invalidate makes the next use reload the rates
+func (r *ExchangeRates) Invalidate() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.rates = nil
+}
+
+// stale is never true without a refresh interval, the rates are loaded once
+func (r *ExchangeRates) stale() bool {
+	interval := time.Duration(r.config.RefreshIntervalInMinutes) * time.Minute
+	return interval > 0 && r.now().Sub(r.loadedAt) >= interval
+}
+
+// load keeps the rates it had when the storage fails, the config ones the first time, and tries again after the refresh interval
+func (r *ExchangeRates) load() {
+	r.loadedAt = r.now()
+	stored, err := r.database.ListExchangeRates()
+	if err != nil {
+		logger.GetInstance().Error("error loading exchange rates", zap.Error(err))
+		if r.rates == nil {
+			r.rates = r.merge(nil)
+		}
+		return
+	}
+	r.rates = r.merge(stored)
+}
+
+func (r *ExchangeRates) merge(stored []*model.ExchangeRate) []*model.ExchangeRate {
+	byCurrency := map[model.Currency]*model.ExchangeRate{}
+	for currency, rate := range r.config.Rates {
+		byCurrency[model.Currency(currency)] = &model.ExchangeRate{Currency: model.Currency(currency), Rate: rate}
+	}
+	for _, rate := range stored {
+		byCurrency[rate.Currency] = rate
+	}
+
+	rates := make([]*model.ExchangeRate, 0, len(byCurrency))
+	for _, rate := range byCurrency {
+		rates = append(rates, rate)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Currency < rates[j].Currency
+	})
+	return rates
+}
@@ -0,0 +1,115 @@
+package currencies_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/currencies"
+	"lahaus/domain/usecases/currencies/mocks"
+	"testing"
+	"time"
+)
+
+type ExchangeRatesSuite struct {
+	suite.Suite
+	mockCtrl          *gomock.Controller
+	database          *mocks.MockStorageManager
+	rates             *currencies.ExchangeRates
+	listUseCase       *currencies.ListExchangeRatesUseCase
+	updateRateUseCase *currencies.UpdateExchangeRateUseCase
+}
+
+var admin = &model.Actor{UserID: 1, Role: model.ADMIN}
+
+func TestExchangeRatesSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRatesSuite))
+}
+
+func (suite *ExchangeRatesSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.rates = currencies.NewExchangeRates(&config.ExchangeRates{Rates: map[string]float64{"USD": 1, "MXN": 17.5, "COP": 4000}},
+		suite.database)
+	suite.listUseCase = currencies.NewListExchangeRatesUseCase(suite.rates)
+	suite.updateRateUseCase = currencies.NewUpdateExchangeRateUseCase(suite.database, suite.rates)
+}
+
+func (suite *ExchangeRatesSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ExchangeRatesSuite) TestExchangeRates_StoredOverConfig() {
+	updatedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	suite.database.EXPECT().ListExchangeRates().Return([]*model.ExchangeRate{
+		{Currency: model.COP, Rate: 4200, UpdatedAt: &updatedAt},
+		{Currency: "EUR", Rate: 0.9, UpdatedAt: &updatedAt},
+	}, nil).Times(1)
+
+	rates := suite.listUseCase.Execute()
+	suite.Equal([]*model.ExchangeRate{
+		{Currency: model.COP, Rate: 4200, UpdatedAt: &updatedAt},
+		{Currency: "EUR", Rate: 0.9, UpdatedAt: &updatedAt},
+		{Currency: model.MXN, Rate: 17.5},
+		{Currency: model.USD, Rate: 1},
+	}, rates)
+	suite.Equal(model.ExchangeRates{model.COP: 4200, "EUR": 0.9, model.MXN: 17.5, model.USD: 1}, suite.rates.Current())
+}
+
+func (suite *ExchangeRatesSuite) TestExchangeRates_Convert() {
+	suite.database.EXPECT().ListExchangeRates().Return(nil, nil)
+
+	amount, err := suite.rates.Convert(100000, model.USD, model.COP)
+	suite.NoError(err)
+	suite.Equal(model.Amount(400000000), amount)
+	amount, err = suite.rates.Convert(400000001, model.COP, model.MXN)
+	suite.NoError(err)
+	suite.Equal(model.Amount(1750000), amount)
+	amount, err = suite.rates.Convert(12345, "EUR", "EUR")
+	suite.NoError(err)
+	suite.Equal(model.Amount(12345), amount)
+	_, err = suite.rates.Convert(100, "EUR", model.USD)
+	suite.Error(err)
+}
+
+func (suite *ExchangeRatesSuite) TestExchangeRates_StorageFails() {
+	suite.database.EXPECT().ListExchangeRates().Return(nil, errors.New("fail"))
+	suite.Equal(model.ExchangeRates{model.COP: 4000, model.MXN: 17.5, model.USD: 1}, suite.rates.Current())
+}
+
+func (suite *ExchangeRatesSuite) TestUpdateExchangeRateUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().ListExchangeRates().Return(nil, nil)
+	suite.database.EXPECT().SaveExchangeRate(gomock.Any(), gomock.Any()).DoAndReturn(func(rate *model.ExchangeRate, audit *model.AuditEntry) error {
+		suite.Equal(model.COP, rate.Currency)
+		suite.NotNil(rate.UpdatedAt)
+		suite.Equal(model.EXCHANGE_RATE, audit.Entity)
+		suite.Equal(model.UPDATE, audit.Action)
+		suite.Equal(map[string]model.AuditChange{"rate": {Before: float64(4000), After: float64(4100)}}, audit.Changes)
+		return nil
+	})
+	rate, err := suite.updateRateUseCase.Execute(&model.ExchangeRate{Currency: model.COP, Rate: 4100}, admin)
+	suite.NoError(err)
+	suite.Equal(4100.0, rate.Rate)
+
+	updatedAt := time.Now().UTC()
+	suite.database.EXPECT().ListExchangeRates().Return([]*model.ExchangeRate{{Currency: model.COP, Rate: 4100, UpdatedAt: &updatedAt}}, nil)
+	suite.Equal(4100.0, suite.rates.Current()[model.COP])
+}
+
+func (suite *ExchangeRatesSuite) TestUpdateExchangeRateUseCase_ExecuteError_InvalidCurrency() {
+	_, err := suite.updateRateUseCase.Execute(&model.ExchangeRate{Currency: "pesos", Rate: 4100}, admin)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ExchangeRatesSuite) TestUpdateExchangeRateUseCase_ExecuteError_InvalidRate() {
+	_, err := suite.updateRateUseCase.Execute(&model.ExchangeRate{Currency: model.COP, Rate: 0}, admin)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ExchangeRatesSuite) TestUpdateExchangeRateUseCase_ExecuteError_Save() {
+	suite.database.EXPECT().ListExchangeRates().Return(nil, nil)
+	suite.database.EXPECT().SaveExchangeRate(gomock.Any(), gomock.Any()).Return(errors.New("fail"))
+	_, err := suite.updateRateUseCase.Execute(&model.ExchangeRate{Currency: model.COP, Rate: 4100}, admin)
+	suite.Error(err)
+}
@@ -0,0 +1,18 @@
+package currencies
+
+import "lahaus/domain/model"
+
+type ListExchangeRatesUseCase struct {
+	rates *ExchangeRates
+}
+
+func NewListExchangeRatesUseCase(rates *ExchangeRates) *ListExchangeRatesUseCase {
+	return &ListExchangeRatesUseCase{
+		rates: rates,
+	}
+}
+
+// Execute lists the rates used in the conversions, the ones without an update date come from the config
+func (uc *ListExchangeRatesUseCase) Execute() []*model.ExchangeRate {
+	return uc.rates.List()
+}
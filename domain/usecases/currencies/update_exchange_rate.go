@@ -0,0 +1,52 @@
+package currencies
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/domain/model"
+	"math"
+	"time"
+)
+
+type UpdateExchangeRateUseCase struct {
+	database StorageManager
+	rates    *ExchangeRates
+}
+
+func NewUpdateExchangeRateUseCase(database StorageManager, rates *ExchangeRates) *UpdateExchangeRateUseCase {
+	return &UpdateExchangeRateUseCase{
+		database: database,
+		rates:    rates,
+	}
+}
+
+// Execute saves the rate of the currency over the one of the config, the conversions of this replica use it right away
+func (uc *UpdateExchangeRateUseCase) Execute(rate *model.ExchangeRate, actor *model.Actor) (*model.ExchangeRate, error) {
+	if !rate.Currency.IsValid() {
+		return nil, model.NewDomainError(fmt.Errorf("invalid currency [%v]", rate.Currency))
+	}
+	if !(rate.Rate > 0) || math.IsInf(rate.Rate, 0) {
+		return nil, model.NewDomainError(errors.New("the rate must be greater than 0"))
+	}
+
+	var current *model.ExchangeRate
+	for _, existing := range uc.rates.List() {
+		if existing.Currency == rate.Currency {
+			current = existing
+		}
+	}
+	updatedAt := time.Now().UTC()
+	rate.UpdatedAt = &updatedAt
+	changes, err := model.AuditChanges(current, rate)
+	if err != nil {
+		return nil, err
+	}
+	delete(changes, "updatedAt")
+
+	err = uc.database.SaveExchangeRate(rate, model.NewAuditEntry(model.EXCHANGE_RATE, 0, model.UPDATE, actor, changes))
+	if err != nil {
+		return nil, err
+	}
+	uc.rates.Invalidate()
+	return rate, nil
+}
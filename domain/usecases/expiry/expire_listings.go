@@ -0,0 +1,122 @@
+package expiry
+
+import (
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_expiry.go -package=mocks -source=./expire_listings.go
+
+type StorageManager interface {
+	GetUserByID(userID int64) (*model.User, bool, error)
+	ListExpiredListings(listedBefore map[model.PropertyType]time.Time) ([]*model.Property, error)
+	ListListingsToRemind(listedBefore map[model.PropertyType]time.Time) ([]*model.Property, error)
+	SaveExpiryReminder(propertyID int64, listedAt time.Time) (bool, error)
+	ExpireProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error)
+}
+
+// Notifier delivers the expiry reminders and notices to the owners
+type Notifier interface {
+	NotifyExpiry(alert *model.ExpiryAlert) error
+}
+
+type ExpireListingsUseCase struct {
+	database  StorageManager
+	notifier  Notifier
+	lifetimes map[model.PropertyType]time.Duration
+	reminder  time.Duration
+}
+
+func NewExpireListingsUseCase(conf *config.ListingExpiry, database StorageManager, notifier Notifier) *ExpireListingsUseCase {
+	lifetimes := map[model.PropertyType]time.Duration{}
+	for propertyType, days := range map[model.PropertyType]int{model.HOUSE: conf.HouseLifetimeInDays, model.APARTMENT: conf.ApartmentLifetimeInDays} {
+		if days > 0 {
+			lifetimes[propertyType] = time.Duration(days) * 24 * time.Hour
+		}
+	}
+	return &ExpireListingsUseCase{
+		database:  database,
+		notifier:  notifier,
+		lifetimes: lifetimes,
+		reminder:  time.Duration(conf.ReminderDaysBefore) * 24 * time.Hour,
+	}
+}
+
+// Execute expires the ACTIVE listings that outlived their lifetime and reminds the owners of the ones about to expire
+func (uc *ExpireListingsUseCase) Execute() error {
+	now := time.Now().UTC()
+	expired, err := uc.database.ListExpiredListings(uc.listedBefore(now))
+	if err != nil {
+		return err
+	}
+	for _, current := range expired {
+		property := *current
+		if err := property.TransitionTo(model.EXPIRED); err != nil {
+			return err
+		}
+		changes, err := model.AuditChanges(current, &property)
+		if err != nil {
+			return err
+		}
+		propertyStored, err := uc.database.ExpireProperty(&property, model.NewAuditEntry(model.PROPERTY, current.ID, model.STATUS_CHANGE, nil, changes))
+		if err != nil {
+			logger.GetInstance().Error("error expiring listing", zap.Error(err), zap.Int64("propertyId", current.ID))
+			continue
+		}
+		uc.notifyOwner(propertyStored, uc.expiresAt(current), true)
+	}
+
+	if uc.reminder <= 0 {
+		return nil
+	}
+	expiring, err := uc.database.ListListingsToRemind(uc.listedBefore(now.Add(uc.reminder)))
+	if err != nil {
+		return err
+	}
+	for _, property := range expiring {
+		saved, err := uc.database.SaveExpiryReminder(property.ID, property.ListedAt)
+		if err != nil {
+			logger.GetInstance().Error("error saving expiry reminder", zap.Error(err), zap.Int64("propertyId", property.ID))
+			continue
+		}
+		if saved {
+			uc.notifyOwner(property, uc.expiresAt(property), false)
+		}
+	}
+	return nil
+}
+
+// listedBefore returns the time the listings of each property type must be listed before to be expired
+func (uc *ExpireListingsUseCase) listedBefore(moment time.Time) map[model.PropertyType]time.Time {
+	listedBefore := make(map[model.PropertyType]time.Time, len(uc.lifetimes))
+	for propertyType, lifetime := range uc.lifetimes {
+		listedBefore[propertyType] = moment.Add(-lifetime)
+	}
+	return listedBefore
+}
+
+func (uc *ExpireListingsUseCase) expiresAt(property *model.Property) time.Time {
+	return property.ListedAt.Add(uc.lifetimes[property.PropertyType])
+}
+
+// notifyOwner only logs the failures, the next runs do not notify the listing again
+func (uc *ExpireListingsUseCase) notifyOwner(property *model.Property, expiresAt time.Time, expired bool) {
+	owner, found, err := uc.database.GetUserByID(property.OwnerID)
+	if err != nil || !found {
+		logger.GetInstance().Error("error getting the owner of the listing", zap.Error(err), zap.Int64("propertyId", property.ID))
+		return
+	}
+	err = uc.notifier.NotifyExpiry(&model.ExpiryAlert{
+		UserID:    owner.ID,
+		Email:     owner.Email,
+		Property:  property,
+		ExpiresAt: expiresAt,
+		Expired:   expired,
+	})
+	if err != nil {
+		logger.GetInstance().Error("error notifying expiry", zap.Error(err), zap.Int64("propertyId", property.ID), zap.Int64("userId", owner.ID))
+	}
+}
@@ -0,0 +1,111 @@
+package expiry_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/expiry"
+	"lahaus/domain/usecases/expiry/mocks"
+	"testing"
+	"time"
+)
+
+type ExpireListingsSuite struct {
+	suite.Suite
+	mockCtrl      *gomock.Controller
+	database      *mocks.MockStorageManager
+	notifier      *mocks.MockNotifier
+	expireUseCase *expiry.ExpireListingsUseCase
+}
+
+func TestExpireListingsSuite(t *testing.T) {
+	suite.Run(t, new(ExpireListingsSuite))
+}
+
+func (suite *ExpireListingsSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.notifier = mocks.NewMockNotifier(suite.mockCtrl)
+	suite.expireUseCase = expiry.NewExpireListingsUseCase(&config.ListingExpiry{HouseLifetimeInDays: 90, ReminderDaysBefore: 7},
+		suite.database, suite.notifier)
+}
+
+func (suite *ExpireListingsSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+var owner = &model.User{ID: 2, Email: "owner@mail.com"}
+
+func listing(id int64, listedAt time.Time) *model.Property {
+	return &model.Property{ID: id, Title: "Casa de familia", PropertyType: model.HOUSE, Status: model.ACTIVE, OwnerID: owner.ID,
+		ListedAt: listedAt, Version: 1}
+}
+
+func (suite *ExpireListingsSuite) TestExpireListingsUseCase_ExecuteSuccess() {
+	now := time.Now().UTC()
+	listedAt := now.Add(-91 * 24 * time.Hour)
+	expiringListedAt := now.Add(-85 * 24 * time.Hour)
+
+	suite.database.EXPECT().ListExpiredListings(gomock.Any()).DoAndReturn(func(listedBefore map[model.PropertyType]time.Time) ([]*model.Property, error) {
+		suite.Len(listedBefore, 1)
+		suite.WithinDuration(now.Add(-90*24*time.Hour), listedBefore[model.HOUSE], time.Minute)
+		return []*model.Property{listing(1, listedAt)}, nil
+	})
+	suite.database.EXPECT().ExpireProperty(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+			suite.Equal(model.EXPIRED, property.Status)
+			suite.Equal(model.STATUS_CHANGE, audit.Action)
+			suite.Nil(audit.ActorID)
+			suite.Equal(model.AuditChange{Before: "ACTIVE", After: "EXPIRED"}, audit.Changes["status"])
+			return property, nil
+		})
+	suite.database.EXPECT().ListListingsToRemind(gomock.Any()).DoAndReturn(func(listedBefore map[model.PropertyType]time.Time) ([]*model.Property, error) {
+		suite.WithinDuration(now.Add(-83*24*time.Hour), listedBefore[model.HOUSE], time.Minute)
+		return []*model.Property{listing(2, expiringListedAt), listing(3, expiringListedAt)}, nil
+	})
+	suite.database.EXPECT().SaveExpiryReminder(int64(2), expiringListedAt).Return(true, nil)
+	suite.database.EXPECT().SaveExpiryReminder(int64(3), expiringListedAt).Return(false, nil)
+	suite.database.EXPECT().GetUserByID(owner.ID).Return(owner, true, nil).Times(2)
+	suite.notifier.EXPECT().NotifyExpiry(gomock.Any()).DoAndReturn(func(alert *model.ExpiryAlert) error {
+		suite.Equal(int64(1), alert.Property.ID)
+		suite.True(alert.Expired)
+		suite.Equal(listedAt.Add(90*24*time.Hour), alert.ExpiresAt)
+		return nil
+	})
+	suite.notifier.EXPECT().NotifyExpiry(gomock.Any()).DoAndReturn(func(alert *model.ExpiryAlert) error {
+		suite.Equal(int64(2), alert.Property.ID)
+		suite.False(alert.Expired)
+		suite.Equal("owner@mail.com", alert.Email)
+		return nil
+	})
+
+	suite.NoError(suite.expireUseCase.Execute())
+}
+
+func (suite *ExpireListingsSuite) TestExpireListingsUseCase_ExecuteSuccess_ModifiedMeanwhile() {
+	suite.database.EXPECT().ListExpiredListings(gomock.Any()).Return([]*model.Property{listing(1, time.Now().UTC())}, nil)
+	suite.database.EXPECT().ExpireProperty(gomock.Any(), gomock.Any()).
+		Return(nil, model.NewPreconditionFailedError(errors.New("the property was modified by another request")))
+	suite.database.EXPECT().ListListingsToRemind(gomock.Any()).Return([]*model.Property{}, nil)
+
+	suite.NoError(suite.expireUseCase.Execute())
+}
+
+func (suite *ExpireListingsSuite) TestExpireListingsUseCase_ExecuteSuccess_WithoutReminders() {
+	expireUseCase := expiry.NewExpireListingsUseCase(&config.ListingExpiry{ApartmentLifetimeInDays: 60}, suite.database, suite.notifier)
+	suite.database.EXPECT().ListExpiredListings(gomock.Any()).DoAndReturn(func(listedBefore map[model.PropertyType]time.Time) ([]*model.Property, error) {
+		_, found := listedBefore[model.HOUSE]
+		suite.False(found)
+		suite.Contains(listedBefore, model.APARTMENT)
+		return []*model.Property{}, nil
+	})
+
+	suite.NoError(expireUseCase.Execute())
+}
+
+func (suite *ExpireListingsSuite) TestExpireListingsUseCase_ExecuteError() {
+	suite.database.EXPECT().ListExpiredListings(gomock.Any()).Return(nil, errors.New("fail"))
+	suite.Error(suite.expireUseCase.Execute())
+}
@@ -0,0 +1,89 @@
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"net/http"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_idempotency.go -package=mocks -source=./execute_idempotent_request.go
+
+type StorageManager interface {
+	// LockIdempotencyKey blocks until no other request holds the key, the lock is held until the returned function is called
+	LockIdempotencyKey(userID int64, key string) (func(), error)
+	GetIdempotencyKey(userID int64, key string) (*model.IdempotencyKey, bool, error)
+	SaveIdempotencyKey(idempotencyKey *model.IdempotencyKey) error
+}
+
+type ExecuteIdempotentRequestUseCase struct {
+	database StorageManager
+	config   *config.Idempotency
+}
+
+func NewExecuteIdempotentRequestUseCase(config *config.Idempotency, database StorageManager) *ExecuteIdempotentRequestUseCase {
+	return &ExecuteIdempotentRequestUseCase{
+		database: database,
+		config:   config,
+	}
+}
+
+// Execute runs the handler once per idempotency key, the repeated requests get the stored response
+func (uc *ExecuteIdempotentRequestUseCase) Execute(request *model.IdempotentRequest, handler func() *model.StoredResponse) (*model.StoredResponse, bool, error) {
+	unlock, err := uc.database.LockIdempotencyKey(request.UserID, request.Key)
+	if err != nil {
+		return nil, false, err
+	}
+	defer unlock()
+
+	requestHash := hashRequest(request)
+	stored, found, err := uc.database.GetIdempotencyKey(request.UserID, request.Key)
+	if err != nil {
+		return nil, false, err
+	}
+	if found {
+		if stored.RequestHash != requestHash {
+			return nil, false, model.NewUnprocessableEntityError(errors.New("the idempotency key was used with another request"))
+		}
+		return &stored.Response, true, nil
+	}
+
+	response := handler()
+	if response.StatusCode >= http.StatusInternalServerError {
+		return response, false, nil
+	}
+	now := time.Now().UTC()
+	err = uc.database.SaveIdempotencyKey(&model.IdempotencyKey{
+		UserID:      request.UserID,
+		Key:         request.Key,
+		RequestHash: requestHash,
+		Response:    *response,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Duration(uc.config.KeyDurationInMinutes) * time.Minute),
+	})
+	if err != nil {
+		// the request already ran, failing it would make the client retry it without the stored response
+		logger.GetInstance().Error("error saving idempotency key", zap.Error(err), zap.Int64("userId", request.UserID))
+	}
+	return response, false, nil
+}
+
+// hashRequest hashes the method, the path and the compacted JSON body
+func hashRequest(request *model.IdempotentRequest) string {
+	body := request.Body
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, request.Body); err == nil {
+		body = compacted.Bytes()
+	}
+	hash := sha256.New()
+	hash.Write([]byte(request.Method + " " + request.Path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,160 @@
+package idempotency_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/idempotency"
+	"lahaus/domain/usecases/idempotency/mocks"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type ExecuteIdempotentRequestSuite struct {
+	suite.Suite
+	mockCtrl       *gomock.Controller
+	database       *mocks.MockStorageManager
+	unlocked       bool
+	executeUseCase *idempotency.ExecuteIdempotentRequestUseCase
+}
+
+func TestExecuteIdempotentRequestSuite(t *testing.T) {
+	suite.Run(t, new(ExecuteIdempotentRequestSuite))
+}
+
+func (suite *ExecuteIdempotentRequestSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.unlocked = false
+	suite.executeUseCase = idempotency.NewExecuteIdempotentRequestUseCase(&config.Idempotency{KeyDurationInMinutes: 60}, suite.database)
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ExecuteIdempotentRequestSuite) expectLock() {
+	suite.database.EXPECT().LockIdempotencyKey(int64(1), "key").Return(func() { suite.unlocked = true }, nil)
+}
+
+func newRequest(body string) *model.IdempotentRequest {
+	return &model.IdempotentRequest{UserID: 1, Key: "key", Method: "POST", Path: "/v1/properties/", Body: []byte(body)}
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TestExecuteIdempotentRequestUseCase_ExecuteSuccess_FirstRequest() {
+	response := &model.StoredResponse{StatusCode: http.StatusOK, Body: []byte(`{"id":7}`)}
+	suite.expectLock()
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").Return(nil, false, nil)
+	suite.database.EXPECT().SaveIdempotencyKey(gomock.Any()).DoAndReturn(func(idempotencyKey *model.IdempotencyKey) error {
+		suite.Equal(*response, idempotencyKey.Response)
+		suite.Len(idempotencyKey.RequestHash, 64)
+		suite.WithinDuration(time.Now().UTC().Add(time.Hour), idempotencyKey.ExpiresAt, time.Minute)
+		return nil
+	})
+
+	calls := 0
+	result, replayed, err := suite.executeUseCase.Execute(newRequest(`{"title": "Casa"}`), func() *model.StoredResponse {
+		calls++
+		return response
+	})
+	suite.NoError(err)
+	suite.False(replayed)
+	suite.Equal(response, result)
+	suite.Equal(1, calls)
+	suite.True(suite.unlocked)
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TestExecuteIdempotentRequestUseCase_ExecuteSuccess_Replayed() {
+	var stored *model.IdempotencyKey
+	suite.database.EXPECT().LockIdempotencyKey(int64(1), "key").Return(func() {}, nil).Times(2)
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").Return(nil, false, nil)
+	suite.database.EXPECT().SaveIdempotencyKey(gomock.Any()).DoAndReturn(func(idempotencyKey *model.IdempotencyKey) error {
+		stored = idempotencyKey
+		return nil
+	})
+	_, _, err := suite.executeUseCase.Execute(newRequest(`{"title": "Casa"}`), func() *model.StoredResponse {
+		return &model.StoredResponse{StatusCode: http.StatusOK, Body: []byte(`{"id":7}`)}
+	})
+	suite.NoError(err)
+
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").DoAndReturn(func(userID int64, key string) (*model.IdempotencyKey, bool, error) {
+		return stored, true, nil
+	})
+	result, replayed, err := suite.executeUseCase.Execute(newRequest(`{"title":"Casa"}`), func() *model.StoredResponse {
+		suite.Fail("the handler must not run again")
+		return nil
+	})
+	suite.NoError(err)
+	suite.True(replayed)
+	suite.Equal([]byte(`{"id":7}`), result.Body)
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TestExecuteIdempotentRequestUseCase_ExecuteError_AnotherBody() {
+	var stored *model.IdempotencyKey
+	suite.database.EXPECT().LockIdempotencyKey(int64(1), "key").Return(func() {}, nil).Times(2)
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").Return(nil, false, nil)
+	suite.database.EXPECT().SaveIdempotencyKey(gomock.Any()).DoAndReturn(func(idempotencyKey *model.IdempotencyKey) error {
+		stored = idempotencyKey
+		return nil
+	})
+	_, _, err := suite.executeUseCase.Execute(newRequest(`{"title": "Casa"}`), func() *model.StoredResponse {
+		return &model.StoredResponse{StatusCode: http.StatusOK}
+	})
+	suite.NoError(err)
+
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").DoAndReturn(func(userID int64, key string) (*model.IdempotencyKey, bool, error) {
+		return stored, true, nil
+	})
+	result, _, err := suite.executeUseCase.Execute(newRequest(`{"title": "Casa de campo"}`), func() *model.StoredResponse {
+		suite.Fail("the handler must not run again")
+		return nil
+	})
+	suite.IsType(&model.UnprocessableEntityError{}, err)
+	suite.Nil(result)
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TestExecuteIdempotentRequestUseCase_ExecuteSuccess_ServerErrorNotStored() {
+	suite.expectLock()
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").Return(nil, false, nil)
+	result, replayed, err := suite.executeUseCase.Execute(newRequest(`{}`), func() *model.StoredResponse {
+		return &model.StoredResponse{StatusCode: http.StatusInternalServerError}
+	})
+	suite.NoError(err)
+	suite.False(replayed)
+	suite.Equal(http.StatusInternalServerError, result.StatusCode)
+	suite.True(suite.unlocked)
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TestExecuteIdempotentRequestUseCase_ExecuteSuccess_SaveError() {
+	suite.expectLock()
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").Return(nil, false, nil)
+	suite.database.EXPECT().SaveIdempotencyKey(gomock.Any()).Return(errors.New("fail"))
+	result, _, err := suite.executeUseCase.Execute(newRequest(`{}`), func() *model.StoredResponse {
+		return &model.StoredResponse{StatusCode: http.StatusOK}
+	})
+	suite.NoError(err)
+	suite.Equal(http.StatusOK, result.StatusCode)
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TestExecuteIdempotentRequestUseCase_ExecuteError_Lock() {
+	suite.database.EXPECT().LockIdempotencyKey(int64(1), "key").Return(nil, errors.New("fail"))
+	_, _, err := suite.executeUseCase.Execute(newRequest(`{}`), func() *model.StoredResponse {
+		suite.Fail("the handler must not run without the lock")
+		return nil
+	})
+	suite.Error(err)
+}
+
+func (suite *ExecuteIdempotentRequestSuite) TestExecuteIdempotentRequestUseCase_ExecuteError_Get() {
+	suite.expectLock()
+	suite.database.EXPECT().GetIdempotencyKey(int64(1), "key").Return(nil, false, errors.New("fail"))
+	_, _, err := suite.executeUseCase.Execute(newRequest(`{}`), func() *model.StoredResponse {
+		suite.Fail("the handler must not run")
+		return nil
+	})
+	suite.Error(err)
+	suite.True(suite.unlocked)
+}
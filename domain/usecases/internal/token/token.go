@@ -0,0 +1,24 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+const sizeInBytes = 32
+
+// Generate returns a random url safe token
+func Generate() (string, error) {
+	value := make([]byte, sizeInBytes)
+	if _, err := rand.Read(value); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(value), nil
+}
+
+// Hash is the value stored for the tokens handed to the users, so a leaked table cannot be used to access the resources
+func Hash(token string) string {
+	tokenEncrypt := sha256.Sum256([]byte(token))
+	return base64.URLEncoding.EncodeToString(tokenEncrypt[:])
+}
@@ -0,0 +1,26 @@
+package token
+
+import "testing"
+
+func TestGenerate(t *testing.T) {
+	first, err := Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	second, err := Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(first) != 43 {
+		t.Errorf("Generate() length = %d, want 43", len(first))
+	}
+	if first == second {
+		t.Errorf("Generate() returned the same token twice")
+	}
+}
+
+func TestHash(t *testing.T) {
+	if got := Hash("token"); got != "PEaenWxYddN6Q_NT1PiOYfz4EsZu7jRXRlpAsNpBU-A=" {
+		t.Errorf("Hash() = %v", got)
+	}
+}
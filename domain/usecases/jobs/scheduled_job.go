@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"lahaus/logger"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_jobs.go -package=mocks -source=./scheduled_job.go
+
+// Locker takes a lock shared by all the replicas without waiting, it returns false when another replica holds it
+type Locker interface {
+	TryLockJob(name string) (func(), bool, error)
+}
+
+type JobExecutor interface {
+	Execute() error
+}
+
+// ScheduledJob runs the executor every interval in every replica, the lock lets only one of them do the work at a time
+type ScheduledJob struct {
+	name     string
+	interval time.Duration
+	locker   Locker
+	executor JobExecutor
+}
+
+func NewScheduledJob(name string, interval time.Duration, locker Locker, executor JobExecutor) *ScheduledJob {
+	return &ScheduledJob{
+		name:     name,
+		interval: interval,
+		locker:   locker,
+		executor: executor,
+	}
+}
+
+// Run executes the job every interval until the context is done
+func (job *ScheduledJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := job.RunOnce(); err != nil {
+				logger.GetInstance().Error("error running job", zap.Error(err), zap.String("job", job.name))
+			}
+		}
+	}
+}
+
+// RunOnce executes the job when no other replica is running it, it returns false when the job was skipped
+func (job *ScheduledJob) RunOnce() (bool, error) {
+	unlock, locked, err := job.locker.TryLockJob(job.name)
+	if err != nil {
+		return false, err
+	}
+	if !locked {
+		logger.GetInstance().Debug("job running in another replica", zap.String("job", job.name))
+		return false, nil
+	}
+	defer unlock()
+	return true, job.executor.Execute()
+}
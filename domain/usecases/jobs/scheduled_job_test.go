@@ -0,0 +1,75 @@
+package jobs_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/usecases/jobs"
+	"lahaus/domain/usecases/jobs/mocks"
+	"testing"
+	"time"
+)
+
+type ScheduledJobSuite struct {
+	suite.Suite
+	mockCtrl *gomock.Controller
+	locker   *mocks.MockLocker
+	executor *mocks.MockJobExecutor
+	job      *jobs.ScheduledJob
+}
+
+func TestScheduledJobSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledJobSuite))
+}
+
+func (suite *ScheduledJobSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.locker = mocks.NewMockLocker(suite.mockCtrl)
+	suite.executor = mocks.NewMockJobExecutor(suite.mockCtrl)
+	suite.job = jobs.NewScheduledJob("listing-expiry", time.Minute, suite.locker, suite.executor)
+}
+
+func (suite *ScheduledJobSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ScheduledJobSuite) TestScheduledJob_RunOnce() {
+	unlocked := false
+	suite.locker.EXPECT().TryLockJob("listing-expiry").Return(func() { unlocked = true }, true, nil)
+	suite.executor.EXPECT().Execute().DoAndReturn(func() error {
+		suite.False(unlocked)
+		return nil
+	})
+
+	ran, err := suite.job.RunOnce()
+	suite.NoError(err)
+	suite.True(ran)
+	suite.True(unlocked)
+}
+
+func (suite *ScheduledJobSuite) TestScheduledJob_RunOnce_LockedByAnotherReplica() {
+	suite.locker.EXPECT().TryLockJob("listing-expiry").Return(nil, false, nil)
+
+	ran, err := suite.job.RunOnce()
+	suite.NoError(err)
+	suite.False(ran)
+}
+
+func (suite *ScheduledJobSuite) TestScheduledJob_RunOnce_ExecutorError() {
+	unlocked := false
+	suite.locker.EXPECT().TryLockJob("listing-expiry").Return(func() { unlocked = true }, true, nil)
+	suite.executor.EXPECT().Execute().Return(errors.New("fail"))
+
+	ran, err := suite.job.RunOnce()
+	suite.Error(err)
+	suite.True(ran)
+	suite.True(unlocked)
+}
+
+func (suite *ScheduledJobSuite) TestScheduledJob_RunOnce_LockError() {
+	suite.locker.EXPECT().TryLockJob("listing-expiry").Return(nil, false, errors.New("fail"))
+
+	ran, err := suite.job.RunOnce()
+	suite.Error(err)
+	suite.False(ran)
+}
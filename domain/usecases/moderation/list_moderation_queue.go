@@ -0,0 +1,31 @@
+package moderation
+
+import (
+	"lahaus/domain/model"
+	"math"
+)
+
+type ModerationSearchParams struct {
+	Page     int64
+	PageSize int64
+}
+
+type ListModerationQueueUseCase struct {
+	database StorageManager
+}
+
+func NewListModerationQueueUseCase(database StorageManager) *ListModerationQueueUseCase {
+	return &ListModerationQueueUseCase{
+		database: database,
+	}
+}
+
+// Execute lists the properties waiting for review, pending and suspected duplicates, the ones waiting longer first
+func (uc *ListModerationQueueUseCase) Execute(search ModerationSearchParams) (*model.PropertiesPaging, error) {
+	results, err := uc.database.ListModerationQueue(search)
+	if err != nil {
+		return nil, err
+	}
+	results.TotalPages = int64(math.Ceil(float64(results.Total) / float64(results.PageSize)))
+	return results, nil
+}
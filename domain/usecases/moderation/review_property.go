@@ -0,0 +1,119 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"strings"
+)
+
+//go:generate mockgen -destination=./mocks/mock_moderation.go -package=mocks -source=./review_property.go
+
+type StorageManager interface {
+	GetProperty(propertyID int64) (*model.Property, bool, error)
+	GetUserByID(userID int64) (*model.User, bool, error)
+	ListModerationQueue(search ModerationSearchParams) (*model.PropertiesPaging, error)
+	ReviewProperty(property *model.Property, review *model.PropertyReview, audit *model.AuditEntry) (*model.Property, error)
+}
+
+type PropertyRuler interface {
+	Execute(property *model.Property)
+}
+
+// PropertyMatcher receives the properties approved as ACTIVE to match them with the saved searches, it must not block
+type PropertyMatcher interface {
+	Match(property *model.Property)
+}
+
+// Notifier delivers the reviews to the owners
+type Notifier interface {
+	NotifyReview(alert *model.ReviewAlert) error
+}
+
+type ReviewPropertyUseCase struct {
+	database      StorageManager
+	propertyRuler PropertyRuler
+	matcher       PropertyMatcher
+	notifier      Notifier
+}
+
+func NewReviewPropertyUseCase(database StorageManager, propertyRuler PropertyRuler, matcher PropertyMatcher, notifier Notifier) *ReviewPropertyUseCase {
+	return &ReviewPropertyUseCase{
+		database:      database,
+		propertyRuler: propertyRuler,
+		matcher:       matcher,
+		notifier:      notifier,
+	}
+}
+
+// Execute approves or rejects a property waiting for review and notifies the owner
+func (uc *ReviewPropertyUseCase) Execute(review *model.PropertyReview, actor *model.Actor) (*model.Property, error) {
+	current, found, err := uc.database.GetProperty(review.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	if !current.Status.AwaitingReview() {
+		return nil, model.NewDomainError(fmt.Errorf("the property is %s, it is not waiting for review", current.Status))
+	}
+
+	property := *current
+	next := model.REJECTED
+	switch review.Decision {
+	case model.APPROVE:
+		uc.propertyRuler.Execute(&property)
+		next = property.Status
+		property.Status = current.Status
+	case model.REJECT:
+		if review.Reason == nil || strings.TrimSpace(*review.Reason) == "" {
+			return nil, model.NewDomainError(errors.New("the rejection needs a reason"))
+		}
+	default:
+		return nil, model.NewDomainError(fmt.Errorf("invalid decision [%v]", review.Decision))
+	}
+	if err := property.TransitionTo(next); err != nil {
+		return nil, err
+	}
+
+	review.ReviewerID = actor.UserID
+	review.Status = property.Status
+	changes, err := model.AuditChanges(current, &property)
+	if err != nil {
+		return nil, err
+	}
+	if review.Reason != nil {
+		changes["reviewReason"] = model.AuditChange{After: *review.Reason}
+	}
+	audit := model.NewAuditEntry(model.PROPERTY, current.ID, model.STATUS_CHANGE, actor, changes)
+	propertyStored, err := uc.database.ReviewProperty(&property, review, audit)
+	if err != nil {
+		return nil, err
+	}
+	if propertyStored.Status == model.ACTIVE {
+		uc.matcher.Match(propertyStored)
+	}
+	uc.notifyOwner(propertyStored, review)
+	return propertyStored, nil
+}
+
+// notifyOwner only logs the failures, the review is already saved
+func (uc *ReviewPropertyUseCase) notifyOwner(property *model.Property, review *model.PropertyReview) {
+	owner, found, err := uc.database.GetUserByID(property.OwnerID)
+	if err != nil || !found {
+		logger.GetInstance().Error("error getting the owner of the reviewed property", zap.Error(err), zap.Int64("propertyId", property.ID))
+		return
+	}
+	err = uc.notifier.NotifyReview(&model.ReviewAlert{
+		UserID:   owner.ID,
+		Email:    owner.Email,
+		Property: property,
+		Review:   review,
+	})
+	if err != nil {
+		logger.GetInstance().Error("error notifying review", zap.Error(err), zap.Int64("propertyId", property.ID), zap.Int64("userId", owner.ID))
+	}
+}
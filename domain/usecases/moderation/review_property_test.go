@@ -0,0 +1,165 @@
+package moderation_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/moderation"
+	"lahaus/domain/usecases/moderation/mocks"
+	"testing"
+)
+
+type ReviewPropertySuite struct {
+	suite.Suite
+	mockCtrl      *gomock.Controller
+	database      *mocks.MockStorageManager
+	propertyRuler *mocks.MockPropertyRuler
+	matcher       *mocks.MockPropertyMatcher
+	notifier      *mocks.MockNotifier
+	reviewUseCase *moderation.ReviewPropertyUseCase
+	actor         *model.Actor
+}
+
+func TestReviewPropertySuite(t *testing.T) {
+	suite.Run(t, new(ReviewPropertySuite))
+}
+
+func (suite *ReviewPropertySuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.propertyRuler = mocks.NewMockPropertyRuler(suite.mockCtrl)
+	suite.matcher = mocks.NewMockPropertyMatcher(suite.mockCtrl)
+	suite.notifier = mocks.NewMockNotifier(suite.mockCtrl)
+	suite.reviewUseCase = moderation.NewReviewPropertyUseCase(suite.database, suite.propertyRuler, suite.matcher, suite.notifier)
+	suite.actor = &model.Actor{UserID: 9, Role: model.ADMIN, RequestID: "req"}
+}
+
+func (suite *ReviewPropertySuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func pending(status model.PropertyStatus) *model.Property {
+	return &model.Property{ID: 4, Title: "Apartamento", Status: status, OwnerID: 2, Version: 3}
+}
+
+func (suite *ReviewPropertySuite) expectOwnerNotified(status model.PropertyStatus) {
+	suite.database.EXPECT().GetUserByID(int64(2)).Return(&model.User{ID: 2, Email: "owner@mail.com"}, true, nil)
+	suite.notifier.EXPECT().NotifyReview(gomock.Any()).DoAndReturn(func(alert *model.ReviewAlert) error {
+		suite.Equal("owner@mail.com", alert.Email)
+		suite.Equal(status, alert.Review.Status)
+		return nil
+	})
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteSuccess_Approve() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(pending(model.PENDING_REVIEW), true, nil)
+	suite.propertyRuler.EXPECT().Execute(gomock.Any()).Do(func(property *model.Property) {
+		property.Status = model.ACTIVE
+	})
+	suite.database.EXPECT().ReviewProperty(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(property *model.Property, review *model.PropertyReview, audit *model.AuditEntry) (*model.Property, error) {
+			suite.Equal(model.ACTIVE, property.Status)
+			suite.Equal(int64(3), property.Version)
+			suite.Equal(int64(9), review.ReviewerID)
+			suite.Equal(model.STATUS_CHANGE, audit.Action)
+			suite.Equal(model.AuditChange{Before: "PENDING_REVIEW", After: "ACTIVE"}, audit.Changes["status"])
+			return property, nil
+		})
+	suite.matcher.EXPECT().Match(gomock.Any())
+	suite.expectOwnerNotified(model.ACTIVE)
+
+	property, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.APPROVE}, suite.actor)
+	suite.NoError(err)
+	suite.Equal(model.ACTIVE, property.Status)
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteSuccess_ApproveDuplicateInvalid() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(pending(model.DUPLICATE_SUSPECT), true, nil)
+	suite.propertyRuler.EXPECT().Execute(gomock.Any()).Do(func(property *model.Property) {
+		property.Status = model.INVALID
+	})
+	suite.database.EXPECT().ReviewProperty(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(property *model.Property, review *model.PropertyReview, audit *model.AuditEntry) (*model.Property, error) {
+			return property, nil
+		})
+	suite.expectOwnerNotified(model.INVALID)
+
+	property, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.APPROVE}, suite.actor)
+	suite.NoError(err)
+	suite.Equal(model.INVALID, property.Status)
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteSuccess_Reject() {
+	reason := "the photos are not of the property"
+	suite.database.EXPECT().GetProperty(int64(4)).Return(pending(model.PENDING_REVIEW), true, nil)
+	suite.database.EXPECT().ReviewProperty(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(property *model.Property, review *model.PropertyReview, audit *model.AuditEntry) (*model.Property, error) {
+			suite.Equal(model.REJECTED, property.Status)
+			suite.Equal(model.REJECTED, review.Status)
+			suite.Equal(reason, audit.Changes["reviewReason"].After)
+			return property, nil
+		})
+	suite.expectOwnerNotified(model.REJECTED)
+
+	property, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.REJECT, Reason: &reason}, suite.actor)
+	suite.NoError(err)
+	suite.Equal(model.REJECTED, property.Status)
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteSuccess_NotifyError() {
+	reason := "duplicated"
+	suite.database.EXPECT().GetProperty(int64(4)).Return(pending(model.DUPLICATE_SUSPECT), true, nil)
+	suite.database.EXPECT().ReviewProperty(gomock.Any(), gomock.Any(), gomock.Any()).Return(pending(model.REJECTED), nil)
+	suite.database.EXPECT().GetUserByID(int64(2)).Return(&model.User{ID: 2, Email: "owner@mail.com"}, true, nil)
+	suite.notifier.EXPECT().NotifyReview(gomock.Any()).Return(errors.New("fail"))
+
+	_, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.REJECT, Reason: &reason}, suite.actor)
+	suite.NoError(err)
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteError_RejectWithoutReason() {
+	reason := "  "
+	suite.database.EXPECT().GetProperty(int64(4)).Return(pending(model.PENDING_REVIEW), true, nil).Times(2)
+
+	_, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.REJECT}, suite.actor)
+	suite.IsType(&model.DomainError{}, err)
+	_, err = suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.REJECT, Reason: &reason}, suite.actor)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteError_NotAwaitingReview() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(pending(model.ACTIVE), true, nil)
+
+	_, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.APPROVE}, suite.actor)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(nil, false, nil)
+
+	_, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.APPROVE}, suite.actor)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *ReviewPropertySuite) TestReviewPropertyUseCase_ExecuteError_Modified() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(pending(model.PENDING_REVIEW), true, nil)
+	suite.propertyRuler.EXPECT().Execute(gomock.Any()).Do(func(property *model.Property) {
+		property.Status = model.ACTIVE
+	})
+	suite.database.EXPECT().ReviewProperty(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, model.NewPreconditionFailedError(errors.New("the property was modified by another request")))
+
+	_, err := suite.reviewUseCase.Execute(&model.PropertyReview{PropertyID: 4, Decision: model.APPROVE}, suite.actor)
+	suite.IsType(&model.PreconditionFailedError{}, err)
+}
+
+func (suite *ReviewPropertySuite) TestListModerationQueueUseCase_ExecuteSuccess() {
+	search := moderation.ModerationSearchParams{Page: 1, PageSize: 10}
+	suite.database.EXPECT().ListModerationQueue(search).
+		Return(&model.PropertiesPaging{Page: 1, PageSize: 10, Total: 11, Data: []*model.Property{pending(model.PENDING_REVIEW)}}, nil)
+
+	results, err := moderation.NewListModerationQueueUseCase(suite.database).Execute(search)
+	suite.NoError(err)
+	suite.Equal(int64(2), results.TotalPages)
+}
@@ -0,0 +1,61 @@
+package photos
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type DeletePhotoUseCase struct {
+	database StorageManager
+	blobs    BlobStore
+	updater  PropertyUpdater
+}
+
+func NewDeletePhotoUseCase(database StorageManager, blobs BlobStore, updater PropertyUpdater) *DeletePhotoUseCase {
+	return &DeletePhotoUseCase{
+		database: database,
+		blobs:    blobs,
+		updater:  updater,
+	}
+}
+
+// Execute removes the photo from the property and its files from the blob store
+func (uc *DeletePhotoUseCase) Execute(propertyID int64, photoID int64, actor *model.Actor) error {
+	property, err := ownedProperty(uc.database, propertyID, actor)
+	if err != nil {
+		return err
+	}
+	photos, err := uc.database.ListPropertyPhotos(propertyID)
+	if err != nil {
+		return err
+	}
+
+	var deleted *model.Photo
+	remaining := make([]*model.Photo, 0, len(photos))
+	for _, photo := range photos {
+		if photo.ID == photoID {
+			deleted = photo
+			continue
+		}
+		remaining = append(remaining, photo)
+	}
+	if deleted == nil {
+		return model.NewEntityNotFoundError(errors.New("photo not found"))
+	}
+
+	if err := syncProperty(uc.updater, property, remaining, []*model.Photo{deleted}, actor); err != nil {
+		return err
+	}
+	if _, err := uc.database.DeletePropertyPhoto(propertyID, photoID); err != nil {
+		return err
+	}
+	deleteFiles(uc.blobs, deleted)
+	if deleted.Cover && len(remaining) > 0 {
+		sortPhotos(remaining)
+		cover := *remaining[0]
+		cover.Cover = true
+		remaining[0] = &cover
+		return uc.database.UpdatePropertyPhotos(propertyID, remaining)
+	}
+	return nil
+}
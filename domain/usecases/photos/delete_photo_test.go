@@ -0,0 +1,75 @@
+package photos_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/photos"
+	"lahaus/domain/usecases/photos/mocks"
+	"testing"
+)
+
+type DeletePhotoSuite struct {
+	suite.Suite
+	mockCtrl      *gomock.Controller
+	database      *mocks.MockStorageManager
+	blobs         *mocks.MockBlobStore
+	updater       *mocks.MockPropertyUpdater
+	deleteUseCase *photos.DeletePhotoUseCase
+}
+
+func TestDeletePhotoSuite(t *testing.T) {
+	suite.Run(t, new(DeletePhotoSuite))
+}
+
+func (suite *DeletePhotoSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.blobs = mocks.NewMockBlobStore(suite.mockCtrl)
+	suite.updater = mocks.NewMockPropertyUpdater(suite.mockCtrl)
+	suite.deleteUseCase = photos.NewDeletePhotoUseCase(suite.database, suite.blobs, suite.updater)
+}
+
+func (suite *DeletePhotoSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *DeletePhotoSuite) TestDeletePhotoUseCase_ExecuteSuccess_NextCover() {
+	property := &model.Property{ID: 1, OwnerID: agent.UserID, Photos: model.Photos{"https://cdn.lahaus.com/1.jpg", "https://cdn.lahaus.com/2.jpg"}}
+	suite.database.EXPECT().GetProperty(int64(1)).Return(property, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{
+		{ID: 1, Position: 0, Cover: true, URL: "https://cdn.lahaus.com/1.jpg", Keys: []string{"a", "b", "c"}},
+		{ID: 2, Position: 1, URL: "https://cdn.lahaus.com/2.jpg"},
+	}, nil)
+	suite.updater.EXPECT().Execute(gomock.Any(), agent).DoAndReturn(func(updated *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(model.Photos{"https://cdn.lahaus.com/2.jpg"}, updated.Photos)
+		return updated, nil
+	})
+	suite.database.EXPECT().DeletePropertyPhoto(int64(1), int64(1)).Return(true, nil)
+	suite.blobs.EXPECT().Delete(gomock.Any()).Times(3)
+	suite.database.EXPECT().UpdatePropertyPhotos(int64(1), gomock.Any()).DoAndReturn(func(propertyID int64, photos []*model.Photo) error {
+		suite.Len(photos, 1)
+		suite.True(photos[0].Cover)
+		return nil
+	})
+
+	suite.NoError(suite.deleteUseCase.Execute(1, 1, agent))
+}
+
+func (suite *DeletePhotoSuite) TestDeletePhotoUseCase_ExecuteSuccess_NotCover() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{{ID: 1, Cover: true}, {ID: 2, Position: 1, Keys: []string{"a"}}}, nil)
+	suite.updater.EXPECT().Execute(gomock.Any(), agent)
+	suite.database.EXPECT().DeletePropertyPhoto(int64(1), int64(2)).Return(true, nil)
+	suite.blobs.EXPECT().Delete("a")
+
+	suite.NoError(suite.deleteUseCase.Execute(1, 2, agent))
+}
+
+func (suite *DeletePhotoSuite) TestDeletePhotoUseCase_ExecuteError_PhotoNotFound() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{{ID: 1, Cover: true}}, nil)
+
+	err := suite.deleteUseCase.Execute(1, 2, agent)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
@@ -0,0 +1,159 @@
+package photos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"lahaus/config"
+	"lahaus/domain/model"
+)
+
+const megabyte = 1024 * 1024
+
+// maxPhotoPixels bounds the decoded size, so a small file cannot expand to a huge image in memory
+const maxPhotoPixels = 50000000
+
+const jpegQuality = 85
+
+// photoFormat is an accepted content type, recognized by the first bytes of the file instead of the declared type
+type photoFormat struct {
+	contentType string
+	extension   string
+	magic       string
+}
+
+var photoFormats = []photoFormat{
+	{contentType: "image/jpeg", extension: "jpg", magic: "\xff\xd8\xff"},
+	{contentType: "image/png", extension: "png", magic: "\x89PNG\r\n\x1a\n"},
+}
+
+type processedPhoto struct {
+	format    photoFormat
+	width     int
+	height    int
+	original  []byte
+	web       []byte
+	thumbnail []byte
+}
+
+// processPhoto validates the upload and encodes it again without the EXIF data
+func processPhoto(data []byte, conf *config.Photos) (*processedPhoto, error) {
+	if len(data) > conf.MaxSizeInMB*megabyte {
+		return nil, model.NewPayloadTooLargeError(fmt.Errorf("the photo exceeds %d MB", conf.MaxSizeInMB))
+	}
+	format, found := detectFormat(data)
+	if !found {
+		return nil, model.NewUnsupportedMediaTypeError(errors.New("the photo must be a JPEG or PNG image"))
+	}
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, model.NewDomainError(fmt.Errorf("the photo cannot be decoded: %v", err))
+	}
+	if imageConfig.Width*imageConfig.Height > maxPhotoPixels {
+		return nil, model.NewDomainError(fmt.Errorf("the photo exceeds %d pixels", maxPhotoPixels))
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, model.NewDomainError(fmt.Errorf("the photo cannot be decoded: %v", err))
+	}
+
+	img := toRGBA(decoded)
+	if format.contentType == "image/jpeg" {
+		img = orient(img, exifOrientation(data))
+	}
+	photo := &processedPhoto{
+		format: format,
+		width:  img.Bounds().Dx(),
+		height: img.Bounds().Dy(),
+	}
+	if photo.original, err = encodePhoto(img, format); err != nil {
+		return nil, err
+	}
+	if photo.web, err = encodePhoto(resizeToWidth(img, conf.WebWidth), format); err != nil {
+		return nil, err
+	}
+	if photo.thumbnail, err = encodePhoto(resizeToWidth(img, conf.ThumbnailWidth), format); err != nil {
+		return nil, err
+	}
+	return photo, nil
+}
+
+func detectFormat(data []byte) (photoFormat, bool) {
+	for _, format := range photoFormats {
+		if bytes.HasPrefix(data, []byte(format.magic)) {
+			return format, true
+		}
+	}
+	return photoFormat{}, false
+}
+
+func encodePhoto(img image.Image, format photoFormat) ([]byte, error) {
+	var buffer bytes.Buffer
+	var err error
+	if format.contentType == "image/png" {
+		err = png.Encode(&buffer, img)
+	} else {
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func toRGBA(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+	return dst
+}
+
+// resizeToWidth scales the image down to the width keeping its proportions
+func resizeToWidth(src *image.RGBA, width int) *image.RGBA {
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+	if width <= 0 || srcWidth <= width {
+		return src
+	}
+	height := srcHeight * width / srcWidth
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		fromY, toY := span(y, height, srcHeight)
+		for x := 0; x < width; x++ {
+			fromX, toX := span(x, width, srcWidth)
+			var sum [4]int
+			for sy := fromY; sy < toY; sy++ {
+				offset := src.PixOffset(fromX, sy)
+				for sx := fromX; sx < toX; sx++ {
+					for channel := 0; channel < 4; channel++ {
+						sum[channel] += int(src.Pix[offset+channel])
+					}
+					offset += 4
+				}
+			}
+			count := (toY - fromY) * (toX - fromX)
+			offset := dst.PixOffset(x, y)
+			for channel := 0; channel < 4; channel++ {
+				dst.Pix[offset+channel] = uint8(sum[channel] / count)
+			}
+		}
+	}
+	return dst
+}
+
+// span returns the source pixels covered by the destination pixel, at least one
+func span(position, size, srcSize int) (int, int) {
+	from := position * srcSize / size
+	to := (position + 1) * srcSize / size
+	if to <= from {
+		to = from + 1
+	}
+	return from, to
+}
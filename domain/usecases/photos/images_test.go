@@ -0,0 +1,110 @@
+package photos
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"testing"
+)
+
+var photosConf = &config.Photos{MaxSizeInMB: 1, MaxPerProperty: 3, WebWidth: 10, ThumbnailWidth: 5}
+
+// exifJPEG encodes a JPEG with an EXIF segment holding the orientation and a GPS entry
+func exifJPEG(t *testing.T, width, height int, orientation uint16) []byte {
+	var encoded bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = append(tiff, 0x00, 0x02)
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry, exifOrientationTag)
+	binary.BigEndian.PutUint16(entry[2:], 3)
+	binary.BigEndian.PutUint32(entry[4:], 1)
+	binary.BigEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0x88, 0x25, 0x00, 0x02, 0x00, 0x00, 0x00, 0x02, 'N', 0x00, 0x00, 0x00)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00)
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	app1 := []byte{0xff, 0xe1, 0x00, 0x00}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+	data := append([]byte{0xff, 0xd8}, app1...)
+	data = append(data, segment...)
+	return append(data, encoded.Bytes()[2:]...)
+}
+
+func TestProcessPhoto_OrientsResizesAndStripsExif(t *testing.T) {
+	data := exifJPEG(t, 40, 20, 6)
+	assert.Equal(t, 6, exifOrientation(data))
+
+	photo, err := processPhoto(data, photosConf)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", photo.format.contentType)
+	assert.Equal(t, 20, photo.width)
+	assert.Equal(t, 40, photo.height)
+	for _, encoded := range [][]byte{photo.original, photo.web, photo.thumbnail} {
+		assert.False(t, bytes.Contains(encoded, []byte("Exif")))
+		assert.Equal(t, 1, exifOrientation(encoded))
+	}
+
+	web, _, err := image.DecodeConfig(bytes.NewReader(photo.web))
+	assert.NoError(t, err)
+	assert.Equal(t, 10, web.Width)
+	assert.Equal(t, 20, web.Height)
+	thumbnail, _, err := image.DecodeConfig(bytes.NewReader(photo.thumbnail))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, thumbnail.Width)
+	assert.Equal(t, 10, thumbnail.Height)
+}
+
+func TestProcessPhoto_Errors(t *testing.T) {
+	_, err := processPhoto(exifJPEG(t, 4, 4, 1), &config.Photos{MaxSizeInMB: 0})
+	assert.IsType(t, &model.PayloadTooLargeError{}, err)
+
+	_, err = processPhoto([]byte("GIF89a..."), photosConf)
+	assert.IsType(t, &model.UnsupportedMediaTypeError{}, err)
+
+	_, err = processPhoto([]byte("\xff\xd8\xff\xe0 not a jpeg"), photosConf)
+	assert.IsType(t, &model.DomainError{}, err)
+}
+
+func TestOrient(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.SetRGBA(0, 0, red)
+	src.SetRGBA(1, 0, blue)
+
+	rotated := orient(src, 6)
+	assert.Equal(t, image.Rect(0, 0, 1, 2), rotated.Bounds())
+	assert.Equal(t, red, rotated.RGBAAt(0, 0))
+	assert.Equal(t, blue, rotated.RGBAAt(0, 1))
+
+	rotated = orient(src, 8)
+	assert.Equal(t, blue, rotated.RGBAAt(0, 0))
+	assert.Equal(t, red, rotated.RGBAAt(0, 1))
+
+	flipped := orient(src, 2)
+	assert.Equal(t, blue, flipped.RGBAAt(0, 0))
+	assert.Equal(t, src, orient(src, 1))
+}
+
+func TestResizeToWidth(t *testing.T) {
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		src.SetRGBA(0, y, white)
+		src.SetRGBA(1, y, white)
+	}
+
+	resized := resizeToWidth(src, 2)
+	assert.Equal(t, image.Rect(0, 0, 2, 1), resized.Bounds())
+	assert.Equal(t, white, resized.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{}, resized.RGBAAt(1, 0))
+	assert.Equal(t, src, resizeToWidth(src, 8))
+}
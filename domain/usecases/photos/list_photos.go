@@ -0,0 +1,33 @@
+package photos
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type ListPhotosUseCase struct {
+	database StorageManager
+}
+
+func NewListPhotosUseCase(database StorageManager) *ListPhotosUseCase {
+	return &ListPhotosUseCase{
+		database: database,
+	}
+}
+
+// Execute returns the uploaded photos of the property, the cover first
+func (uc *ListPhotosUseCase) Execute(propertyID int64, user *model.User) ([]*model.Photo, error) {
+	property, found, err := uc.database.GetProperty(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found || (property.Status.Hidden() && (user == nil || (user.Role != model.ADMIN && user.ID != property.OwnerID))) {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	photos, err := uc.database.ListPropertyPhotos(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	sortPhotos(photos)
+	return photos, nil
+}
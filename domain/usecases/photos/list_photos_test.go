@@ -0,0 +1,56 @@
+package photos_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/photos"
+	"lahaus/domain/usecases/photos/mocks"
+	"testing"
+)
+
+type ListPhotosSuite struct {
+	suite.Suite
+	mockCtrl    *gomock.Controller
+	database    *mocks.MockStorageManager
+	listUseCase *photos.ListPhotosUseCase
+}
+
+func TestListPhotosSuite(t *testing.T) {
+	suite.Run(t, new(ListPhotosSuite))
+}
+
+func (suite *ListPhotosSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.listUseCase = photos.NewListPhotosUseCase(suite.database)
+}
+
+func (suite *ListPhotosSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ListPhotosSuite) TestListPhotosUseCase_ExecuteSuccess_CoverFirst() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.ACTIVE}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{{ID: 1, Position: 0}, {ID: 2, Position: 1, Cover: true}}, nil)
+
+	result, err := suite.listUseCase.Execute(1, nil)
+	suite.NoError(err)
+	suite.Equal(int64(2), result[0].ID)
+	suite.Equal(int64(1), result[1].ID)
+}
+
+func (suite *ListPhotosSuite) TestListPhotosUseCase_ExecuteSuccess_HiddenOwner() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.PENDING_REVIEW, OwnerID: 7}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{}, nil)
+
+	_, err := suite.listUseCase.Execute(1, &model.User{ID: 7, Role: model.AGENT})
+	suite.NoError(err)
+}
+
+func (suite *ListPhotosSuite) TestListPhotosUseCase_ExecuteError_Hidden() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.INVALID, OwnerID: 9}, true, nil)
+
+	_, err := suite.listUseCase.Execute(1, &model.User{ID: 7, Role: model.AGENT})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
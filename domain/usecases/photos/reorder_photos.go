@@ -0,0 +1,62 @@
+package photos
+
+import (
+	"fmt"
+	"lahaus/domain/model"
+)
+
+type ReorderPhotosUseCase struct {
+	database StorageManager
+	updater  PropertyUpdater
+}
+
+func NewReorderPhotosUseCase(database StorageManager, updater PropertyUpdater) *ReorderPhotosUseCase {
+	return &ReorderPhotosUseCase{
+		database: database,
+		updater:  updater,
+	}
+}
+
+// Execute sets the positions of the photos of the property in the order of the ids
+func (uc *ReorderPhotosUseCase) Execute(propertyID int64, photoIDs []int64, actor *model.Actor) ([]*model.Photo, error) {
+	property, err := ownedProperty(uc.database, propertyID, actor)
+	if err != nil {
+		return nil, err
+	}
+	photos, err := uc.database.ListPropertyPhotos(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(photoIDs) != len(photos) {
+		return nil, model.NewDomainError(fmt.Errorf("the order must list the %d photos of the property", len(photos)))
+	}
+
+	byID := make(map[int64]*model.Photo, len(photos))
+	for _, photo := range photos {
+		byID[photo.ID] = photo
+	}
+	ordered := make([]*model.Photo, 0, len(photos))
+	for position, photoID := range photoIDs {
+		photo, found := byID[photoID]
+		if !found {
+			return nil, model.NewDomainError(fmt.Errorf("the photo %d is not in the property or is repeated", photoID))
+		}
+		delete(byID, photoID)
+		reordered := *photo
+		reordered.Position = position
+		ordered = append(ordered, &reordered)
+	}
+	return savePhotos(uc.database, uc.updater, property, ordered, actor)
+}
+
+// savePhotos lists the photos in the property and then stores their positions and cover
+func savePhotos(database StorageManager, updater PropertyUpdater, property *model.Property, photos []*model.Photo, actor *model.Actor) ([]*model.Photo, error) {
+	if err := syncProperty(updater, property, photos, nil, actor); err != nil {
+		return nil, err
+	}
+	if err := database.UpdatePropertyPhotos(property.ID, photos); err != nil {
+		return nil, err
+	}
+	sortPhotos(photos)
+	return photos, nil
+}
@@ -0,0 +1,77 @@
+package photos_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/photos"
+	"lahaus/domain/usecases/photos/mocks"
+	"testing"
+)
+
+type ReorderPhotosSuite struct {
+	suite.Suite
+	mockCtrl       *gomock.Controller
+	database       *mocks.MockStorageManager
+	updater        *mocks.MockPropertyUpdater
+	reorderUseCase *photos.ReorderPhotosUseCase
+}
+
+func TestReorderPhotosSuite(t *testing.T) {
+	suite.Run(t, new(ReorderPhotosSuite))
+}
+
+func (suite *ReorderPhotosSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.updater = mocks.NewMockPropertyUpdater(suite.mockCtrl)
+	suite.reorderUseCase = photos.NewReorderPhotosUseCase(suite.database, suite.updater)
+}
+
+func (suite *ReorderPhotosSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ReorderPhotosSuite) storedPhotos() []*model.Photo {
+	return []*model.Photo{
+		{ID: 1, Position: 0, Cover: true, URL: "https://cdn.lahaus.com/1.jpg"},
+		{ID: 2, Position: 1, URL: "https://cdn.lahaus.com/2.jpg"},
+		{ID: 3, Position: 2, URL: "https://cdn.lahaus.com/3.jpg"},
+	}
+}
+
+func (suite *ReorderPhotosSuite) TestReorderPhotosUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return(suite.storedPhotos(), nil)
+	suite.updater.EXPECT().Execute(gomock.Any(), agent).DoAndReturn(func(updated *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(model.Photos{"https://cdn.lahaus.com/1.jpg", "https://cdn.lahaus.com/3.jpg", "https://cdn.lahaus.com/2.jpg"}, updated.Photos)
+		return updated, nil
+	})
+	suite.database.EXPECT().UpdatePropertyPhotos(int64(1), gomock.Any()).DoAndReturn(func(propertyID int64, photos []*model.Photo) error {
+		suite.Equal(int64(3), photos[0].ID)
+		suite.Equal(0, photos[0].Position)
+		suite.Equal(2, photos[2].Position)
+		return nil
+	})
+
+	result, err := suite.reorderUseCase.Execute(1, []int64{3, 1, 2}, agent)
+	suite.NoError(err)
+	suite.Equal(int64(1), result[0].ID)
+	suite.Equal(int64(3), result[1].ID)
+}
+
+func (suite *ReorderPhotosSuite) TestReorderPhotosUseCase_ExecuteError_Repeated() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return(suite.storedPhotos(), nil)
+
+	_, err := suite.reorderUseCase.Execute(1, []int64{3, 3, 2}, agent)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ReorderPhotosSuite) TestReorderPhotosUseCase_ExecuteError_Incomplete() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return(suite.storedPhotos(), nil)
+
+	_, err := suite.reorderUseCase.Execute(1, []int64{3, 1}, agent)
+	suite.IsType(&model.DomainError{}, err)
+}
@@ -0,0 +1,43 @@
+package photos
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type SetCoverPhotoUseCase struct {
+	database StorageManager
+	updater  PropertyUpdater
+}
+
+func NewSetCoverPhotoUseCase(database StorageManager, updater PropertyUpdater) *SetCoverPhotoUseCase {
+	return &SetCoverPhotoUseCase{
+		database: database,
+		updater:  updater,
+	}
+}
+
+// Execute makes the photo the cover of the property, the first one listed in its photos
+func (uc *SetCoverPhotoUseCase) Execute(propertyID int64, photoID int64, actor *model.Actor) ([]*model.Photo, error) {
+	property, err := ownedProperty(uc.database, propertyID, actor)
+	if err != nil {
+		return nil, err
+	}
+	photos, err := uc.database.ListPropertyPhotos(propertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	updated := make([]*model.Photo, 0, len(photos))
+	for _, photo := range photos {
+		photoUpdated := *photo
+		photoUpdated.Cover = photo.ID == photoID
+		found = found || photoUpdated.Cover
+		updated = append(updated, &photoUpdated)
+	}
+	if !found {
+		return nil, model.NewEntityNotFoundError(errors.New("photo not found"))
+	}
+	return savePhotos(uc.database, uc.updater, property, updated, actor)
+}
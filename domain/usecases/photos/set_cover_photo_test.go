@@ -0,0 +1,60 @@
+package photos_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/photos"
+	"lahaus/domain/usecases/photos/mocks"
+	"testing"
+)
+
+type SetCoverPhotoSuite struct {
+	suite.Suite
+	mockCtrl     *gomock.Controller
+	database     *mocks.MockStorageManager
+	updater      *mocks.MockPropertyUpdater
+	coverUseCase *photos.SetCoverPhotoUseCase
+}
+
+func TestSetCoverPhotoSuite(t *testing.T) {
+	suite.Run(t, new(SetCoverPhotoSuite))
+}
+
+func (suite *SetCoverPhotoSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.updater = mocks.NewMockPropertyUpdater(suite.mockCtrl)
+	suite.coverUseCase = photos.NewSetCoverPhotoUseCase(suite.database, suite.updater)
+}
+
+func (suite *SetCoverPhotoSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *SetCoverPhotoSuite) TestSetCoverPhotoUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{
+		{ID: 1, Position: 0, Cover: true, URL: "https://cdn.lahaus.com/1.jpg"},
+		{ID: 2, Position: 1, URL: "https://cdn.lahaus.com/2.jpg"},
+	}, nil)
+	suite.updater.EXPECT().Execute(gomock.Any(), agent).DoAndReturn(func(updated *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(model.Photos{"https://cdn.lahaus.com/2.jpg", "https://cdn.lahaus.com/1.jpg"}, updated.Photos)
+		return updated, nil
+	})
+	suite.database.EXPECT().UpdatePropertyPhotos(int64(1), gomock.Any())
+
+	result, err := suite.coverUseCase.Execute(1, 2, agent)
+	suite.NoError(err)
+	suite.True(result[0].Cover)
+	suite.Equal(int64(2), result[0].ID)
+	suite.False(result[1].Cover)
+}
+
+func (suite *SetCoverPhotoSuite) TestSetCoverPhotoUseCase_ExecuteError_PhotoNotFound() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{{ID: 1, Cover: true}}, nil)
+
+	_, err := suite.coverUseCase.Execute(1, 2, agent)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
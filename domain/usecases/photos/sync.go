@@ -0,0 +1,46 @@
+package photos
+
+import (
+	"lahaus/domain/model"
+	"sort"
+)
+
+// syncProperty lists the uploaded photos in the property followed by the URLs that were not uploaded
+func syncProperty(updater PropertyUpdater, current *model.Property, uploaded []*model.Photo, removed []*model.Photo, actor *model.Actor) error {
+	property := *current
+	property.Photos = photoURLs(current.Photos, uploaded, removed)
+	_, err := updater.Execute(&property, actor)
+	return err
+}
+
+func photoURLs(current model.Photos, uploaded []*model.Photo, removed []*model.Photo) model.Photos {
+	ordered := make([]*model.Photo, len(uploaded))
+	copy(ordered, uploaded)
+	sortPhotos(ordered)
+
+	managed := map[string]bool{}
+	urls := model.Photos{}
+	for _, photo := range removed {
+		managed[photo.URL] = true
+	}
+	for _, photo := range ordered {
+		managed[photo.URL] = true
+		urls = append(urls, photo.URL)
+	}
+	for _, url := range current {
+		if !managed[url] {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// sortPhotos orders the photos as they are listed, the cover first and then by position
+func sortPhotos(photos []*model.Photo) {
+	sort.SliceStable(photos, func(i, j int) bool {
+		if photos[i].Cover != photos[j].Cover {
+			return photos[i].Cover
+		}
+		return photos[i].Position < photos[j].Position
+	})
+}
@@ -0,0 +1,149 @@
+package photos
+
+//go:generate mockgen -destination=./mocks/mock_upload_photo.go -package=mocks -source=./upload_photo.go
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/internal/token"
+)
+
+type StorageManager interface {
+	GetProperty(propertyID int64) (*model.Property, bool, error)
+	ListPropertyPhotos(propertyID int64) ([]*model.Photo, error)
+	SavePropertyPhoto(photo *model.Photo) error
+	UpdatePropertyPhotos(propertyID int64, photos []*model.Photo) error
+	DeletePropertyPhoto(propertyID int64, photoID int64) (bool, error)
+}
+
+// BlobStore keeps the files of the photos, URL is where the clients download them from
+type BlobStore interface {
+	Put(key string, contentType string, data []byte) error
+	Delete(key string) error
+	URL(key string) string
+}
+
+// PropertyUpdater saves the photos listed in the property as any other edit
+type PropertyUpdater interface {
+	Execute(property *model.Property, actor *model.Actor) (*model.Property, error)
+}
+
+type UploadPhotoUseCase struct {
+	conf     *config.Photos
+	database StorageManager
+	blobs    BlobStore
+	updater  PropertyUpdater
+}
+
+func NewUploadPhotoUseCase(conf *config.Photos, database StorageManager, blobs BlobStore, updater PropertyUpdater) *UploadPhotoUseCase {
+	return &UploadPhotoUseCase{
+		conf:     conf,
+		database: database,
+		blobs:    blobs,
+		updater:  updater,
+	}
+}
+
+// Execute adds the photo to the property of the actor, admins can add photos to any property
+func (uc *UploadPhotoUseCase) Execute(propertyID int64, data []byte, actor *model.Actor) (*model.Photo, error) {
+	property, err := ownedProperty(uc.database, propertyID, actor)
+	if err != nil {
+		return nil, err
+	}
+	photos, err := uc.database.ListPropertyPhotos(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(photos) >= uc.conf.MaxPerProperty {
+		return nil, model.NewDomainError(fmt.Errorf("the property already has %d photos", uc.conf.MaxPerProperty))
+	}
+	processed, err := processPhoto(data, uc.conf)
+	if err != nil {
+		return nil, err
+	}
+
+	photo, err := uc.store(propertyID, processed)
+	if err != nil {
+		return nil, err
+	}
+	photo.Position = nextPosition(photos)
+	photo.Cover = len(photos) == 0
+	if err := uc.database.SavePropertyPhoto(photo); err != nil {
+		deleteFiles(uc.blobs, photo)
+		return nil, err
+	}
+	if err := syncProperty(uc.updater, property, append(photos, photo), nil, actor); err != nil {
+		if _, deleteErr := uc.database.DeletePropertyPhoto(propertyID, photo.ID); deleteErr == nil {
+			deleteFiles(uc.blobs, photo)
+		}
+		return nil, err
+	}
+	return photo, nil
+}
+
+func (uc *UploadPhotoUseCase) store(propertyID int64, processed *processedPhoto) (*model.Photo, error) {
+	name, err := token.Generate()
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("properties/%d/%s", propertyID, name)
+	photo := &model.Photo{
+		PropertyID:  propertyID,
+		ContentType: processed.format.contentType,
+		Width:       processed.width,
+		Height:      processed.height,
+	}
+	files := []struct {
+		size string
+		data []byte
+		url  *string
+	}{
+		{size: "original", data: processed.original, url: &photo.OriginalURL},
+		{size: "web", data: processed.web, url: &photo.URL},
+		{size: "thumb", data: processed.thumbnail, url: &photo.ThumbnailURL},
+	}
+	for _, file := range files {
+		key := fmt.Sprintf("%s/%s.%s", prefix, file.size, processed.format.extension)
+		if err := uc.blobs.Put(key, processed.format.contentType, file.data); err != nil {
+			deleteFiles(uc.blobs, photo)
+			return nil, err
+		}
+		photo.Keys = append(photo.Keys, key)
+		*file.url = uc.blobs.URL(key)
+	}
+	return photo, nil
+}
+
+// deleteFiles is best effort, a file left behind is not referenced by any photo
+func deleteFiles(blobs BlobStore, photo *model.Photo) {
+	for _, key := range photo.Keys {
+		_ = blobs.Delete(key)
+	}
+}
+
+// ownedProperty returns the property when the actor owns it or is an admin
+func ownedProperty(database StorageManager, propertyID int64, actor *model.Actor) (*model.Property, error) {
+	property, found, err := database.GetProperty(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	if actor.Role != model.ADMIN && property.OwnerID != actor.UserID {
+		return nil, model.NewForbiddenError(errors.New("the property belongs to another user"))
+	}
+	return property, nil
+}
+
+func nextPosition(photos []*model.Photo) int {
+	position := 0
+	for _, photo := range photos {
+		if photo.Position >= position {
+			position = photo.Position + 1
+		}
+	}
+	return position
+}
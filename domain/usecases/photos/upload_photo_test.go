@@ -0,0 +1,161 @@
+package photos_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"image"
+	"image/png"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/photos"
+	"lahaus/domain/usecases/photos/mocks"
+	"strings"
+	"testing"
+)
+
+var agent = &model.Actor{UserID: 7, Role: model.AGENT}
+
+func pngPhoto() []byte {
+	var data bytes.Buffer
+	_ = png.Encode(&data, image.NewRGBA(image.Rect(0, 0, 20, 10)))
+	return data.Bytes()
+}
+
+type UploadPhotoSuite struct {
+	suite.Suite
+	mockCtrl      *gomock.Controller
+	database      *mocks.MockStorageManager
+	blobs         *mocks.MockBlobStore
+	updater       *mocks.MockPropertyUpdater
+	uploadUseCase *photos.UploadPhotoUseCase
+}
+
+func TestUploadPhotoSuite(t *testing.T) {
+	suite.Run(t, new(UploadPhotoSuite))
+}
+
+func (suite *UploadPhotoSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.blobs = mocks.NewMockBlobStore(suite.mockCtrl)
+	suite.updater = mocks.NewMockPropertyUpdater(suite.mockCtrl)
+	conf := &config.Photos{MaxSizeInMB: 1, MaxPerProperty: 2, WebWidth: 10, ThumbnailWidth: 5}
+	suite.uploadUseCase = photos.NewUploadPhotoUseCase(conf, suite.database, suite.blobs, suite.updater)
+}
+
+func (suite *UploadPhotoSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *UploadPhotoSuite) expectStoredFiles() {
+	suite.blobs.EXPECT().Put(gomock.Any(), "image/png", gomock.Any()).Times(3).Return(nil)
+	suite.blobs.EXPECT().URL(gomock.Any()).Times(3).DoAndReturn(func(key string) string {
+		return "https://cdn.lahaus.com/" + key
+	})
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteSuccess_FirstPhotoIsCover() {
+	property := &model.Property{ID: 1, OwnerID: agent.UserID, Version: 3, Photos: model.Photos{"https://example.com/a.jpg"}}
+	suite.database.EXPECT().GetProperty(int64(1)).Return(property, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{}, nil)
+	suite.expectStoredFiles()
+	suite.database.EXPECT().SavePropertyPhoto(gomock.Any()).DoAndReturn(func(photo *model.Photo) error {
+		photo.ID = 5
+		return nil
+	})
+	suite.updater.EXPECT().Execute(gomock.Any(), agent).DoAndReturn(func(updated *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(int64(3), updated.Version)
+		suite.Len(updated.Photos, 2)
+		suite.True(strings.HasSuffix(updated.Photos[0], "/web.png"))
+		suite.Equal("https://example.com/a.jpg", updated.Photos[1])
+		return updated, nil
+	})
+
+	photo, err := suite.uploadUseCase.Execute(1, pngPhoto(), agent)
+	suite.NoError(err)
+	suite.Equal(int64(5), photo.ID)
+	suite.True(photo.Cover)
+	suite.Equal(0, photo.Position)
+	suite.Equal(20, photo.Width)
+	suite.Len(photo.Keys, 3)
+	suite.True(strings.HasPrefix(photo.Keys[0], "properties/1/"))
+	suite.True(strings.HasSuffix(photo.ThumbnailURL, "/thumb.png"))
+	suite.True(strings.HasSuffix(photo.OriginalURL, "/original.png"))
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteSuccess_NextPosition() {
+	cover := &model.Photo{ID: 2, Position: 0, Cover: true, URL: "https://cdn.lahaus.com/cover.png"}
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: 9}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{cover}, nil)
+	suite.expectStoredFiles()
+	suite.database.EXPECT().SavePropertyPhoto(gomock.Any())
+	suite.updater.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(updated *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(cover.URL, updated.Photos[0])
+		return updated, nil
+	})
+
+	photo, err := suite.uploadUseCase.Execute(1, pngPhoto(), &model.Actor{UserID: 1, Role: model.ADMIN})
+	suite.NoError(err)
+	suite.False(photo.Cover)
+	suite.Equal(1, photo.Position)
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteError_UpdateFailsRemovesPhoto() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{}, nil)
+	suite.expectStoredFiles()
+	suite.database.EXPECT().SavePropertyPhoto(gomock.Any()).DoAndReturn(func(photo *model.Photo) error {
+		photo.ID = 5
+		return nil
+	})
+	suite.updater.EXPECT().Execute(gomock.Any(), agent).Return(nil, model.NewPreconditionFailedError(errors.New("the property has version 4")))
+	suite.database.EXPECT().DeletePropertyPhoto(int64(1), int64(5)).Return(true, nil)
+	suite.blobs.EXPECT().Delete(gomock.Any()).Times(3)
+
+	_, err := suite.uploadUseCase.Execute(1, pngPhoto(), agent)
+	suite.IsType(&model.PreconditionFailedError{}, err)
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteError_StoreFails() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{}, nil)
+	suite.blobs.EXPECT().Put(gomock.Any(), "image/png", gomock.Any()).Return(nil)
+	suite.blobs.EXPECT().URL(gomock.Any()).Return("https://cdn.lahaus.com/original.png")
+	suite.blobs.EXPECT().Put(gomock.Any(), "image/png", gomock.Any()).Return(errors.New("unavailable"))
+	suite.blobs.EXPECT().Delete(gomock.Any())
+
+	_, err := suite.uploadUseCase.Execute(1, pngPhoto(), agent)
+	suite.EqualError(err, "unavailable")
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteError_LimitReached() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{{ID: 1}, {ID: 2}}, nil)
+
+	_, err := suite.uploadUseCase.Execute(1, pngPhoto(), agent)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteError_UnsupportedMediaType() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().ListPropertyPhotos(int64(1)).Return([]*model.Photo{}, nil)
+
+	_, err := suite.uploadUseCase.Execute(1, []byte("%PDF-1.4"), agent)
+	suite.IsType(&model.UnsupportedMediaTypeError{}, err)
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteError_AnotherOwner() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, OwnerID: 9}, true, nil)
+
+	_, err := suite.uploadUseCase.Execute(1, pngPhoto(), agent)
+	suite.IsType(&model.ForbiddenError{}, err)
+}
+
+func (suite *UploadPhotoSuite) TestUploadPhotoUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, nil)
+
+	_, err := suite.uploadUseCase.Execute(1, pngPhoto(), agent)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
@@ -0,0 +1,48 @@
+package pricedrops
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+)
+
+type NotifyPriceDropExecutor interface {
+	Execute(change *model.PriceChange) error
+}
+
+// BackgroundWatcher notifies the price drops outside of the request that updated the property
+type BackgroundWatcher struct {
+	notifier NotifyPriceDropExecutor
+	queue    chan *model.PriceChange
+}
+
+func NewBackgroundWatcher(notifier NotifyPriceDropExecutor, queueSize int) *BackgroundWatcher {
+	return &BackgroundWatcher{
+		notifier: notifier,
+		queue:    make(chan *model.PriceChange, queueSize),
+	}
+}
+
+// PriceDropped queues the change without blocking, when the queue is full the change is dropped
+func (w *BackgroundWatcher) PriceDropped(change *model.PriceChange) {
+	select {
+	case w.queue <- change:
+	default:
+		logger.GetInstance().Warn("price drops queue is full, change dropped", zap.Int64("propertyId", change.PropertyID))
+	}
+}
+
+// Run notifies the queued changes one at a time until the context is done
+func (w *BackgroundWatcher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change := <-w.queue:
+			if err := w.notifier.Execute(change); err != nil {
+				logger.GetInstance().Error("error notifying price drop", zap.Error(err), zap.Int64("propertyId", change.PropertyID))
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package pricedrops
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+)
+
+//go:generate mockgen -destination=./mocks/mock_price_drop.go -package=mocks -source=./notify_price_drop.go
+
+type StorageManager interface {
+	GetProperty(propertyID int64) (*model.Property, bool, error)
+	ListPriceDropWatchers(propertyID int64) ([]*model.PriceDropWatcher, error)
+	SavePriceDropNotification(userID, priceChangeID int64) (bool, error)
+	GetPriceDropSettings(userID int64) (*model.PriceDropSettings, error)
+	SavePriceDropSettings(settings *model.PriceDropSettings) (*model.PriceDropSettings, error)
+}
+
+// Notifier delivers the price drops
+type Notifier interface {
+	NotifyPriceDrop(alert *model.PriceDropAlert) error
+}
+
+type NotifyPriceDropUseCase struct {
+	database StorageManager
+	notifier Notifier
+}
+
+func NewNotifyPriceDropUseCase(database StorageManager, notifier Notifier) *NotifyPriceDropUseCase {
+	return &NotifyPriceDropUseCase{
+		database: database,
+		notifier: notifier,
+	}
+}
+
+// Execute notifies the users that saved the ACTIVE property when the drop reaches their threshold
+func (uc *NotifyPriceDropUseCase) Execute(change *model.PriceChange) error {
+	if change.PreviousPrice <= 0 || change.NewPrice >= change.PreviousPrice {
+		return nil
+	}
+
+	property, found, err := uc.database.GetProperty(change.PropertyID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	if property.Status != model.ACTIVE {
+		return nil
+	}
+
+	watchers, err := uc.database.ListPriceDropWatchers(property.ID)
+	if err != nil {
+		return err
+	}
+
+	dropPercent := float64(change.PreviousPrice-change.NewPrice) * 100 / float64(change.PreviousPrice)
+	for _, watcher := range watchers {
+		if !watcher.Enabled || dropPercent < float64(watcher.MinDropPercent) {
+			continue
+		}
+		created, err := uc.database.SavePriceDropNotification(watcher.UserID, change.ID)
+		if err != nil {
+			return err
+		}
+		if !created {
+			continue
+		}
+		err = uc.notifier.NotifyPriceDrop(&model.PriceDropAlert{
+			UserID:        watcher.UserID,
+			Email:         watcher.Email,
+			Property:      property,
+			PreviousPrice: change.PreviousPrice,
+			NewPrice:      change.NewPrice,
+		})
+		if err != nil {
+			logger.GetInstance().Error("error notifying price drop", zap.Error(err), zap.Int64("propertyId", property.ID),
+				zap.Int64("userId", watcher.UserID))
+		}
+	}
+	return nil
+}
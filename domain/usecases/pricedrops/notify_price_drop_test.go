@@ -0,0 +1,87 @@
+package pricedrops_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/pricedrops"
+	"lahaus/domain/usecases/pricedrops/mocks"
+	"testing"
+)
+
+type NotifyPriceDropSuite struct {
+	suite.Suite
+	mockCtrl      *gomock.Controller
+	database      *mocks.MockStorageManager
+	notifier      *mocks.MockNotifier
+	notifyUseCase *pricedrops.NotifyPriceDropUseCase
+}
+
+func TestNotifyPriceDropSuite(t *testing.T) {
+	suite.Run(t, new(NotifyPriceDropSuite))
+}
+
+func (suite *NotifyPriceDropSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.notifier = mocks.NewMockNotifier(suite.mockCtrl)
+	suite.notifyUseCase = pricedrops.NewNotifyPriceDropUseCase(suite.database, suite.notifier)
+}
+
+func (suite *NotifyPriceDropSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func watcher(userID int64, enabled bool, minDropPercent int) *model.PriceDropWatcher {
+	return &model.PriceDropWatcher{
+		PriceDropSettings: model.PriceDropSettings{UserID: userID, Enabled: enabled, MinDropPercent: minDropPercent},
+		Email:             "watcher@mail.com",
+	}
+}
+
+func (suite *NotifyPriceDropSuite) TestNotifyPriceDropUseCase_ExecuteSuccess() {
+	property := &model.Property{ID: 4, Status: model.ACTIVE, Pricing: model.Pricing{SalePrice: 90}}
+	suite.database.EXPECT().GetProperty(int64(4)).Return(property, true, nil)
+	suite.database.EXPECT().ListPriceDropWatchers(int64(4)).Return([]*model.PriceDropWatcher{
+		watcher(1, true, 0), watcher(2, false, 0), watcher(3, true, 20), watcher(5, true, 10), watcher(6, true, 0),
+	}, nil)
+	suite.database.EXPECT().SavePriceDropNotification(int64(1), int64(12)).Return(true, nil)
+	suite.database.EXPECT().SavePriceDropNotification(int64(5), int64(12)).Return(true, nil)
+	suite.database.EXPECT().SavePriceDropNotification(int64(6), int64(12)).Return(false, nil)
+	suite.notifier.EXPECT().NotifyPriceDrop(gomock.Any()).DoAndReturn(func(alert *model.PriceDropAlert) error {
+		suite.Equal(model.Amount(100), alert.PreviousPrice)
+		suite.Equal(model.Amount(90), alert.NewPrice)
+		suite.Equal(property, alert.Property)
+		return nil
+	}).Times(2)
+
+	suite.NoError(suite.notifyUseCase.Execute(&model.PriceChange{ID: 12, PropertyID: 4, PreviousPrice: 100, NewPrice: 90}))
+}
+
+func (suite *NotifyPriceDropSuite) TestNotifyPriceDropUseCase_ExecuteSuccess_PriceRaised() {
+	suite.NoError(suite.notifyUseCase.Execute(&model.PriceChange{PropertyID: 4, PreviousPrice: 90, NewPrice: 100}))
+}
+
+func (suite *NotifyPriceDropSuite) TestNotifyPriceDropUseCase_ExecuteSuccess_NotActive() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(&model.Property{ID: 4, Status: model.INACTIVE}, true, nil)
+	suite.NoError(suite.notifyUseCase.Execute(&model.PriceChange{ID: 12, PropertyID: 4, PreviousPrice: 100, NewPrice: 90}))
+}
+
+func (suite *NotifyPriceDropSuite) TestNotifyPriceDropUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(nil, false, nil)
+	err := suite.notifyUseCase.Execute(&model.PriceChange{ID: 12, PropertyID: 4, PreviousPrice: 100, NewPrice: 90})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *NotifyPriceDropSuite) TestNotifyPriceDropUseCase_ExecuteError_SaveNotification() {
+	suite.database.EXPECT().GetProperty(int64(4)).Return(&model.Property{ID: 4, Status: model.ACTIVE}, true, nil)
+	suite.database.EXPECT().ListPriceDropWatchers(int64(4)).Return([]*model.PriceDropWatcher{watcher(1, true, 0)}, nil)
+	suite.database.EXPECT().SavePriceDropNotification(int64(1), int64(12)).Return(false, errors.New("fail"))
+	suite.Error(suite.notifyUseCase.Execute(&model.PriceChange{ID: 12, PropertyID: 4, PreviousPrice: 100, NewPrice: 90}))
+}
+
+func (suite *NotifyPriceDropSuite) TestUpdatePriceDropSettingsUseCase_ExecuteError_InvalidPercent() {
+	_, err := pricedrops.NewUpdatePriceDropSettingsUseCase(suite.database).Execute(&model.PriceDropSettings{UserID: 1, MinDropPercent: 101})
+	suite.IsType(&model.DomainError{}, err)
+}
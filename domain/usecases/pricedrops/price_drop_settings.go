@@ -0,0 +1,38 @@
+package pricedrops
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type GetPriceDropSettingsUseCase struct {
+	database StorageManager
+}
+
+func NewGetPriceDropSettingsUseCase(database StorageManager) *GetPriceDropSettingsUseCase {
+	return &GetPriceDropSettingsUseCase{
+		database: database,
+	}
+}
+
+func (uc *GetPriceDropSettingsUseCase) Execute(userID int64) (*model.PriceDropSettings, error) {
+	return uc.database.GetPriceDropSettings(userID)
+}
+
+type UpdatePriceDropSettingsUseCase struct {
+	database StorageManager
+}
+
+func NewUpdatePriceDropSettingsUseCase(database StorageManager) *UpdatePriceDropSettingsUseCase {
+	return &UpdatePriceDropSettingsUseCase{
+		database: database,
+	}
+}
+
+// Execute saves the settings, MinDropPercent goes from 0, any drop, to 100
+func (uc *UpdatePriceDropSettingsUseCase) Execute(settings *model.PriceDropSettings) (*model.PriceDropSettings, error) {
+	if settings.MinDropPercent < 0 || settings.MinDropPercent > 100 {
+		return nil, model.NewDomainError(errors.New("minDropPercent must be between 0 and 100"))
+	}
+	return uc.database.SavePriceDropSettings(settings)
+}
@@ -1,40 +1,133 @@
 package properties
 
 import (
+	"errors"
+	"lahaus/config"
 	"lahaus/domain/model"
 )
 
 //go:generate mockgen -destination=./mocks/mock_property.go -package=mocks -source=./create_property.go
 
 type StorageManager interface {
-	SaveProperty(property *model.Property) (*model.Property, error)
-	UpdateProperty(property *model.Property) (*model.Property, error)
+	SaveProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error)
+	UpdateProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error)
+	RenewProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error)
+	GetProperty(propertyID int64) (*model.Property, bool, error)
 	FilterProperties(search PropertySearchParams) (*model.PropertiesPaging, error)
+	ListPriceHistory(propertyID int64) ([]*model.PriceHistoryEntry, error)
+	GetUserByID(userID int64) (*model.User, bool, error)
+	ListDuplicateCandidates(search DuplicateSearchParams) ([]*model.Property, error)
 }
 
 type PropertyRuler interface {
 	Execute(property *model.Property)
 }
 
+// PropertyMatcher receives the properties saved as ACTIVE to match them with the saved searches, it must not block
+type PropertyMatcher interface {
+	Match(property *model.Property)
+}
+
+// ExchangeRates gives the rates to search and show the prices in another currency
+type ExchangeRates interface {
+	Current() model.ExchangeRates
+}
+
+// PriceWatcher receives the sale price drops to notify the users that saved the property, it must not block
+type PriceWatcher interface {
+	PriceDropped(change *model.PriceChange)
+}
+
 type CreatePropertyUseCase struct {
 	database      StorageManager
 	propertyRuler PropertyRuler
+	matcher       PropertyMatcher
+	verification  *config.EmailVerification
+	duplicates    *config.DuplicateDetection
+	moderation    *config.Moderation
 }
 
-func NewCreatePropertyUseCase(database StorageManager, propertyRuler PropertyRuler) *CreatePropertyUseCase {
+func NewCreatePropertyUseCase(verification *config.EmailVerification, duplicates *config.DuplicateDetection, moderation *config.Moderation,
+	database StorageManager, propertyRuler PropertyRuler, matcher PropertyMatcher) *CreatePropertyUseCase {
 	return &CreatePropertyUseCase{
 		database:      database,
 		propertyRuler: propertyRuler,
+		matcher:       matcher,
+		verification:  verification,
+		duplicates:    duplicates,
+		moderation:    moderation,
 	}
 }
 
-func (uc CreatePropertyUseCase) Execute(property *model.Property) (*model.Property, error) {
+// Execute saves the property of the actor unless it looks like a property already listed
+func (uc CreatePropertyUseCase) Execute(property *model.Property, actor *model.Actor) (*model.Property, error) {
+	if uc.verification.Required {
+		owner, found, err := uc.database.GetUserByID(actor.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, model.NewUnauthorizedError(errors.New("user not found"))
+		}
+		if owner.EmailVerifiedAt == nil {
+			return nil, model.NewForbiddenError(errors.New("the email must be verified"))
+		}
+	}
+	property.OwnerID = actor.UserID
+	property.UpdateMetrics()
 	uc.propertyRuler.Execute(property)
-	propertyStored, err := uc.database.SaveProperty(property)
+	var duplicates []*model.Property
+	if property.Status != model.INVALID && uc.detectsDuplicates() {
+		var err error
+		duplicates, err = findDuplicates(uc.database, uc.duplicates, property)
+		if err != nil {
+			return nil, err
+		}
+		if len(duplicates) > 0 && uc.duplicates.Action == rejectDuplicates {
+			return nil, model.NewDuplicatePropertyError(errors.New("the property looks like a property already listed"), duplicates)
+		}
+	}
+	err := moveToStatus(property, "", func(verdict model.PropertyStatus) model.PropertyStatus {
+		if len(duplicates) > 0 {
+			return model.DUPLICATE_SUSPECT
+		}
+		return createdStatus(uc.moderation, verdict)
+	})
+	if err != nil {
+		return nil, err
+	}
+	audit, err := propertyAudit(nil, property, actor)
 	if err != nil {
 		return nil, err
 	}
+	propertyStored, err := uc.database.SaveProperty(property, audit)
+	if err != nil {
+		return nil, err
+	}
+	if propertyStored.Status == model.ACTIVE {
+		uc.matcher.Match(propertyStored)
+	}
 
 	return propertyStored, nil
 
 }
+
+func (uc CreatePropertyUseCase) detectsDuplicates() bool {
+	return uc.duplicates != nil && (uc.duplicates.Action == rejectDuplicates || uc.duplicates.Action == flagDuplicates)
+}
+
+// propertyAudit returns the audit entry of the change of the property, before is nil for created properties
+func propertyAudit(before, after *model.Property, actor *model.Actor) (*model.AuditEntry, error) {
+	changes, err := model.AuditChanges(before, after)
+	if err != nil {
+		return nil, err
+	}
+	action := model.CREATE
+	if before != nil {
+		action = model.UPDATE
+		if before.Status != after.Status {
+			action = model.STATUS_CHANGE
+		}
+	}
+	return model.NewAuditEntry(model.PROPERTY, after.ID, action, actor, changes), nil
+}
@@ -10,14 +10,18 @@ import (
 	"lahaus/domain/usecases/properties/mocks"
 	"lahaus/domain/usecases/ruler"
 	"testing"
+	"time"
 )
 
 const million = 1000000
 
+var agent = &model.Actor{UserID: 7, Role: model.AGENT, RequestID: "request-1"}
+
 type CreatePropertySuite struct {
 	suite.Suite
 	mockCtrl      *gomock.Controller
 	database      *mocks.MockStorageManager
+	matcher       *mocks.MockPropertyMatcher
 	propertyRuler *ruler.PropertyRules
 	createUseCase *properties.CreatePropertyUseCase
 }
@@ -29,6 +33,7 @@ func TestCreatePropertySuite(t *testing.T) {
 func (suite *CreatePropertySuite) SetupTest() {
 	suite.mockCtrl = gomock.NewController(suite.T())
 	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.matcher = mocks.NewMockPropertyMatcher(suite.mockCtrl)
 	suite.propertyRuler = ruler.NewPropertyRulerUseCase(&config.Config{
 		BusinessRules: &config.BusinessRules{
 			HouseValidator: &config.PropertyTypeValidator{
@@ -80,8 +85,8 @@ func (suite *CreatePropertySuite) SetupTest() {
 				},
 			},
 		},
-	})
-	suite.createUseCase = properties.NewCreatePropertyUseCase(suite.database, suite.propertyRuler)
+	}, model.ExchangeRates{}, nil)
+	suite.createUseCase = properties.NewCreatePropertyUseCase(&config.EmailVerification{}, &config.DuplicateDetection{}, &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
 }
 
 func (suite *CreatePropertySuite) TearDownSuite() {
@@ -108,10 +113,46 @@ func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccessActive
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().SaveProperty(property).Return(property, nil)
-	propertyResult, err := suite.createUseCase.Execute(property)
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).DoAndReturn(func(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+		suite.Equal(model.PROPERTY, audit.Entity)
+		suite.Equal(model.CREATE, audit.Action)
+		suite.Equal(agent.UserID, *audit.ActorID)
+		suite.Equal(model.AGENT, audit.ActorRole)
+		suite.Equal(model.AuditChange{After: "Casa de familia"}, audit.Changes["title"])
+		suite.Equal(model.AuditChange{After: "ACTIVE"}, audit.Changes["status"])
+		return property, nil
+	})
+	suite.matcher.EXPECT().Match(property)
+	propertyResult, err := suite.createUseCase.Execute(property, agent)
 	suite.NoError(err)
 	suite.Equal(model.ACTIVE, propertyResult.Status)
+	suite.Equal(agent.UserID, propertyResult.OwnerID)
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccessMetrics() {
+	fee := model.Amount(50000)
+	property := &model.Property{
+		Title: "Casa de familia",
+		Location: model.Location{
+			Longitude: -99.096741,
+			Latitude:  19.296135,
+		},
+		Pricing: model.Pricing{
+			SalePrice:         3 * million,
+			AdministrativeFee: &fee,
+		},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	suite.matcher.EXPECT().Match(property)
+	propertyResult, err := suite.createUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.Amount(10000), *propertyResult.PricePerSquareMeter)
+	suite.Equal(fee, *propertyResult.MonthlyCost)
 }
 
 func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccessInactive() {
@@ -134,8 +175,8 @@ func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccessInacti
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().SaveProperty(property).Return(property, nil)
-	propertyResult, err := suite.createUseCase.Execute(property)
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := suite.createUseCase.Execute(property, agent)
 	suite.NoError(err)
 	suite.Equal(model.INACTIVE, propertyResult.Status)
 }
@@ -160,8 +201,8 @@ func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccessInvali
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().SaveProperty(property).Return(property, nil)
-	propertyResult, err := suite.createUseCase.Execute(property)
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := suite.createUseCase.Execute(property, agent)
 	suite.NoError(err)
 	suite.Equal(model.INVALID, propertyResult.Status)
 }
@@ -186,8 +227,108 @@ func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteError() {
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().SaveProperty(property).Return(nil, errors.New("fail to save in database"))
-	propertyResult, err := suite.createUseCase.Execute(property)
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(nil, errors.New("fail to save in database"))
+	propertyResult, err := suite.createUseCase.Execute(property, agent)
+	suite.Error(err)
+	suite.Nil(propertyResult)
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteError_EmailNotVerified() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{Required: true}, &config.DuplicateDetection{}, &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
+	suite.database.EXPECT().GetUserByID(agent.UserID).Return(&model.User{ID: agent.UserID}, true, nil)
+	propertyResult, err := createUseCase.Execute(&model.Property{}, agent)
+	suite.IsType(&model.ForbiddenError{}, err)
+	suite.Nil(propertyResult)
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccess_EmailVerified() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{Required: true}, &config.DuplicateDetection{}, &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
+	verifiedAt := time.Now()
+	property := &model.Property{PropertyType: model.HOUSE}
+	suite.database.EXPECT().GetUserByID(agent.UserID).Return(&model.User{ID: agent.UserID, EmailVerifiedAt: &verifiedAt}, true, nil)
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	_, err := createUseCase.Execute(property, agent)
+	suite.NoError(err)
+}
+
+func duplicateRules(action string) *config.DuplicateDetection {
+	return &config.DuplicateDetection{Action: action, RadiusInMeters: 50, AreaTolerancePercent: 10, MinTitleSimilarity: 0.5}
+}
+
+func newActiveHouse() *model.Property {
+	return &model.Property{
+		Title:        "Casa de familia cerca al parque",
+		Location:     model.Location{Longitude: -99.096741, Latitude: 19.296135},
+		Pricing:      model.Pricing{SalePrice: 3 * million},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteError_DuplicateRejected() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{}, duplicateRules("reject"), &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
+	property := newActiveHouse()
+	candidate := &model.Property{ID: 9, Title: "Casa familiar cerca del parque", PropertyType: model.HOUSE, Area: 310}
+	suite.database.EXPECT().ListDuplicateCandidates(properties.DuplicateSearchParams{
+		PropertyType:   model.HOUSE,
+		Location:       property.Location,
+		RadiusInMeters: 50,
+		MinArea:        270,
+		MaxArea:        330,
+	}).Return([]*model.Property{candidate, {ID: 10, Title: "Lote con vista al mar", PropertyType: model.HOUSE, Area: 300}}, nil)
+	propertyResult, err := createUseCase.Execute(property, agent)
+	suite.IsType(&model.DuplicatePropertyError{}, err)
+	suite.Equal([]*model.Property{candidate}, err.(*model.DuplicatePropertyError).Candidates)
+	suite.Nil(propertyResult)
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccess_DuplicateFlagged() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{}, duplicateRules("flag"), &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
+	property := newActiveHouse()
+	suite.database.EXPECT().ListDuplicateCandidates(gomock.Any()).Return([]*model.Property{{ID: 9, Title: "CASA DE FAMILIA, cerca al parque!"}}, nil)
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := createUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.DUPLICATE_SUSPECT, propertyResult.Status)
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccess_NoDuplicate() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{}, duplicateRules("reject"), &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
+	property := newActiveHouse()
+	suite.database.EXPECT().ListDuplicateCandidates(gomock.Any()).Return([]*model.Property{{ID: 9, Title: "Apartamento amoblado en el centro"}}, nil)
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	suite.matcher.EXPECT().Match(property)
+	propertyResult, err := createUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.ACTIVE, propertyResult.Status)
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccess_InvalidNotChecked() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{}, duplicateRules("reject"), &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
+	property := newActiveHouse()
+	property.Bedrooms = 0
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := createUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.INVALID, propertyResult.Status)
+}
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteError_DuplicateCandidates() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{}, duplicateRules("flag"), &config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher)
+	suite.database.EXPECT().ListDuplicateCandidates(gomock.Any()).Return(nil, errors.New("fail"))
+	propertyResult, err := createUseCase.Execute(newActiveHouse(), agent)
 	suite.Error(err)
 	suite.Nil(propertyResult)
 }
+
+func (suite *CreatePropertySuite) TestCreatePropertyUseCase_ExecuteSuccess_PendingReview() {
+	createUseCase := properties.NewCreatePropertyUseCase(&config.EmailVerification{}, &config.DuplicateDetection{}, &config.Moderation{Required: true},
+		suite.database, suite.propertyRuler, suite.matcher)
+	property := newActiveHouse()
+	suite.database.EXPECT().SaveProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := createUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.PENDING_REVIEW, propertyResult.Status)
+}
@@ -0,0 +1,76 @@
+package properties
+
+import (
+	"lahaus/config"
+	"lahaus/domain/model"
+	"strings"
+	"unicode"
+)
+
+const (
+	rejectDuplicates = "reject"
+	flagDuplicates   = "flag"
+)
+
+// DuplicateSearchParams are the type, the location radius and the area range of the candidate duplicates
+type DuplicateSearchParams struct {
+	PropertyType   model.PropertyType
+	Location       model.Location
+	RadiusInMeters float64
+	MinArea        int
+	MaxArea        int
+}
+
+// findDuplicates returns the stored properties that look like the new one
+func findDuplicates(database StorageManager, rules *config.DuplicateDetection, property *model.Property) ([]*model.Property, error) {
+	tolerance := property.Area * rules.AreaTolerancePercent / 100
+	nearby, err := database.ListDuplicateCandidates(DuplicateSearchParams{
+		PropertyType:   property.PropertyType,
+		Location:       property.Location,
+		RadiusInMeters: rules.RadiusInMeters,
+		MinArea:        property.Area - tolerance,
+		MaxArea:        property.Area + tolerance,
+	})
+	if err != nil {
+		return nil, err
+	}
+	duplicates := []*model.Property{}
+	for _, candidate := range nearby {
+		if titleSimilarity(property.Title, candidate.Title) >= rules.MinTitleSimilarity {
+			duplicates = append(duplicates, candidate)
+		}
+	}
+	return duplicates, nil
+}
+
+var accents = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n")
+
+// titleSimilarity is the share of word trigrams the titles have in common, from 0 to 1
+func titleSimilarity(first, second string) float64 {
+	firstTrigrams := trigrams(first)
+	secondTrigrams := trigrams(second)
+	if len(firstTrigrams) == 0 || len(secondTrigrams) == 0 {
+		return 0
+	}
+	shared := 0
+	for trigram := range firstTrigrams {
+		if secondTrigrams[trigram] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(firstTrigrams)+len(secondTrigrams)-shared)
+}
+
+func trigrams(title string) map[string]bool {
+	words := strings.FieldsFunc(accents.Replace(strings.ToLower(title)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	result := map[string]bool{}
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			result[string(padded[i:i+3])] = true
+		}
+	}
+	return result
+}
@@ -0,0 +1,13 @@
+package properties
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTitleSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, titleSimilarity("Apartamento cerca a la estación", "apartamento cerca a la estacion"))
+	assert.GreaterOrEqual(t, titleSimilarity("Apartamento cerca a la estación", "Apartamento cerca de la estación!"), 0.5)
+	assert.Less(t, titleSimilarity("Apartamento cerca a la estación", "Casa con jardín en las afueras"), 0.2)
+	assert.Equal(t, 0.0, titleSimilarity("", "Casa"))
+}
@@ -0,0 +1,49 @@
+package properties
+
+import (
+	"errors"
+	"lahaus/config"
+	"lahaus/domain/model"
+)
+
+type EvaluatePropertyUseCase struct {
+	database      StorageManager
+	propertyRuler PropertyRuler
+	moderation    *config.Moderation
+}
+
+func NewEvaluatePropertyUseCase(moderation *config.Moderation, database StorageManager, propertyRuler PropertyRuler) *EvaluatePropertyUseCase {
+	return &EvaluatePropertyUseCase{
+		moderation:    moderation,
+		database:      database,
+		propertyRuler: propertyRuler,
+	}
+}
+
+// Execute runs the property rules again over a stored property, so changes in the business rules are applied to it
+func (uc *EvaluatePropertyUseCase) Execute(propertyID int64, actor *model.Actor) (*model.Property, error) {
+	property, found, err := uc.database.GetProperty(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	current := *property
+	uc.propertyRuler.Execute(property)
+	err = moveToStatus(property, current.Status, func(verdict model.PropertyStatus) model.PropertyStatus {
+		return editedStatus(uc.moderation, &current, property, verdict)
+	})
+	if err != nil {
+		return nil, err
+	}
+	audit, err := propertyAudit(&current, property, actor)
+	if err != nil {
+		return nil, err
+	}
+	propertyStored, err := uc.database.UpdateProperty(property, audit)
+	if err != nil {
+		return nil, err
+	}
+	return propertyStored, nil
+}
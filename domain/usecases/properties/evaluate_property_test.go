@@ -0,0 +1,68 @@
+package properties_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/properties"
+	"lahaus/domain/usecases/properties/mocks"
+	"testing"
+)
+
+type EvaluatePropertySuite struct {
+	suite.Suite
+	mockCtrl        *gomock.Controller
+	database        *mocks.MockStorageManager
+	propertyRuler   *mocks.MockPropertyRuler
+	evaluateUseCase *properties.EvaluatePropertyUseCase
+}
+
+func TestEvaluatePropertySuite(t *testing.T) {
+	suite.Run(t, new(EvaluatePropertySuite))
+}
+
+func (suite *EvaluatePropertySuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.propertyRuler = mocks.NewMockPropertyRuler(suite.mockCtrl)
+	suite.evaluateUseCase = properties.NewEvaluatePropertyUseCase(&config.Moderation{}, suite.database, suite.propertyRuler)
+}
+
+func (suite *EvaluatePropertySuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *EvaluatePropertySuite) TestEvaluatePropertyUseCase_ExecuteSuccess() {
+	property := &model.Property{ID: 1, Status: model.INVALID}
+	suite.database.EXPECT().GetProperty(int64(1)).Return(property, true, nil)
+	suite.propertyRuler.EXPECT().Execute(property).Do(func(property *model.Property) {
+		property.Status = model.ACTIVE
+	})
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := suite.evaluateUseCase.Execute(1, &model.Actor{UserID: 1, Role: model.ADMIN})
+	suite.NoError(err)
+	suite.Equal(model.ACTIVE, propertyResult.Status)
+}
+
+func (suite *EvaluatePropertySuite) TestEvaluatePropertyUseCase_ExecuteError_GetProperty() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, errors.New("fail"))
+	_, err := suite.evaluateUseCase.Execute(1, &model.Actor{UserID: 1, Role: model.ADMIN})
+	suite.Error(err)
+}
+
+func (suite *EvaluatePropertySuite) TestEvaluatePropertyUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, nil)
+	_, err := suite.evaluateUseCase.Execute(1, &model.Actor{UserID: 1, Role: model.ADMIN})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *EvaluatePropertySuite) TestEvaluatePropertyUseCase_ExecuteError_Update() {
+	property := &model.Property{ID: 1}
+	suite.database.EXPECT().GetProperty(int64(1)).Return(property, true, nil)
+	suite.propertyRuler.EXPECT().Execute(property)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(nil, errors.New("fail"))
+	_, err := suite.evaluateUseCase.Execute(1, &model.Actor{UserID: 1, Role: model.ADMIN})
+	suite.Error(err)
+}
@@ -0,0 +1,32 @@
+package properties
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type GetPriceHistoryUseCase struct {
+	database StorageManager
+}
+
+func NewGetPriceHistoryUseCase(database StorageManager) *GetPriceHistoryUseCase {
+	return &GetPriceHistoryUseCase{
+		database: database,
+	}
+}
+
+// Execute returns the pricing changes of the property, newest first
+func (uc *GetPriceHistoryUseCase) Execute(propertyID int64, user *model.User) ([]*model.PriceHistoryEntry, error) {
+	property, found, err := uc.database.GetProperty(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found || (property.Status.Hidden() && !canSeeHidden(property, user)) {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	return uc.database.ListPriceHistory(propertyID)
+}
+
+func canSeeHidden(property *model.Property, user *model.User) bool {
+	return user != nil && (user.Role == model.ADMIN || user.ID == property.OwnerID)
+}
@@ -0,0 +1,79 @@
+package properties_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/properties"
+	"lahaus/domain/usecases/properties/mocks"
+	"testing"
+)
+
+type GetPriceHistorySuite struct {
+	suite.Suite
+	mockCtrl            *gomock.Controller
+	database            *mocks.MockStorageManager
+	priceHistoryUseCase *properties.GetPriceHistoryUseCase
+}
+
+func TestGetPriceHistorySuite(t *testing.T) {
+	suite.Run(t, new(GetPriceHistorySuite))
+}
+
+func (suite *GetPriceHistorySuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.priceHistoryUseCase = properties.NewGetPriceHistoryUseCase(suite.database)
+}
+
+func (suite *GetPriceHistorySuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *GetPriceHistorySuite) TestGetPriceHistoryUseCase_ExecuteSuccess() {
+	history := []*model.PriceHistoryEntry{{ID: 1, PropertyID: 1, Previous: model.Pricing{SalePrice: 450000000}, Current: model.Pricing{SalePrice: 400000000}}}
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.ACTIVE}, true, nil)
+	suite.database.EXPECT().ListPriceHistory(int64(1)).Return(history, nil)
+	result, err := suite.priceHistoryUseCase.Execute(1, nil)
+	suite.NoError(err)
+	suite.Equal(history, result)
+}
+
+func (suite *GetPriceHistorySuite) TestGetPriceHistoryUseCase_ExecuteSuccess_InvalidByOwner() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.INVALID, OwnerID: 7}, true, nil)
+	suite.database.EXPECT().ListPriceHistory(int64(1)).Return([]*model.PriceHistoryEntry{}, nil)
+	_, err := suite.priceHistoryUseCase.Execute(1, &model.User{ID: 7, Role: model.AGENT})
+	suite.NoError(err)
+}
+
+func (suite *GetPriceHistorySuite) TestGetPriceHistoryUseCase_ExecuteSuccess_InvalidByAdmin() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.INVALID, OwnerID: 7}, true, nil)
+	suite.database.EXPECT().ListPriceHistory(int64(1)).Return([]*model.PriceHistoryEntry{}, nil)
+	_, err := suite.priceHistoryUseCase.Execute(1, &model.User{ID: 2, Role: model.ADMIN})
+	suite.NoError(err)
+}
+
+func (suite *GetPriceHistorySuite) TestGetPriceHistoryUseCase_ExecuteError_Invalid() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.INVALID, OwnerID: 7}, true, nil)
+	_, err := suite.priceHistoryUseCase.Execute(1, &model.User{ID: 2, Role: model.BUYER})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *GetPriceHistorySuite) TestGetPriceHistoryUseCase_ExecuteError_Anonymous() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.INVALID, OwnerID: 7}, true, nil)
+	_, err := suite.priceHistoryUseCase.Execute(1, nil)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *GetPriceHistorySuite) TestGetPriceHistoryUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, nil)
+	_, err := suite.priceHistoryUseCase.Execute(1, nil)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *GetPriceHistorySuite) TestGetPriceHistoryUseCase_ExecuteError_GetProperty() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, errors.New("fail"))
+	_, err := suite.priceHistoryUseCase.Execute(1, nil)
+	suite.Error(err)
+}
@@ -0,0 +1,28 @@
+package properties
+
+import (
+	"errors"
+	"lahaus/domain/model"
+)
+
+type GetPropertyUseCase struct {
+	database StorageManager
+}
+
+func NewGetPropertyUseCase(database StorageManager) *GetPropertyUseCase {
+	return &GetPropertyUseCase{
+		database: database,
+	}
+}
+
+// Execute returns the property with its current version
+func (uc *GetPropertyUseCase) Execute(propertyID int64, user *model.User) (*model.Property, error) {
+	property, found, err := uc.database.GetProperty(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found || (property.Status.Hidden() && !canSeeHidden(property, user)) {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	return property, nil
+}
@@ -0,0 +1,64 @@
+package properties_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/properties"
+	"lahaus/domain/usecases/properties/mocks"
+	"testing"
+)
+
+type GetPropertySuite struct {
+	suite.Suite
+	mockCtrl           *gomock.Controller
+	database           *mocks.MockStorageManager
+	getPropertyUseCase *properties.GetPropertyUseCase
+}
+
+func TestGetPropertySuite(t *testing.T) {
+	suite.Run(t, new(GetPropertySuite))
+}
+
+func (suite *GetPropertySuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.getPropertyUseCase = properties.NewGetPropertyUseCase(suite.database)
+}
+
+func (suite *GetPropertySuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *GetPropertySuite) TestGetPropertyUseCase_ExecuteSuccess() {
+	property := &model.Property{ID: 1, Status: model.ACTIVE, Version: 3}
+	suite.database.EXPECT().GetProperty(int64(1)).Return(property, true, nil)
+	result, err := suite.getPropertyUseCase.Execute(1, nil)
+	suite.NoError(err)
+	suite.Equal(property, result)
+}
+
+func (suite *GetPropertySuite) TestGetPropertyUseCase_ExecuteSuccess_InvalidByOwner() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.INVALID, OwnerID: 7}, true, nil)
+	_, err := suite.getPropertyUseCase.Execute(1, &model.User{ID: 7, Role: model.AGENT})
+	suite.NoError(err)
+}
+
+func (suite *GetPropertySuite) TestGetPropertyUseCase_ExecuteError_Invalid() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.INVALID, OwnerID: 7}, true, nil)
+	_, err := suite.getPropertyUseCase.Execute(1, nil)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *GetPropertySuite) TestGetPropertyUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, nil)
+	_, err := suite.getPropertyUseCase.Execute(1, nil)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *GetPropertySuite) TestGetPropertyUseCase_ExecuteError() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, errors.New("fail"))
+	_, err := suite.getPropertyUseCase.Execute(1, nil)
+	suite.Error(err)
+}
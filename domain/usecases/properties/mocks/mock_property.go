@@ -35,33 +35,64 @@ func (m *MockStorageManager) EXPECT() *MockStorageManagerMockRecorder {
 }
 
 // SaveProperty mocks base method
-func (m *MockStorageManager) SaveProperty(property *model.Property) (*model.Property, error) {
+func (m *MockStorageManager) SaveProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveProperty", property)
+	ret := m.ctrl.Call(m, "SaveProperty", property, audit)
 	ret0, _ := ret[0].(*model.Property)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SaveProperty indicates an expected call of SaveProperty
-func (mr *MockStorageManagerMockRecorder) SaveProperty(property interface{}) *gomock.Call {
+func (mr *MockStorageManagerMockRecorder) SaveProperty(property, audit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProperty", reflect.TypeOf((*MockStorageManager)(nil).SaveProperty), property)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProperty", reflect.TypeOf((*MockStorageManager)(nil).SaveProperty), property, audit)
 }
 
 // UpdateProperty mocks base method
-func (m *MockStorageManager) UpdateProperty(property *model.Property) (*model.Property, error) {
+func (m *MockStorageManager) UpdateProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProperty", property)
+	ret := m.ctrl.Call(m, "UpdateProperty", property, audit)
 	ret0, _ := ret[0].(*model.Property)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProperty indicates an expected call of UpdateProperty
-func (mr *MockStorageManagerMockRecorder) UpdateProperty(property interface{}) *gomock.Call {
+func (mr *MockStorageManagerMockRecorder) UpdateProperty(property, audit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProperty", reflect.TypeOf((*MockStorageManager)(nil).UpdateProperty), property)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProperty", reflect.TypeOf((*MockStorageManager)(nil).UpdateProperty), property, audit)
+}
+
+// RenewProperty mocks base method
+func (m *MockStorageManager) RenewProperty(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewProperty", property, audit)
+	ret0, _ := ret[0].(*model.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewProperty indicates an expected call of RenewProperty
+func (mr *MockStorageManagerMockRecorder) RenewProperty(property, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewProperty", reflect.TypeOf((*MockStorageManager)(nil).RenewProperty), property, audit)
+}
+
+// GetProperty mocks base method
+func (m *MockStorageManager) GetProperty(propertyID int64) (*model.Property, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProperty", propertyID)
+	ret0, _ := ret[0].(*model.Property)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProperty indicates an expected call of GetProperty
+func (mr *MockStorageManagerMockRecorder) GetProperty(propertyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProperty", reflect.TypeOf((*MockStorageManager)(nil).GetProperty), propertyID)
 }
 
 // FilterProperties mocks base method
@@ -79,6 +110,52 @@ func (mr *MockStorageManagerMockRecorder) FilterProperties(search interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterProperties", reflect.TypeOf((*MockStorageManager)(nil).FilterProperties), search)
 }
 
+// ListPriceHistory mocks base method
+func (m *MockStorageManager) ListPriceHistory(propertyID int64) ([]*model.PriceHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceHistory", propertyID)
+	ret0, _ := ret[0].([]*model.PriceHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceHistory indicates an expected call of ListPriceHistory
+func (mr *MockStorageManagerMockRecorder) ListPriceHistory(propertyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceHistory", reflect.TypeOf((*MockStorageManager)(nil).ListPriceHistory), propertyID)
+}
+
+// GetUserByID mocks base method
+func (m *MockStorageManager) GetUserByID(userID int64) (*model.User, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserByID indicates an expected call of GetUserByID
+func (mr *MockStorageManagerMockRecorder) GetUserByID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockStorageManager)(nil).GetUserByID), userID)
+}
+
+// ListDuplicateCandidates mocks base method
+func (m *MockStorageManager) ListDuplicateCandidates(search properties.DuplicateSearchParams) ([]*model.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDuplicateCandidates", search)
+	ret0, _ := ret[0].([]*model.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDuplicateCandidates indicates an expected call of ListDuplicateCandidates
+func (mr *MockStorageManagerMockRecorder) ListDuplicateCandidates(search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDuplicateCandidates", reflect.TypeOf((*MockStorageManager)(nil).ListDuplicateCandidates), search)
+}
+
 // MockPropertyRuler is a mock of PropertyRuler interface
 type MockPropertyRuler struct {
 	ctrl     *gomock.Controller
@@ -113,3 +190,110 @@ func (mr *MockPropertyRulerMockRecorder) Execute(property interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPropertyRuler)(nil).Execute), property)
 }
+
+// MockPropertyMatcher is a mock of PropertyMatcher interface
+type MockPropertyMatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPropertyMatcherMockRecorder
+}
+
+// MockPropertyMatcherMockRecorder is the mock recorder for MockPropertyMatcher
+type MockPropertyMatcherMockRecorder struct {
+	mock *MockPropertyMatcher
+}
+
+// NewMockPropertyMatcher creates a new mock instance
+func NewMockPropertyMatcher(ctrl *gomock.Controller) *MockPropertyMatcher {
+	mock := &MockPropertyMatcher{ctrl: ctrl}
+	mock.recorder = &MockPropertyMatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPropertyMatcher) EXPECT() *MockPropertyMatcherMockRecorder {
+	return m.recorder
+}
+
+// Match mocks base method
+func (m *MockPropertyMatcher) Match(property *model.Property) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Match", property)
+}
+
+// Match indicates an expected call of Match
+func (mr *MockPropertyMatcherMockRecorder) Match(property interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockPropertyMatcher)(nil).Match), property)
+}
+
+// MockExchangeRates is a mock of ExchangeRates interface
+type MockExchangeRates struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesMockRecorder
+}
+
+// MockExchangeRatesMockRecorder is the mock recorder for MockExchangeRates
+type MockExchangeRatesMockRecorder struct {
+	mock *MockExchangeRates
+}
+
+// NewMockExchangeRates creates a new mock instance
+func NewMockExchangeRates(ctrl *gomock.Controller) *MockExchangeRates {
+	mock := &MockExchangeRates{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockExchangeRates) EXPECT() *MockExchangeRatesMockRecorder {
+	return m.recorder
+}
+
+// Current mocks base method
+func (m *MockExchangeRates) Current() model.ExchangeRates {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current")
+	ret0, _ := ret[0].(model.ExchangeRates)
+	return ret0
+}
+
+// Current indicates an expected call of Current
+func (mr *MockExchangeRatesMockRecorder) Current() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockExchangeRates)(nil).Current))
+}
+
+// MockPriceWatcher is a mock of PriceWatcher interface
+type MockPriceWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceWatcherMockRecorder
+}
+
+// MockPriceWatcherMockRecorder is the mock recorder for MockPriceWatcher
+type MockPriceWatcherMockRecorder struct {
+	mock *MockPriceWatcher
+}
+
+// NewMockPriceWatcher creates a new mock instance
+func NewMockPriceWatcher(ctrl *gomock.Controller) *MockPriceWatcher {
+	mock := &MockPriceWatcher{ctrl: ctrl}
+	mock.recorder = &MockPriceWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPriceWatcher) EXPECT() *MockPriceWatcherMockRecorder {
+	return m.recorder
+}
+
+// PriceDropped mocks base method
+func (m *MockPriceWatcher) PriceDropped(change *model.PriceChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PriceDropped", change)
+}
+
+// PriceDropped indicates an expected call of PriceDropped
+func (mr *MockPriceWatcherMockRecorder) PriceDropped(change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceDropped", reflect.TypeOf((*MockPriceWatcher)(nil).PriceDropped), change)
+}
@@ -0,0 +1,53 @@
+package properties
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/domain/model"
+)
+
+type RenewPropertyUseCase struct {
+	database StorageManager
+	matcher  PropertyMatcher
+}
+
+func NewRenewPropertyUseCase(database StorageManager, matcher PropertyMatcher) *RenewPropertyUseCase {
+	return &RenewPropertyUseCase{
+		database: database,
+		matcher:  matcher,
+	}
+}
+
+// Execute lists the property of the actor again from now, admins can renew any property
+func (uc *RenewPropertyUseCase) Execute(propertyID int64, actor *model.Actor) (*model.Property, error) {
+	current, found, err := uc.database.GetProperty(propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	if actor.Role != model.ADMIN && current.OwnerID != actor.UserID {
+		return nil, model.NewForbiddenError(errors.New("the property belongs to another user"))
+	}
+	if current.Status != model.ACTIVE && current.Status != model.EXPIRED {
+		return nil, model.NewDomainError(fmt.Errorf("the property is %s, only ACTIVE or EXPIRED properties can be renewed", current.Status))
+	}
+
+	property := *current
+	if err := property.TransitionTo(model.ACTIVE); err != nil {
+		return nil, err
+	}
+	changes, err := model.AuditChanges(current, &property)
+	if err != nil {
+		return nil, err
+	}
+	propertyStored, err := uc.database.RenewProperty(&property, model.NewAuditEntry(model.PROPERTY, current.ID, model.RENEW, actor, changes))
+	if err != nil {
+		return nil, err
+	}
+	if current.Status == model.EXPIRED {
+		uc.matcher.Match(propertyStored)
+	}
+	return propertyStored, nil
+}
@@ -0,0 +1,93 @@
+package properties_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/properties"
+	"lahaus/domain/usecases/properties/mocks"
+	"testing"
+)
+
+type RenewPropertySuite struct {
+	suite.Suite
+	mockCtrl     *gomock.Controller
+	database     *mocks.MockStorageManager
+	matcher      *mocks.MockPropertyMatcher
+	renewUseCase *properties.RenewPropertyUseCase
+}
+
+func TestRenewPropertySuite(t *testing.T) {
+	suite.Run(t, new(RenewPropertySuite))
+}
+
+func (suite *RenewPropertySuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.matcher = mocks.NewMockPropertyMatcher(suite.mockCtrl)
+	suite.renewUseCase = properties.NewRenewPropertyUseCase(suite.database, suite.matcher)
+}
+
+func (suite *RenewPropertySuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *RenewPropertySuite) TestRenewPropertyUseCase_ExecuteSuccess_Expired() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.EXPIRED, OwnerID: agent.UserID, Version: 4}, true, nil)
+	suite.database.EXPECT().RenewProperty(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+			suite.Equal(model.ACTIVE, property.Status)
+			suite.Equal(int64(4), property.Version)
+			suite.Equal(model.RENEW, audit.Action)
+			suite.Equal(model.AuditChange{Before: "EXPIRED", After: "ACTIVE"}, audit.Changes["status"])
+			return property, nil
+		})
+	suite.matcher.EXPECT().Match(gomock.Any())
+
+	property, err := suite.renewUseCase.Execute(1, agent)
+	suite.NoError(err)
+	suite.Equal(model.ACTIVE, property.Status)
+}
+
+func (suite *RenewPropertySuite) TestRenewPropertyUseCase_ExecuteSuccess_Active() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.ACTIVE, OwnerID: 9}, true, nil)
+	suite.database.EXPECT().RenewProperty(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+			suite.Empty(audit.Changes)
+			return property, nil
+		})
+
+	_, err := suite.renewUseCase.Execute(1, &model.Actor{UserID: 1, Role: model.ADMIN})
+	suite.NoError(err)
+}
+
+func (suite *RenewPropertySuite) TestRenewPropertyUseCase_ExecuteError_NotRenewable() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.PENDING_REVIEW, OwnerID: agent.UserID}, true, nil)
+
+	_, err := suite.renewUseCase.Execute(1, agent)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *RenewPropertySuite) TestRenewPropertyUseCase_ExecuteError_AnotherOwner() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.EXPIRED, OwnerID: 9}, true, nil)
+
+	_, err := suite.renewUseCase.Execute(1, agent)
+	suite.IsType(&model.ForbiddenError{}, err)
+}
+
+func (suite *RenewPropertySuite) TestRenewPropertyUseCase_ExecuteError_NotFound() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(nil, false, nil)
+
+	_, err := suite.renewUseCase.Execute(1, agent)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *RenewPropertySuite) TestRenewPropertyUseCase_ExecuteError_Modified() {
+	suite.database.EXPECT().GetProperty(int64(1)).Return(&model.Property{ID: 1, Status: model.EXPIRED, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().RenewProperty(gomock.Any(), gomock.Any()).
+		Return(nil, model.NewPreconditionFailedError(errors.New("the property was modified by another request")))
+
+	_, err := suite.renewUseCase.Execute(1, agent)
+	suite.IsType(&model.PreconditionFailedError{}, err)
+}
@@ -1,39 +1,73 @@
 package properties
 
 import (
+	"fmt"
 	"lahaus/domain/model"
 	"math"
 )
 
 type SearchPropertyUseCase struct {
 	database StorageManager
-}
-
-type BBoxSearchParams struct {
-	MinLongitude float64
-	MinLatitude  float64
-	MaxLongitude float64
-	MaxLatitude  float64
+	rates    ExchangeRates
 }
 
 type PropertySearchParams struct {
-	Status   string
-	Bbox     *BBoxSearchParams
+	model.SearchFilters
+	Status string
+	// IncludeHidden lists the invalid and the suspected duplicates with the status ALL
+	IncludeHidden bool
+	OwnerID       *int64
+	// MinPrice and MaxPrice bound the sale price in minor units of Currency
+	Currency model.Currency
+	MinPrice *int64
+	MaxPrice *int64
+	// The price per square meter and the monthly cost are bounded in minor units of Currency too
+	MinPricePerSquareMeter *int64
+	MaxPricePerSquareMeter *int64
+	MinMonthlyCost         *int64
+	MaxMonthlyCost         *int64
+	// SortBy orders the results by a metric, converted to Currency when it is set, the properties without it go last
+	SortBy         SortField
+	SortDescending bool
+	// Rates are the exchange rates to convert the sale prices to Currency, the search sets them
+	Rates    model.ExchangeRates
 	Page     int64
 	PageSize int64
 }
 
-func NewSearchPropertyUseCase(database StorageManager) *SearchPropertyUseCase {
+// SortField is the metric the search results are ordered by, the empty one orders them by the last update, newest first
+type SortField string
+
+const (
+	SortByUpdatedAt           SortField = ""
+	SortByPricePerSquareMeter SortField = "pricePerSquareMeter"
+	SortByMonthlyCost         SortField = "monthlyCost"
+)
+
+func NewSearchPropertyUseCase(database StorageManager, rates ExchangeRates) *SearchPropertyUseCase {
 	return &SearchPropertyUseCase{
 		database: database,
+		rates:    rates,
 	}
 }
 
 func (uc *SearchPropertyUseCase) Execute(search PropertySearchParams) (*model.PropertiesPaging, error) {
+	if search.Currency != "" {
+		search.Rates = uc.rates.Current()
+		if _, found := search.Rates[search.Currency]; !found {
+			return nil, model.NewDomainError(fmt.Errorf("there is no exchange rate for the currency %q", search.Currency))
+		}
+	}
 	propertiesPaging, err := uc.database.FilterProperties(search)
 	if err != nil {
 		return nil, err
 	}
+	if search.Currency != "" {
+		for _, property := range propertiesPaging.Data {
+			// the properties in a currency without a rate are shown only with their own prices
+			property.ConvertedPricing, _ = search.Rates.ConvertPricing(property.Pricing, search.Currency)
+		}
+	}
 	propertiesPaging.TotalPages = int64(math.Ceil(float64(propertiesPaging.Total) / float64(propertiesPaging.PageSize)))
 	return propertiesPaging, nil
 }
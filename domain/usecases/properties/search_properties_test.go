@@ -15,6 +15,7 @@ type SearchPropertySuite struct {
 	suite.Suite
 	mockCtrl      *gomock.Controller
 	database      *mocks.MockStorageManager
+	rates         *mocks.MockExchangeRates
 	searchUseCase *properties.SearchPropertyUseCase
 }
 
@@ -25,7 +26,8 @@ func TestSearchPropertySuite(t *testing.T) {
 func (suite *SearchPropertySuite) SetupTest() {
 	suite.mockCtrl = gomock.NewController(suite.T())
 	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
-	suite.searchUseCase = properties.NewSearchPropertyUseCase(suite.database)
+	suite.rates = mocks.NewMockExchangeRates(suite.mockCtrl)
+	suite.searchUseCase = properties.NewSearchPropertyUseCase(suite.database, suite.rates)
 }
 
 func (suite *SearchPropertySuite) TearDownSuite() {
@@ -51,3 +53,31 @@ func (suite *SearchPropertySuite) TestSearchPropertyUseCase_ExecuteError() {
 	suite.Error(err)
 	suite.Nil(propertiesResult)
 }
+
+func (suite *SearchPropertySuite) TestSearchPropertyUseCase_ExecuteSuccessWithCurrency() {
+	rates := model.ExchangeRates{model.USD: 1, model.COP: 4000}
+	suite.rates.EXPECT().Current().Return(rates)
+	suite.database.EXPECT().FilterProperties(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal(rates, search.Rates)
+		return &model.PropertiesPaging{
+			Page:     1,
+			PageSize: 10,
+			Total:    2,
+			Data: []*model.Property{
+				{ID: 1, Pricing: model.Pricing{Currency: model.COP, SalePrice: 400000000}},
+				{ID: 2, Pricing: model.Pricing{Currency: model.MXN, SalePrice: 100000}},
+			},
+		}, nil
+	})
+	propertiesResult, err := suite.searchUseCase.Execute(properties.PropertySearchParams{Currency: model.USD})
+	suite.NoError(err)
+	suite.Equal(&model.Pricing{Currency: model.USD, SalePrice: 100000}, propertiesResult.Data[0].ConvertedPricing)
+	suite.Nil(propertiesResult.Data[1].ConvertedPricing)
+}
+
+func (suite *SearchPropertySuite) TestSearchPropertyUseCase_ExecuteError_UnknownCurrency() {
+	suite.rates.EXPECT().Current().Return(model.ExchangeRates{model.USD: 1})
+	propertiesResult, err := suite.searchUseCase.Execute(properties.PropertySearchParams{Currency: "EUR"})
+	suite.IsType(&model.DomainError{}, err)
+	suite.Nil(propertiesResult)
+}
@@ -0,0 +1,85 @@
+package properties
+
+import (
+	"lahaus/config"
+	"lahaus/domain/model"
+	"reflect"
+)
+
+// listingContent is what the moderators review, the changes of the pricing are not material
+type listingContent struct {
+	Title        string
+	Description  model.Description
+	Location     model.Location
+	PropertyType model.PropertyType
+	Bedrooms     int
+	Bathrooms    int
+	ParkingSpots model.ParkingSpots
+	Area         int
+	Photos       model.Photos
+	Amenities    []model.Amenity
+	Floor        *int
+	TotalFloors  *int
+	YearBuilt    *int
+	Condition    model.PropertyCondition
+	Orientation  model.Orientation
+}
+
+func contentOf(property *model.Property) listingContent {
+	return listingContent{
+		Title:        property.Title,
+		Description:  property.Description,
+		Location:     property.Location,
+		PropertyType: property.PropertyType,
+		Bedrooms:     property.Bedrooms,
+		Bathrooms:    property.Bathrooms,
+		ParkingSpots: property.ParkingSpots,
+		Area:         property.Area,
+		Photos:       property.Photos,
+		Amenities:    property.Amenities,
+		Floor:        property.Floor,
+		TotalFloors:  property.TotalFloors,
+		YearBuilt:    property.YearBuilt,
+		Condition:    property.Condition,
+		Orientation:  property.Orientation,
+	}
+}
+
+func materialChange(current, property *model.Property) bool {
+	return !reflect.DeepEqual(contentOf(current), contentOf(property))
+}
+
+// moveToStatus moves the property from the stored status to the one the rules set through the state machine
+func moveToStatus(property *model.Property, stored model.PropertyStatus, decide func(verdict model.PropertyStatus) model.PropertyStatus) error {
+	verdict := property.Status
+	property.Status = stored
+	return property.TransitionTo(decide(verdict))
+}
+
+// createdStatus returns the status of a new property, the valid ones wait for review when the moderation is required
+func createdStatus(moderation *config.Moderation, verdict model.PropertyStatus) model.PropertyStatus {
+	if verdict != model.INVALID && moderation != nil && moderation.Required {
+		return model.PENDING_REVIEW
+	}
+	return verdict
+}
+
+// editedStatus returns the status of a stored property after an edit or an evaluation
+func editedStatus(moderation *config.Moderation, current, property *model.Property, verdict model.PropertyStatus) model.PropertyStatus {
+	switch {
+	case current.Status.AwaitingReview():
+		return current.Status
+	case current.Status == model.REJECTED:
+		if materialChange(current, property) {
+			return model.PENDING_REVIEW
+		}
+		return model.REJECTED
+	case verdict == model.INVALID:
+		return model.INVALID
+	case moderation != nil && moderation.Required && (current.Status == model.INVALID || materialChange(current, property)):
+		return model.PENDING_REVIEW
+	case current.Status == model.EXPIRED:
+		return model.EXPIRED
+	}
+	return verdict
+}
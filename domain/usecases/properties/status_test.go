@@ -0,0 +1,56 @@
+package properties
+
+import (
+	"github.com/stretchr/testify/assert"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"testing"
+)
+
+func TestCreatedStatus(t *testing.T) {
+	required := &config.Moderation{Required: true}
+	assert.Equal(t, model.ACTIVE, createdStatus(nil, model.ACTIVE))
+	assert.Equal(t, model.INACTIVE, createdStatus(&config.Moderation{}, model.INACTIVE))
+	assert.Equal(t, model.PENDING_REVIEW, createdStatus(required, model.ACTIVE))
+	assert.Equal(t, model.PENDING_REVIEW, createdStatus(required, model.INACTIVE))
+	assert.Equal(t, model.INVALID, createdStatus(required, model.INVALID))
+}
+
+func TestEditedStatus(t *testing.T) {
+	required := &config.Moderation{Required: true}
+	current := &model.Property{Title: "Apartamento", Status: model.DUPLICATE_SUSPECT}
+	edited := &model.Property{Title: "Apartamento en el centro"}
+	samePricing := &model.Property{Title: "Apartamento", Pricing: model.Pricing{SalePrice: 1}}
+
+	assert.Equal(t, model.DUPLICATE_SUSPECT, editedStatus(nil, current, edited, model.ACTIVE))
+	assert.Equal(t, model.DUPLICATE_SUSPECT, editedStatus(nil, current, edited, model.INVALID))
+
+	current.Status = model.PENDING_REVIEW
+	assert.Equal(t, model.PENDING_REVIEW, editedStatus(required, current, edited, model.ACTIVE))
+
+	current.Status = model.REJECTED
+	assert.Equal(t, model.PENDING_REVIEW, editedStatus(nil, current, edited, model.ACTIVE))
+	assert.Equal(t, model.REJECTED, editedStatus(nil, current, samePricing, model.ACTIVE))
+
+	current.Status = model.ACTIVE
+	assert.Equal(t, model.INACTIVE, editedStatus(nil, current, edited, model.INACTIVE))
+	assert.Equal(t, model.INVALID, editedStatus(required, current, edited, model.INVALID))
+	assert.Equal(t, model.PENDING_REVIEW, editedStatus(required, current, edited, model.ACTIVE))
+	assert.Equal(t, model.ACTIVE, editedStatus(required, current, samePricing, model.ACTIVE))
+
+	current.Status = model.INVALID
+	assert.Equal(t, model.PENDING_REVIEW, editedStatus(required, current, samePricing, model.ACTIVE))
+	assert.Equal(t, model.ACTIVE, editedStatus(nil, current, samePricing, model.ACTIVE))
+}
+
+func TestEditedStatus_Expired(t *testing.T) {
+	required := &config.Moderation{Required: true}
+	current := &model.Property{Title: "Apartamento", Status: model.EXPIRED}
+	edited := &model.Property{Title: "Apartamento en el centro"}
+	samePricing := &model.Property{Title: "Apartamento", Pricing: model.Pricing{SalePrice: 1}}
+
+	assert.Equal(t, model.EXPIRED, editedStatus(nil, current, edited, model.ACTIVE))
+	assert.Equal(t, model.EXPIRED, editedStatus(required, current, samePricing, model.ACTIVE))
+	assert.Equal(t, model.INVALID, editedStatus(nil, current, edited, model.INVALID))
+	assert.Equal(t, model.PENDING_REVIEW, editedStatus(required, current, edited, model.ACTIVE))
+}
@@ -1,26 +1,77 @@
 package properties
 
 import (
+	"errors"
+	"fmt"
+	"lahaus/config"
 	"lahaus/domain/model"
+	"time"
 )
 
 type UpdatePropertyUseCase struct {
 	database      StorageManager
 	propertyRuler PropertyRuler
+	matcher       PropertyMatcher
+	priceWatcher  PriceWatcher
+	moderation    *config.Moderation
 }
 
-func NewUpdatePropertyUseCase(database StorageManager, propertyRuler PropertyRuler) *UpdatePropertyUseCase {
+func NewUpdatePropertyUseCase(moderation *config.Moderation, database StorageManager, propertyRuler PropertyRuler, matcher PropertyMatcher,
+	priceWatcher PriceWatcher) *UpdatePropertyUseCase {
 	return &UpdatePropertyUseCase{
+		moderation:    moderation,
 		database:      database,
 		propertyRuler: propertyRuler,
+		matcher:       matcher,
+		priceWatcher:  priceWatcher,
 	}
 }
 
-func (uc *UpdatePropertyUseCase) Execute(property *model.Property) (*model.Property, error) {
+// Execute updates the property when the user owns it and it has the stored version, admins can update any property
+func (uc *UpdatePropertyUseCase) Execute(property *model.Property, actor *model.Actor) (*model.Property, error) {
+	current, found, err := uc.database.GetProperty(property.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, model.NewEntityNotFoundError(errors.New("property not found"))
+	}
+	if actor.Role != model.ADMIN && current.OwnerID != actor.UserID {
+		return nil, model.NewForbiddenError(errors.New("the property belongs to another user"))
+	}
+	if property.Version != current.Version {
+		return nil, model.NewPreconditionFailedError(fmt.Errorf("the property has version %d", current.Version))
+	}
+	property.OwnerID = current.OwnerID
+	property.UpdateMetrics()
 	uc.propertyRuler.Execute(property)
-	propertyStored, err := uc.database.UpdateProperty(property)
+	err = moveToStatus(property, current.Status, func(verdict model.PropertyStatus) model.PropertyStatus {
+		return editedStatus(uc.moderation, current, property, verdict)
+	})
+	if err != nil {
+		return nil, err
+	}
+	audit, err := propertyAudit(current, property, actor)
 	if err != nil {
 		return nil, err
 	}
+	propertyStored, err := uc.database.UpdateProperty(property, audit)
+	if err != nil {
+		return nil, err
+	}
+	if propertyStored.Status == model.ACTIVE {
+		uc.matcher.Match(propertyStored)
+	}
+	// A property that stops being for sale keeps no sale price, and prices in another currency can not be compared, neither is a drop
+	if propertyStored.OperationType.Offers(model.SALE) && propertyStored.Pricing.Currency == current.Pricing.Currency &&
+		propertyStored.Pricing.SalePrice < current.Pricing.SalePrice {
+		uc.priceWatcher.PriceDropped(&model.PriceChange{
+			ID:            propertyStored.PriceChangeID,
+			PropertyID:    propertyStored.ID,
+			PreviousPrice: current.Pricing.SalePrice,
+			NewPrice:      propertyStored.Pricing.SalePrice,
+			ChangedAt:     time.Now().UTC(),
+		})
+	}
 	return propertyStored, nil
 }
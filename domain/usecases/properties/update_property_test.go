@@ -16,6 +16,8 @@ type UpdatePropertySuite struct {
 	suite.Suite
 	mockCtrl      *gomock.Controller
 	database      *mocks.MockStorageManager
+	matcher       *mocks.MockPropertyMatcher
+	priceWatcher  *mocks.MockPriceWatcher
 	propertyRuler *ruler.PropertyRules
 	updateUseCase *properties.UpdatePropertyUseCase
 }
@@ -27,6 +29,8 @@ func TestUpdatePropertySuite(t *testing.T) {
 func (suite *UpdatePropertySuite) SetupTest() {
 	suite.mockCtrl = gomock.NewController(suite.T())
 	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.matcher = mocks.NewMockPropertyMatcher(suite.mockCtrl)
+	suite.priceWatcher = mocks.NewMockPriceWatcher(suite.mockCtrl)
 	suite.propertyRuler = ruler.NewPropertyRulerUseCase(&config.Config{
 		BusinessRules: &config.BusinessRules{
 			HouseValidator: &config.PropertyTypeValidator{
@@ -76,10 +80,18 @@ func (suite *UpdatePropertySuite) SetupTest() {
 					LowerBound: 50 * million,
 					UpperBound: 3500 * million,
 				},
+				RentIn: config.BetweenInt{
+					LowerBound: 5000,
+					UpperBound: 150000,
+				},
+				RentOut: config.BetweenInt{
+					LowerBound: 200000,
+					UpperBound: 15 * million,
+				},
 			},
 		},
-	})
-	suite.updateUseCase = properties.NewUpdatePropertyUseCase(suite.database, suite.propertyRuler)
+	}, model.ExchangeRates{}, nil)
+	suite.updateUseCase = properties.NewUpdatePropertyUseCase(&config.Moderation{}, suite.database, suite.propertyRuler, suite.matcher, suite.priceWatcher)
 }
 
 func (suite *UpdatePropertySuite) TearDownSuite() {
@@ -107,12 +119,121 @@ func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessActive
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().UpdateProperty(property).Return(property, nil)
-	propertyResult, err := suite.updateUseCase.Execute(property)
+	suite.database.EXPECT().GetProperty(property.ID).Return(&model.Property{ID: property.ID, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	suite.matcher.EXPECT().Match(property)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.ACTIVE, propertyResult.Status)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessPriceDropped() {
+	property := &model.Property{
+		ID:           4,
+		Title:        "Casa de familia",
+		Location:     model.Location{Longitude: -99.096741, Latitude: 19.296135},
+		Pricing:      model.Pricing{SalePrice: 3 * million},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+
+	suite.database.EXPECT().GetProperty(property.ID).
+		Return(&model.Property{ID: property.ID, OwnerID: agent.UserID, Pricing: model.Pricing{SalePrice: 4 * million}}, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).DoAndReturn(func(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+		property.PriceChangeID = 12
+		return property, nil
+	})
+	suite.matcher.EXPECT().Match(property)
+	suite.priceWatcher.EXPECT().PriceDropped(gomock.Any()).Do(func(change *model.PriceChange) {
+		suite.Equal(int64(12), change.ID)
+		suite.Equal(property.ID, change.PropertyID)
+		suite.Equal(model.Amount(4*million), change.PreviousPrice)
+		suite.Equal(model.Amount(3*million), change.NewPrice)
+	})
+	_, err := suite.updateUseCase.Execute(property, agent)
+	suite.NoError(err)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessNowForRent() {
+	property := &model.Property{
+		ID:            4,
+		Title:         "Casa de familia",
+		Location:      model.Location{Longitude: -99.096741, Latitude: 19.296135},
+		Pricing:       model.Pricing{Rent: &model.RentPricing{MonthlyRent: 30000, DepositMonths: 1, MinContractMonths: 6}},
+		PropertyType:  model.HOUSE,
+		OperationType: model.RENT,
+		Bedrooms:      1,
+		Bathrooms:     1,
+		Area:          300,
+	}
+
+	suite.database.EXPECT().GetProperty(property.ID).
+		Return(&model.Property{ID: property.ID, OwnerID: agent.UserID, Pricing: model.Pricing{SalePrice: 4 * million}}, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	suite.matcher.EXPECT().Match(property)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
 	suite.NoError(err)
 	suite.Equal(model.ACTIVE, propertyResult.Status)
 }
 
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessAudit() {
+	property := &model.Property{
+		ID:           4,
+		Title:        "Casa de familia",
+		Location:     model.Location{Longitude: -99.096741, Latitude: 19.296135},
+		Pricing:      model.Pricing{SalePrice: 3 * million},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+	current := *property
+	current.OwnerID = agent.UserID
+	current.Title = "Casa"
+	current.Status = model.ACTIVE
+
+	suite.database.EXPECT().GetProperty(property.ID).Return(&current, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).DoAndReturn(func(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+		suite.Equal(model.PROPERTY, audit.Entity)
+		suite.Equal(property.ID, audit.EntityID)
+		suite.Equal(model.UPDATE, audit.Action)
+		suite.Equal(agent.UserID, *audit.ActorID)
+		suite.Equal(agent.RequestID, audit.RequestID)
+		suite.Equal(map[string]model.AuditChange{"title": {Before: "Casa", After: "Casa de familia"}}, audit.Changes)
+		return property, nil
+	})
+	suite.matcher.EXPECT().Match(property)
+	_, err := suite.updateUseCase.Execute(property, agent)
+	suite.NoError(err)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessAuditStatusChange() {
+	property := &model.Property{
+		ID:           4,
+		Title:        "Casa de familia",
+		Location:     model.Location{Longitude: -99.096741, Latitude: 20.296135},
+		Pricing:      model.Pricing{SalePrice: 130 * million},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+	current := *property
+	current.OwnerID = agent.UserID
+	current.Status = model.ACTIVE
+
+	suite.database.EXPECT().GetProperty(property.ID).Return(&current, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).DoAndReturn(func(property *model.Property, audit *model.AuditEntry) (*model.Property, error) {
+		suite.Equal(model.STATUS_CHANGE, audit.Action)
+		suite.Equal(model.AuditChange{Before: "ACTIVE", After: "INACTIVE"}, audit.Changes["status"])
+		return property, nil
+	})
+	_, err := suite.updateUseCase.Execute(property, agent)
+	suite.NoError(err)
+}
+
 func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessInactive() {
 	descriptionValue := "Casa chica"
 	property := &model.Property{
@@ -134,8 +255,9 @@ func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessInacti
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().UpdateProperty(property).Return(property, nil)
-	propertyResult, err := suite.updateUseCase.Execute(property)
+	suite.database.EXPECT().GetProperty(property.ID).Return(&model.Property{ID: property.ID, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
 	suite.NoError(err)
 	suite.Equal(model.INACTIVE, propertyResult.Status)
 }
@@ -161,8 +283,9 @@ func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccessInvali
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().UpdateProperty(property).Return(property, nil)
-	propertyResult, err := suite.updateUseCase.Execute(property)
+	suite.database.EXPECT().GetProperty(property.ID).Return(&model.Property{ID: property.ID, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
 	suite.NoError(err)
 	suite.Equal(model.INVALID, propertyResult.Status)
 }
@@ -188,8 +311,121 @@ func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteError() {
 		Photos:       nil,
 	}
 
-	suite.database.EXPECT().UpdateProperty(property).Return(nil, errors.New("fail to save in database"))
-	propertyResult, err := suite.updateUseCase.Execute(property)
+	suite.database.EXPECT().GetProperty(property.ID).Return(&model.Property{ID: property.ID, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(nil, errors.New("fail to save in database"))
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
+	suite.Error(err)
+	suite.Nil(propertyResult)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteError_NotFound() {
+	property := &model.Property{ID: 1}
+	suite.database.EXPECT().GetProperty(property.ID).Return(nil, false, nil)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+	suite.Nil(propertyResult)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteError_GetProperty() {
+	property := &model.Property{ID: 1}
+	suite.database.EXPECT().GetProperty(property.ID).Return(nil, false, errors.New("fail"))
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
 	suite.Error(err)
 	suite.Nil(propertyResult)
 }
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteError_NotOwner() {
+	property := &model.Property{ID: 1}
+	suite.database.EXPECT().GetProperty(property.ID).Return(&model.Property{ID: 1, OwnerID: agent.UserID + 1}, true, nil)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
+	suite.IsType(&model.ForbiddenError{}, err)
+	suite.Nil(propertyResult)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteError_VersionMismatch() {
+	property := &model.Property{ID: 1, Version: 2}
+	suite.database.EXPECT().GetProperty(property.ID).Return(&model.Property{ID: 1, OwnerID: agent.UserID, Version: 3}, true, nil)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
+	suite.IsType(&model.PreconditionFailedError{}, err)
+	suite.Nil(propertyResult)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccess_AdminOverride() {
+	admin := &model.Actor{UserID: 1, Role: model.ADMIN}
+	property := &model.Property{
+		ID:    1,
+		Title: "Casa de familia",
+		Location: model.Location{
+			Longitude: -99.096741,
+			Latitude:  19.296135,
+		},
+		Pricing: model.Pricing{
+			SalePrice: 3 * million,
+		},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+	suite.database.EXPECT().GetProperty(property.ID).Return(&model.Property{ID: 1, OwnerID: agent.UserID}, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	suite.matcher.EXPECT().Match(property)
+	propertyResult, err := suite.updateUseCase.Execute(property, admin)
+	suite.NoError(err)
+	suite.Equal(agent.UserID, propertyResult.OwnerID)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccess_MaterialEditPendingReview() {
+	updateUseCase := properties.NewUpdatePropertyUseCase(&config.Moderation{Required: true}, suite.database, suite.propertyRuler, suite.matcher, suite.priceWatcher)
+	property := &model.Property{
+		ID:           4,
+		Title:        "Casa de familia",
+		Location:     model.Location{Longitude: -99.096741, Latitude: 19.296135},
+		Pricing:      model.Pricing{SalePrice: 3 * million},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+	current := *property
+	current.OwnerID = agent.UserID
+	current.Status = model.ACTIVE
+
+	suite.database.EXPECT().GetProperty(property.ID).Return(&current, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	suite.matcher.EXPECT().Match(property)
+	propertyResult, err := updateUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.ACTIVE, propertyResult.Status)
+
+	edited := *property
+	edited.Bedrooms = 2
+	suite.database.EXPECT().GetProperty(property.ID).Return(&current, true, nil)
+	suite.database.EXPECT().UpdateProperty(&edited, gomock.Any()).Return(&edited, nil)
+	propertyResult, err = updateUseCase.Execute(&edited, agent)
+	suite.NoError(err)
+	suite.Equal(model.PENDING_REVIEW, propertyResult.Status)
+}
+
+func (suite *UpdatePropertySuite) TestUpdatePropertyUseCase_ExecuteSuccess_RejectedKeepsStatus() {
+	property := &model.Property{
+		ID:           4,
+		Title:        "Casa de familia",
+		Location:     model.Location{Longitude: -99.096741, Latitude: 19.296135},
+		Pricing:      model.Pricing{SalePrice: 3 * million},
+		PropertyType: model.HOUSE,
+		Bedrooms:     1,
+		Bathrooms:    1,
+		Area:         300,
+	}
+	current := *property
+	current.OwnerID = agent.UserID
+	current.Status = model.REJECTED
+	current.Pricing.SalePrice = 2 * million
+
+	suite.database.EXPECT().GetProperty(property.ID).Return(&current, true, nil)
+	suite.database.EXPECT().UpdateProperty(property, gomock.Any()).Return(property, nil)
+	propertyResult, err := suite.updateUseCase.Execute(property, agent)
+	suite.NoError(err)
+	suite.Equal(model.REJECTED, propertyResult.Status)
+}
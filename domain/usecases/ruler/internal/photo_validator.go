@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"fmt"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"net/url"
+	"strings"
+)
+
+type PhotoValidator struct {
+	AllowedHosts   []string
+	HTTPSOnly      bool
+	MaxCount       int
+	MinActiveCount int
+	// UploadsURL is the public URL of the uploaded photos, they are allowed whatever the host or the scheme
+	UploadsURL string
+}
+
+func NewPhotoRuler(config *config.Config) PropertyRulerFunc {
+	policy := config.BusinessRules.PhotoPolicy
+	validator := PhotoValidator{
+		AllowedHosts:   policy.AllowedHosts,
+		HTTPSOnly:      policy.HTTPSOnly,
+		MaxCount:       policy.MaxCount,
+		MinActiveCount: policy.MinActiveCount,
+	}
+	if config.SystemSettings != nil && config.SystemSettings.Photos != nil && config.SystemSettings.Photos.PublicURL != "" {
+		validator.UploadsURL = strings.TrimRight(config.SystemSettings.Photos.PublicURL, "/") + "/"
+	}
+
+	return validator.IsValidPhotos()
+}
+
+// IsValidPhotos normalizes the photos before checking them, it must run after the rulers that set the status
+func (pv *PhotoValidator) IsValidPhotos() PropertyRulerFunc {
+	return func(property *model.Property) error {
+		var photos model.Photos
+		var invalid error
+		seen := map[string]bool{}
+		for _, photo := range property.Photos {
+			photo = strings.TrimSpace(photo)
+			if photo == "" {
+				continue
+			}
+			normalized, err := pv.normalize(photo)
+			if err != nil && invalid == nil {
+				invalid = err
+			}
+			if !seen[normalized] {
+				seen[normalized] = true
+				photos = append(photos, normalized)
+			}
+		}
+		property.Photos = photos
+
+		if invalid != nil {
+			return invalid
+		}
+		if pv.MaxCount > 0 && len(photos) > pv.MaxCount {
+			return fmt.Errorf("the property has %d photos, the maximum is %d", len(photos), pv.MaxCount)
+		}
+		if property.Status == model.ACTIVE && len(photos) < pv.MinActiveCount {
+			return fmt.Errorf("the property has %d photos, the minimum is %d", len(photos), pv.MinActiveCount)
+		}
+		return nil
+	}
+}
+
+// normalize returns the photo with the host in lower case, or the photo as it is with the reason it is not allowed
+func (pv *PhotoValidator) normalize(photo string) (string, error) {
+	if pv.UploadsURL != "" && strings.HasPrefix(photo, pv.UploadsURL) {
+		return photo, nil
+	}
+	photoURL, err := url.Parse(photo)
+	if err != nil || (photoURL.Scheme != "http" && photoURL.Scheme != "https") || photoURL.Host == "" {
+		return photo, fmt.Errorf("the photo %q is not an http URL", photo)
+	}
+	photoURL.Host = strings.ToLower(photoURL.Host)
+	if pv.HTTPSOnly && photoURL.Scheme != "https" {
+		return photo, fmt.Errorf("the photo %q is not https", photo)
+	}
+	if !pv.isAllowedHost(photoURL.Hostname()) {
+		return photo, fmt.Errorf("the host of the photo %q is not allowed", photo)
+	}
+	return photoURL.String(), nil
+}
+
+func (pv *PhotoValidator) isAllowedHost(host string) bool {
+	if len(pv.AllowedHosts) == 0 {
+		return true
+	}
+	for _, allowed := range pv.AllowedHosts {
+		allowed = strings.ToLower(allowed)
+		if strings.HasPrefix(allowed, "*.") {
+			if strings.HasSuffix(host, allowed[1:]) {
+				return true
+			}
+		} else if host == allowed {
+			return true
+		}
+	}
+	return false
+}
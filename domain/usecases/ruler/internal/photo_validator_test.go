@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"lahaus/config"
+	"lahaus/domain/model"
+	"reflect"
+	"testing"
+)
+
+func TestPhotoValidator_IsValidPhotos(t *testing.T) {
+
+	ruler := NewPhotoRuler(&config.Config{
+		SystemSettings: &config.SystemSettings{
+			Photos: &config.Photos{PublicURL: "http://localhost:8080/photos/"},
+		},
+		BusinessRules: &config.BusinessRules{
+			PhotoPolicy: &config.PhotoPolicy{
+				AllowedHosts:   []string{"cdn.pixabay.com", "*.lahaus.com"},
+				HTTPSOnly:      true,
+				MaxCount:       3,
+				MinActiveCount: 2,
+			},
+		},
+	})
+	tests := []struct {
+		name       string
+		property   model.Property
+		wantError  bool
+		wantPhotos model.Photos
+	}{
+		{"photos are allowed", model.Property{Status: model.ACTIVE, Photos: model.Photos{"https://cdn.pixabay.com/a.jpg", "https://img.lahaus.com/b.jpg"}},
+			false, model.Photos{"https://cdn.pixabay.com/a.jpg", "https://img.lahaus.com/b.jpg"}},
+		{"blank and repeated photos are dropped", model.Property{Status: model.ACTIVE, Photos: model.Photos{" https://CDN.pixabay.com/a.jpg ", "", "https://cdn.pixabay.com/a.jpg", "https://cdn.pixabay.com/b.jpg"}},
+			false, model.Photos{"https://cdn.pixabay.com/a.jpg", "https://cdn.pixabay.com/b.jpg"}},
+		{"uploaded photos are allowed", model.Property{Status: model.ACTIVE, Photos: model.Photos{"http://localhost:8080/photos/properties/1/a/web.jpg", "https://cdn.pixabay.com/a.jpg"}},
+			false, model.Photos{"http://localhost:8080/photos/properties/1/a/web.jpg", "https://cdn.pixabay.com/a.jpg"}},
+		{"javascript is not allowed", model.Property{Photos: model.Photos{"javascript:alert(1)"}}, true, model.Photos{"javascript:alert(1)"}},
+		{"http is not allowed", model.Property{Photos: model.Photos{"http://cdn.pixabay.com/a.jpg"}}, true, model.Photos{"http://cdn.pixabay.com/a.jpg"}},
+		{"host is not allowed", model.Property{Photos: model.Photos{"https://example.com/a.jpg"}}, true, model.Photos{"https://example.com/a.jpg"}},
+		{"too many photos", model.Property{Photos: model.Photos{"https://cdn.pixabay.com/a.jpg", "https://cdn.pixabay.com/b.jpg", "https://cdn.pixabay.com/c.jpg", "https://cdn.pixabay.com/d.jpg"}},
+			true, model.Photos{"https://cdn.pixabay.com/a.jpg", "https://cdn.pixabay.com/b.jpg", "https://cdn.pixabay.com/c.jpg", "https://cdn.pixabay.com/d.jpg"}},
+		{"active property has too few photos", model.Property{Status: model.ACTIVE, Photos: model.Photos{"https://cdn.pixabay.com/a.jpg"}}, true, model.Photos{"https://cdn.pixabay.com/a.jpg"}},
+		{"inactive property needs no photos", model.Property{Status: model.INACTIVE, Photos: model.Photos{""}}, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruler(&tt.property); (got != nil) != tt.wantError {
+				t.Errorf("ruler() = %v, want %v", got, tt.wantError)
+			}
+			if !reflect.DeepEqual(tt.property.Photos, tt.wantPhotos) {
+				t.Errorf("photos = %v, want %v", tt.property.Photos, tt.wantPhotos)
+			}
+		})
+	}
+}
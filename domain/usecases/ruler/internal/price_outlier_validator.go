@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/logger"
+)
+
+// PriceStatistics gives the quartiles of the price per square meter of the other ACTIVE properties of the type and currency
+type PriceStatistics interface {
+	PricePerSquareMeterQuartiles(propertyType model.PropertyType, currency model.Currency, excludedID int64) (*model.PriceQuartiles, error)
+}
+
+type PriceOutlierValidator struct {
+	IQRMultiplier float64
+	MinSamples    int
+	Statistics    PriceStatistics
+}
+
+func NewPriceOutlierRuler(config *config.Config, statistics PriceStatistics) PropertyRulerFunc {
+	validator := PriceOutlierValidator{
+		IQRMultiplier: config.BusinessRules.PriceOutliers.IQRMultiplier,
+		MinSamples:    config.BusinessRules.PriceOutliers.MinSamples,
+		Statistics:    statistics,
+	}
+	return validator.IsUsualPricePerSquareMeter()
+}
+
+// IsUsualPricePerSquareMeter checks the price per square meter against the Tukey fences of the similar properties
+func (pov PriceOutlierValidator) IsUsualPricePerSquareMeter() PropertyRulerFunc {
+	return func(property *model.Property) error {
+		if property.PricePerSquareMeter == nil {
+			return nil
+		}
+		quartiles, err := pov.Statistics.PricePerSquareMeterQuartiles(property.PropertyType, property.Pricing.Currency, property.ID)
+		if err != nil {
+			logger.GetInstance().Error("error getting the price per square meter quartiles", zap.Error(err), zap.Int64("propertyId", property.ID))
+			return nil
+		}
+		if quartiles.Samples < pov.MinSamples {
+			return nil
+		}
+		fence := pov.IQRMultiplier * (quartiles.ThirdQuartile - quartiles.FirstQuartile)
+		lower, upper := quartiles.FirstQuartile-fence, quartiles.ThirdQuartile+fence
+		pricePerSquareMeter := float64(*property.PricePerSquareMeter)
+		if pricePerSquareMeter < lower || pricePerSquareMeter > upper {
+			return fmt.Errorf("the price per square meter %d is out of the usual range of similar properties, from %.0f to %.0f",
+				*property.PricePerSquareMeter, lower, upper)
+		}
+		return nil
+	}
+}
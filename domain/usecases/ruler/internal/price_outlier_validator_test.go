@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"errors"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"testing"
+)
+
+type priceStatisticsStub struct {
+	quartiles *model.PriceQuartiles
+	err       error
+}
+
+func (stub priceStatisticsStub) PricePerSquareMeterQuartiles(model.PropertyType, model.Currency, int64) (*model.PriceQuartiles, error) {
+	return stub.quartiles, stub.err
+}
+
+func TestPriceOutlierValidator_IsUsualPricePerSquareMeter(t *testing.T) {
+	rulesConfig := &config.Config{BusinessRules: &config.BusinessRules{PriceOutliers: &config.PriceOutliers{IQRMultiplier: 1.5, MinSamples: 10}}}
+	enough := priceStatisticsStub{quartiles: &model.PriceQuartiles{FirstQuartile: 1000, ThirdQuartile: 1200, Samples: 50}}
+	price := func(v model.Amount) *model.Amount {
+		return &v
+	}
+
+	tests := []struct {
+		name       string
+		statistics PriceStatistics
+		price      *model.Amount
+		wantError  bool
+	}{
+		{"price inside the quartiles", enough, price(1100), false},
+		{"price on the upper fence", enough, price(1500), false},
+		{"price above the upper fence", enough, price(1501), true},
+		{"price below the lower fence", enough, price(699), true},
+		{"property without price per square meter", enough, nil, false},
+		{"not enough properties to compare", priceStatisticsStub{quartiles: &model.PriceQuartiles{FirstQuartile: 1000, ThirdQuartile: 2000, Samples: 9}}, price(9000), false},
+		{"statistics fail", priceStatisticsStub{err: errors.New("fail")}, price(9000), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruler := NewPriceOutlierRuler(rulesConfig, tt.statistics)
+			if got := ruler(&model.Property{PricePerSquareMeter: tt.price}); (got != nil) != tt.wantError {
+				t.Errorf("ruler() = %v, want %v", got, tt.wantError)
+			}
+		})
+	}
+}
@@ -6,15 +6,29 @@ import (
 	"lahaus/domain/model"
 )
 
+// CurrencyConverter converts the amounts in minor units between currencies
+type CurrencyConverter interface {
+	Convert(amount model.Amount, from, to model.Currency) (model.Amount, error)
+}
+
+// PriceValidator checks the prices in the base currency of the zone, CurrencyIn inside the bundle box and CurrencyOut outside
 type PriceValidator struct {
-	Longitude BetweenFloat
-	Latitude  BetweenFloat
-	PriceIn   BetweenInt
-	PriceOut  BetweenInt
+	Longitude   BetweenFloat
+	Latitude    BetweenFloat
+	PriceIn     BetweenInt
+	PriceOut    BetweenInt
+	RentIn      BetweenInt
+	RentOut     BetweenInt
+	CurrencyIn  model.Currency
+	CurrencyOut model.Currency
+	Rates       CurrencyConverter
 }
 
-func NewPriceRuler(config *config.Config) PropertyRulerFunc {
+func NewPriceRuler(config *config.Config, rates CurrencyConverter) PropertyRulerFunc {
 	validator := PriceValidator{
+		CurrencyIn:  model.Currency(config.BusinessRules.BundleValidator.CurrencyIn),
+		CurrencyOut: model.Currency(config.BusinessRules.BundleValidator.CurrencyOut),
+		Rates:       rates,
 		PriceIn: BetweenInt{
 			LowerBound: config.BusinessRules.BundleValidator.PriceIn.LowerBound,
 			UpperBound: config.BusinessRules.BundleValidator.PriceIn.UpperBound,
@@ -23,6 +37,14 @@ func NewPriceRuler(config *config.Config) PropertyRulerFunc {
 			LowerBound: config.BusinessRules.BundleValidator.PriceOut.LowerBound,
 			UpperBound: config.BusinessRules.BundleValidator.PriceOut.UpperBound,
 		},
+		RentIn: BetweenInt{
+			LowerBound: config.BusinessRules.BundleValidator.RentIn.LowerBound,
+			UpperBound: config.BusinessRules.BundleValidator.RentIn.UpperBound,
+		},
+		RentOut: BetweenInt{
+			LowerBound: config.BusinessRules.BundleValidator.RentOut.LowerBound,
+			UpperBound: config.BusinessRules.BundleValidator.RentOut.UpperBound,
+		},
 		Longitude: BetweenFloat{
 			LowerBound: config.BusinessRules.BundleValidator.Longitude.LowerBound,
 			UpperBound: config.BusinessRules.BundleValidator.Longitude.UpperBound,
@@ -37,25 +59,55 @@ func NewPriceRuler(config *config.Config) PropertyRulerFunc {
 
 }
 
+// IsValidPrice checks the prices of the operation type of the property
 func (lv *PriceValidator) IsValidPrice() PropertyRulerFunc {
 	return func(property *model.Property) error {
 		inside := lv.IsInsideBundleBox(property)
 
+		priceRange, rentRange, currency := lv.PriceOut, lv.RentOut, lv.CurrencyOut
 		if inside {
 			property.Status = model.ACTIVE
-			if property.Pricing.SalePrice < lv.PriceIn.LowerBound || property.Pricing.SalePrice > lv.PriceIn.UpperBound {
+			priceRange, rentRange, currency = lv.PriceIn, lv.RentIn, lv.CurrencyIn
+		} else {
+			property.Status = model.INACTIVE
+		}
+		if property.Pricing.Currency == "" {
+			property.Pricing.Currency = currency
+		}
+		if property.OperationType.Offers(model.SALE) {
+			salePrice, err := lv.toBaseCurrency(property.Pricing.SalePrice, property.Pricing.Currency, currency)
+			if err != nil {
+				return err
+			}
+			if !priceRange.contains(int64(salePrice)) {
 				return fmt.Errorf("price is incorrect") // TODO: return values
 			}
-			return nil
 		}
-		property.Status = model.INACTIVE
-		if property.Pricing.SalePrice < lv.PriceOut.LowerBound || property.Pricing.SalePrice > lv.PriceOut.UpperBound {
-			return fmt.Errorf("price is incorrect") //TODO: return values
+		if property.OperationType.Offers(model.RENT) {
+			rent := property.Pricing.Rent
+			if rent == nil {
+				return fmt.Errorf("rent is incorrect")
+			}
+			monthlyRent, err := lv.toBaseCurrency(rent.MonthlyRent, property.Pricing.Currency, currency)
+			if err != nil {
+				return err
+			}
+			if !rentRange.contains(int64(monthlyRent)) {
+				return fmt.Errorf("rent is incorrect")
+			}
 		}
 		return nil
 	}
 }
 
+// toBaseCurrency leaves the amount as it is when the zone has no base currency
+func (lv *PriceValidator) toBaseCurrency(amount model.Amount, from, base model.Currency) (model.Amount, error) {
+	if base == "" || from == base {
+		return amount, nil
+	}
+	return lv.Rates.Convert(amount, from, base)
+}
+
 func (lv *PriceValidator) IsInsideBundleBox(property *model.Property) bool {
 	return lv.isInsideLatitudeBox(property) && lv.isInsideLongitudeBox(property)
 }
@@ -28,9 +28,19 @@ func TestPriceValidator_IsValidPrice(t *testing.T) {
 					LowerBound: 50 * million,
 					UpperBound: 3500 * million,
 				},
+				RentIn: config.BetweenInt{
+					LowerBound: 5000,
+					UpperBound: 150000,
+				},
+				RentOut: config.BetweenInt{
+					LowerBound: 200000,
+					UpperBound: 15 * million,
+				},
+				CurrencyIn:  "MXN",
+				CurrencyOut: "COP",
 			},
 		},
-	})
+	}, model.ExchangeRates{model.USD: 1, model.MXN: 17.5, model.COP: 4000})
 	tests := []struct {
 		name      string
 		property  model.Property
@@ -40,6 +50,14 @@ func TestPriceValidator_IsValidPrice(t *testing.T) {
 		{"property is inside and price is invalid", model.Property{Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{SalePrice: 20 * million}}, true},
 		{"property is outside and price is valid", model.Property{Location: model.Location{Longitude: -99.5, Latitude: 19.3}, Pricing: model.Pricing{SalePrice: 200 * million}}, false},
 		{"property is outside and price is invalid", model.Property{Location: model.Location{Longitude: -99.5, Latitude: 19.3}, Pricing: model.Pricing{SalePrice: 2 * million}}, true},
+		{"property for rent is inside and rent is valid", model.Property{OperationType: model.RENT, Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{Rent: &model.RentPricing{MonthlyRent: 20000}}}, false},
+		{"property for rent is inside and rent is invalid", model.Property{OperationType: model.RENT, Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{Rent: &model.RentPricing{MonthlyRent: 2 * million}}}, true},
+		{"property for rent is outside and rent is valid", model.Property{OperationType: model.RENT, Location: model.Location{Longitude: -99.5, Latitude: 19.3}, Pricing: model.Pricing{Rent: &model.RentPricing{MonthlyRent: 2 * million}}}, false},
+		{"property for rent has no rent", model.Property{OperationType: model.RENT, Location: model.Location{Longitude: -99.1, Latitude: 19.3}}, true},
+		{"property in another currency is converted to the zone currency", model.Property{Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{Currency: model.USD, SalePrice: 200000}}, false},
+		{"property in another currency is out of range once converted", model.Property{Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{Currency: model.USD, SalePrice: 2 * million}}, true},
+		{"property in a currency without rate", model.Property{Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{Currency: "EUR", SalePrice: 2 * million}}, true},
+		{"property for sale and rent has an invalid rent", model.Property{OperationType: model.SALE_AND_RENT, Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{SalePrice: 2 * million, Rent: &model.RentPricing{MonthlyRent: 1000}}}, true},
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
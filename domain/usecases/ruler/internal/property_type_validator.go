@@ -13,11 +13,27 @@ type BetweenInt struct {
 	UpperBound int
 }
 
+func (between BetweenInt) contains(value int64) bool {
+	return value >= int64(between.LowerBound) && value <= int64(between.UpperBound)
+}
+
+// optionalBetween copies a range of the configuration, nil when the range is not configured
+func optionalBetween(between *config.BetweenInt) *BetweenInt {
+	if between == nil {
+		return nil
+	}
+	return &BetweenInt{LowerBound: between.LowerBound, UpperBound: between.UpperBound}
+}
+
 type PropertyTypeRules struct {
 	Bedrooms     BetweenInt
 	Bathrooms    BetweenInt
 	Area         BetweenInt
 	ParkingSpots int
+	Floor        *BetweenInt
+	TotalFloors  *BetweenInt
+	YearBuilt    *BetweenInt
+	Amenities    []string
 }
 
 func NewPropertyTypeRuler(config *config.Config) PropertyRulerFunc {
@@ -35,6 +51,10 @@ func NewPropertyTypeRuler(config *config.Config) PropertyRulerFunc {
 			UpperBound: config.BusinessRules.HouseValidator.Area.UpperBound,
 		},
 		ParkingSpots: config.BusinessRules.HouseValidator.ParkingSpots,
+		Floor:        optionalBetween(config.BusinessRules.HouseValidator.Floor),
+		TotalFloors:  optionalBetween(config.BusinessRules.HouseValidator.TotalFloors),
+		YearBuilt:    optionalBetween(config.BusinessRules.HouseValidator.YearBuilt),
+		Amenities:    config.BusinessRules.HouseValidator.Amenities,
 	}
 	apartmentValidator := PropertyTypeRules{
 		Bedrooms: BetweenInt{
@@ -50,6 +70,10 @@ func NewPropertyTypeRuler(config *config.Config) PropertyRulerFunc {
 			UpperBound: config.BusinessRules.ApartmentValidator.Area.UpperBound,
 		},
 		ParkingSpots: config.BusinessRules.ApartmentValidator.ParkingSpots,
+		Floor:        optionalBetween(config.BusinessRules.ApartmentValidator.Floor),
+		TotalFloors:  optionalBetween(config.BusinessRules.ApartmentValidator.TotalFloors),
+		YearBuilt:    optionalBetween(config.BusinessRules.ApartmentValidator.YearBuilt),
+		Amenities:    config.BusinessRules.ApartmentValidator.Amenities,
 	}
 
 	houseRuleFunc := PropertyRulerFuncs{
@@ -57,6 +81,10 @@ func NewPropertyTypeRuler(config *config.Config) PropertyRulerFunc {
 		houseValidator.IsValidBathrooms(),
 		houseValidator.IsValidArea(),
 		houseValidator.IsValidParkingSpot(),
+		houseValidator.IsValidFloor(),
+		houseValidator.IsValidTotalFloors(),
+		houseValidator.IsValidYearBuilt(),
+		houseValidator.IsValidAmenities(),
 	}
 
 	apartmentRuleFunc := PropertyRulerFuncs{
@@ -64,6 +92,10 @@ func NewPropertyTypeRuler(config *config.Config) PropertyRulerFunc {
 		apartmentValidator.IsValidBathrooms(),
 		apartmentValidator.IsValidArea(),
 		apartmentValidator.IsValidParkingSpot(),
+		apartmentValidator.IsValidFloor(),
+		apartmentValidator.IsValidTotalFloors(),
+		apartmentValidator.IsValidYearBuilt(),
+		apartmentValidator.IsValidAmenities(),
 	}
 
 	ruler := PropertyTypeRuler{
@@ -118,3 +150,60 @@ func (ptv PropertyTypeRules) IsValidParkingSpot() PropertyRulerFunc {
 		return nil
 	}
 }
+
+func (ptv PropertyTypeRules) IsValidFloor() PropertyRulerFunc {
+	return func(property *model.Property) error {
+		if property.Floor == nil {
+			return nil
+		}
+		if ptv.Floor == nil {
+			return fmt.Errorf("floor is not allowed for %v properties", property.PropertyType)
+		}
+		if !ptv.Floor.contains(int64(*property.Floor)) {
+			return fmt.Errorf("floor must be between %v and %v", ptv.Floor.LowerBound, ptv.Floor.UpperBound)
+		}
+		if property.TotalFloors != nil && *property.Floor > *property.TotalFloors {
+			return fmt.Errorf("floor must not be greater than totalfloors")
+		}
+		return nil
+	}
+}
+
+func (ptv PropertyTypeRules) IsValidTotalFloors() PropertyRulerFunc {
+	return func(property *model.Property) error {
+		if property.TotalFloors != nil && ptv.TotalFloors != nil && !ptv.TotalFloors.contains(int64(*property.TotalFloors)) {
+			return fmt.Errorf("totalfloors must be between %v and %v", ptv.TotalFloors.LowerBound, ptv.TotalFloors.UpperBound)
+		}
+		return nil
+	}
+}
+
+func (ptv PropertyTypeRules) IsValidYearBuilt() PropertyRulerFunc {
+	return func(property *model.Property) error {
+		if property.YearBuilt != nil && ptv.YearBuilt != nil && !ptv.YearBuilt.contains(int64(*property.YearBuilt)) {
+			return fmt.Errorf("yearbuilt must be between %v and %v", ptv.YearBuilt.LowerBound, ptv.YearBuilt.UpperBound)
+		}
+		return nil
+	}
+}
+
+func (ptv PropertyTypeRules) IsValidAmenities() PropertyRulerFunc {
+	return func(property *model.Property) error {
+		if len(ptv.Amenities) == 0 {
+			return nil
+		}
+		for _, amenity := range property.Amenities {
+			allowed := false
+			for _, configured := range ptv.Amenities {
+				if string(amenity) == configured {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return fmt.Errorf("amenity %v is not allowed for %v properties", amenity, property.PropertyType)
+			}
+		}
+		return nil
+	}
+}
@@ -96,3 +96,70 @@ func TestPropertyTypeRuler(t *testing.T) {
 	}
 
 }
+
+func TestPropertyTypeRuler_Attributes(t *testing.T) {
+	ruler := NewPropertyTypeRuler(&config.Config{
+		BusinessRules: &config.BusinessRules{
+			HouseValidator: &config.PropertyTypeValidator{
+				Bedrooms:    &config.BetweenInt{LowerBound: 1, UpperBound: 14},
+				Bathrooms:   &config.BetweenInt{LowerBound: 1, UpperBound: 12},
+				Area:        &config.BetweenInt{LowerBound: 50, UpperBound: 3000},
+				TotalFloors: &config.BetweenInt{LowerBound: 1, UpperBound: 5},
+				YearBuilt:   &config.BetweenInt{LowerBound: 1800, UpperBound: 2030},
+				Amenities:   []string{"POOL", "GYM", "PET_FRIENDLY", "FURNISHED"},
+			},
+			ApartmentValidator: &config.PropertyTypeValidator{
+				Bedrooms:     &config.BetweenInt{LowerBound: 1, UpperBound: 6},
+				Bathrooms:    &config.BetweenInt{LowerBound: 1, UpperBound: 4},
+				Area:         &config.BetweenInt{LowerBound: 40, UpperBound: 400},
+				ParkingSpots: 1,
+				Floor:        &config.BetweenInt{LowerBound: 1, UpperBound: 100},
+				TotalFloors:  &config.BetweenInt{LowerBound: 1, UpperBound: 100},
+			},
+		},
+	})
+
+	value := func(v int) *int {
+		return &v
+	}
+	house := func() *model.Property {
+		return &model.Property{PropertyType: model.HOUSE, Bedrooms: 3, Bathrooms: 2, Area: 400}
+	}
+	apartment := func() *model.Property {
+		return &model.Property{PropertyType: model.APARTMENT, Bedrooms: 3, Bathrooms: 2, Area: 100}
+	}
+
+	tests := []struct {
+		name      string
+		property  func() *model.Property
+		change    func(property *model.Property)
+		wantError bool
+	}{
+		{"house with total floors, year built and allowed amenities", house, func(property *model.Property) {
+			property.TotalFloors, property.YearBuilt, property.Amenities = value(2), value(1995), []model.Amenity{model.POOL, model.GYM}
+		}, false},
+		{"house with a floor", house, func(property *model.Property) { property.Floor = value(1) }, true},
+		{"house with too many floors", house, func(property *model.Property) { property.TotalFloors = value(8) }, true},
+		{"house built too early", house, func(property *model.Property) { property.YearBuilt = value(1700) }, true},
+		{"house with an elevator", house, func(property *model.Property) { property.Amenities = []model.Amenity{model.ELEVATOR} }, true},
+		{"apartment with a floor and any amenity", apartment, func(property *model.Property) {
+			property.Floor, property.TotalFloors, property.Amenities = value(7), value(20), []model.Amenity{model.ELEVATOR, model.DOORMAN}
+		}, false},
+		{"apartment without year built range", apartment, func(property *model.Property) { property.YearBuilt = value(1500) }, false},
+		{"apartment out of the floor range", apartment, func(property *model.Property) { property.Floor = value(0) }, true},
+		{"apartment above the top floor", apartment, func(property *model.Property) {
+			property.Floor, property.TotalFloors = value(12), value(10)
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			property := tt.property()
+			tt.change(property)
+			if tt.wantError {
+				require.Error(t, ruler(property))
+			} else {
+				require.NoError(t, ruler(property))
+			}
+		})
+	}
+}
@@ -6,26 +6,57 @@ import (
 	"lahaus/domain/usecases/ruler/internal"
 )
 
+// PropertyRules holds the rules a property must follow, and the flags that only mark it as suspicious
 type PropertyRules struct {
 	rulers []internal.PropertyRulerFunc
+	flags  []internal.PropertyRulerFunc
 }
 
-func NewPropertyRulerUseCase(config *config.Config) *PropertyRules {
+// CurrencyConverter converts the prices to the base currency of the zone of the property
+type CurrencyConverter interface {
+	Convert(amount model.Amount, from, to model.Currency) (model.Amount, error)
+}
+
+// PriceStatistics gives the quartiles of the price per square meter of the properties like the one checked
+type PriceStatistics interface {
+	PricePerSquareMeterQuartiles(propertyType model.PropertyType, currency model.Currency, excludedID int64) (*model.PriceQuartiles, error)
+}
+
+func NewPropertyRulerUseCase(config *config.Config, rates CurrencyConverter, statistics PriceStatistics) *PropertyRules {
 	pv := &PropertyRules{
 		rulers: []internal.PropertyRulerFunc{
 			internal.NewLocationRuler(),
 			internal.NewPropertyTypeRuler(config),
-			internal.NewPriceRuler(config),
+			internal.NewPriceRuler(config, rates),
 		},
 	}
+	// The photo policy goes after the price, which sets the status its minimum depends on
+	if config.BusinessRules.PhotoPolicy != nil {
+		pv.rulers = append(pv.rulers, internal.NewPhotoRuler(config))
+	}
+	if config.BusinessRules.PriceOutliers != nil {
+		pv.flags = append(pv.flags, internal.NewPriceOutlierRuler(config, statistics))
+	}
 	return pv
 }
 
+// Execute sets the status of the property, the first broken rule makes it INVALID
 func (pv *PropertyRules) Execute(property *model.Property) {
+	property.InvalidReason = nil
+	property.SuspectReason = nil
 	for _, rule := range pv.rulers {
 		if err := rule(property); err != nil {
+			reason := err.Error()
 			property.Status = model.INVALID
-			break
+			property.InvalidReason = &reason
+			return
+		}
+	}
+	for _, flag := range pv.flags {
+		if err := flag(property); err != nil {
+			reason := err.Error()
+			property.SuspectReason = &reason
+			return
 		}
 	}
 }
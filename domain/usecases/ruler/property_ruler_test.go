@@ -60,7 +60,7 @@ func TestPropertyRuler_Execute(t *testing.T) {
 				},
 			},
 		},
-	})
+	}, model.ExchangeRates{}, nil)
 
 	value1 := 1
 	tests := []struct {
@@ -94,3 +94,95 @@ func TestPropertyRuler_Execute(t *testing.T) {
 	}
 
 }
+
+func TestPropertyRuler_ExecuteReason(t *testing.T) {
+
+	validator := &config.PropertyTypeValidator{
+		Bedrooms:  &config.BetweenInt{LowerBound: 1, UpperBound: 14},
+		Bathrooms: &config.BetweenInt{LowerBound: 1, UpperBound: 12},
+		Area:      &config.BetweenInt{LowerBound: 50, UpperBound: 3000},
+	}
+	ruler := NewPropertyRulerUseCase(&config.Config{
+		BusinessRules: &config.BusinessRules{
+			HouseValidator:     validator,
+			ApartmentValidator: validator,
+			BundleValidator: &config.BundleValidator{
+				Longitude: config.BetweenFloat{LowerBound: -99.296741, UpperBound: -98.916339},
+				Latitude:  config.BetweenFloat{LowerBound: 19.296134, UpperBound: 19.661237},
+				PriceIn:   config.BetweenInt{LowerBound: 1, UpperBound: 100},
+				PriceOut:  config.BetweenInt{LowerBound: 1, UpperBound: 100},
+			},
+			PhotoPolicy: &config.PhotoPolicy{HTTPSOnly: true},
+		},
+	}, model.ExchangeRates{}, nil)
+
+	property := model.Property{PropertyType: model.HOUSE, Bedrooms: 2, Bathrooms: 2, Area: 400,
+		Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{SalePrice: 50},
+		Photos: model.Photos{"javascript:alert(1)"}}
+	ruler.Execute(&property)
+	if property.Status != model.INVALID || property.InvalidReason == nil {
+		t.Fatalf("ruler() = %v, want INVALID with a reason", property.Status)
+	}
+	if *property.InvalidReason != `the photo "javascript:alert(1)" is not an http URL` {
+		t.Errorf("reason = %v", *property.InvalidReason)
+	}
+
+	property.Photos = model.Photos{"https://cdn.pixabay.com/a.jpg"}
+	ruler.Execute(&property)
+	if property.Status != model.ACTIVE || property.InvalidReason != nil {
+		t.Errorf("ruler() = %v, want ACTIVE without a reason", property.Status)
+	}
+}
+
+type priceStatisticsStub struct {
+	quartiles *model.PriceQuartiles
+}
+
+func (stub priceStatisticsStub) PricePerSquareMeterQuartiles(model.PropertyType, model.Currency, int64) (*model.PriceQuartiles, error) {
+	return stub.quartiles, nil
+}
+
+func TestPropertyRuler_ExecuteSuspect(t *testing.T) {
+
+	validator := &config.PropertyTypeValidator{
+		Bedrooms:  &config.BetweenInt{LowerBound: 1, UpperBound: 14},
+		Bathrooms: &config.BetweenInt{LowerBound: 1, UpperBound: 12},
+		Area:      &config.BetweenInt{LowerBound: 50, UpperBound: 3000},
+	}
+	ruler := NewPropertyRulerUseCase(&config.Config{
+		BusinessRules: &config.BusinessRules{
+			HouseValidator:     validator,
+			ApartmentValidator: validator,
+			BundleValidator: &config.BundleValidator{
+				Longitude: config.BetweenFloat{LowerBound: -99.296741, UpperBound: -98.916339},
+				Latitude:  config.BetweenFloat{LowerBound: 19.296134, UpperBound: 19.661237},
+				PriceIn:   config.BetweenInt{LowerBound: 1, UpperBound: 1000000},
+				PriceOut:  config.BetweenInt{LowerBound: 1, UpperBound: 1000000},
+			},
+			PriceOutliers: &config.PriceOutliers{IQRMultiplier: 1.5, MinSamples: 10},
+		},
+	}, model.ExchangeRates{}, priceStatisticsStub{quartiles: &model.PriceQuartiles{FirstQuartile: 100, ThirdQuartile: 200, Samples: 30}})
+
+	property := model.Property{PropertyType: model.HOUSE, Bedrooms: 2, Bathrooms: 2, Area: 100,
+		Location: model.Location{Longitude: -99.1, Latitude: 19.3}, Pricing: model.Pricing{SalePrice: 100000}}
+	property.UpdateMetrics()
+	ruler.Execute(&property)
+	if property.Status != model.ACTIVE || property.SuspectReason == nil {
+		t.Fatalf("ruler() = %v, want ACTIVE and suspect", property.Status)
+	}
+
+	property.Pricing.SalePrice = 15000
+	property.UpdateMetrics()
+	ruler.Execute(&property)
+	if property.Status != model.ACTIVE || property.SuspectReason != nil {
+		t.Errorf("ruler() = %v, want ACTIVE without suspicion", property.Status)
+	}
+
+	property.Bedrooms = 0
+	property.Pricing.SalePrice = 100000
+	property.UpdateMetrics()
+	ruler.Execute(&property)
+	if property.Status != model.INVALID || property.SuspectReason != nil {
+		t.Errorf("ruler() = %v, want INVALID without suspicion", property.Status)
+	}
+}
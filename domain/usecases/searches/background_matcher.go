@@ -0,0 +1,54 @@
+package searches
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"time"
+)
+
+type MatchSavedSearchesExecutor interface {
+	Execute(property *model.Property) error
+}
+
+// BackgroundMatcher matches the properties against the saved searches outside of the request that saved them
+type BackgroundMatcher struct {
+	matcher MatchSavedSearchesExecutor
+	queue   chan *model.Property
+	timeout time.Duration
+}
+
+func NewBackgroundMatcher(matcher MatchSavedSearchesExecutor, queueSize int, timeout time.Duration) *BackgroundMatcher {
+	return &BackgroundMatcher{
+		matcher: matcher,
+		queue:   make(chan *model.Property, queueSize),
+		timeout: timeout,
+	}
+}
+
+// Match queues a copy of the property waiting up to the timeout, the properties left out are matched by the sweep
+func (m *BackgroundMatcher) Match(property *model.Property) {
+	queued := *property
+	timer := time.NewTimer(m.timeout)
+	defer timer.Stop()
+	select {
+	case m.queue <- &queued:
+	case <-timer.C:
+		logger.GetInstance().Warn("saved searches matcher queue is full, the sweep matches the property", zap.Int64("propertyId", property.ID))
+	}
+}
+
+// Run matches the queued properties one at a time until the context is done
+func (m *BackgroundMatcher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case property := <-m.queue:
+			if err := m.matcher.Execute(property); err != nil {
+				logger.GetInstance().Error("error matching saved searches", zap.Error(err), zap.Int64("propertyId", property.ID))
+			}
+		}
+	}
+}
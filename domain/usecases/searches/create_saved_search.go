@@ -0,0 +1,81 @@
+package searches
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_saved_search.go -package=mocks -source=./create_saved_search.go
+
+type StorageManager interface {
+	SaveSavedSearch(search *model.SavedSearch) (*model.SavedSearch, error)
+	UpdateSavedSearch(search *model.SavedSearch) (*model.SavedSearch, error)
+	DeleteSavedSearch(savedSearchID int64) error
+	GetSavedSearch(savedSearchID int64) (*model.SavedSearch, bool, error)
+	ListSavedSearches(userID int64) ([]*model.SavedSearch, error)
+	ListAllSavedSearches() ([]*model.SavedSearch, error)
+	ListDueSavedSearches(frequency model.AlertFrequency, alertedBefore time.Time) ([]*model.SavedSearch, error)
+	SaveSearchMatch(savedSearchID, propertyID int64, matchedAt time.Time) (bool, error)
+	ListActivePropertiesUpdatedSince(since time.Time) ([]*model.Property, error)
+	DeliverSearchMatches(savedSearchID int64, deliveredAt time.Time, deliver func(matched []*model.Property) error) error
+	GetUserByID(userID int64) (*model.User, bool, error)
+}
+
+// Notifier delivers the alerts of the saved searches
+type Notifier interface {
+	Notify(alert *model.SearchAlert) error
+}
+
+type CreateSavedSearchUseCase struct {
+	database     StorageManager
+	verification *config.EmailVerification
+	alerts       *config.SearchAlerts
+}
+
+func NewCreateSavedSearchUseCase(verification *config.EmailVerification, alerts *config.SearchAlerts, database StorageManager) *CreateSavedSearchUseCase {
+	return &CreateSavedSearchUseCase{
+		database:     database,
+		verification: verification,
+		alerts:       alerts,
+	}
+}
+
+// Execute saves the search of the user, the frequency defaults to DAILY
+func (uc *CreateSavedSearchUseCase) Execute(search *model.SavedSearch) (*model.SavedSearch, error) {
+	if uc.verification.Required {
+		if err := checkEmailVerified(uc.database, search.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	savedSearches, err := uc.database.ListSavedSearches(search.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(savedSearches) >= uc.alerts.MaxSavedSearches {
+		return nil, model.NewDomainError(fmt.Errorf("a user can not have more than %d saved searches", uc.alerts.MaxSavedSearches))
+	}
+
+	search.ID = 0
+	if err := validateSavedSearch(savedSearches, search); err != nil {
+		return nil, err
+	}
+	return uc.database.SaveSavedSearch(search)
+}
+
+func checkEmailVerified(database StorageManager, userID int64) error {
+	user, found, err := database.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewUnauthorizedError(errors.New("user not found"))
+	}
+	if user.EmailVerifiedAt == nil {
+		return model.NewForbiddenError(errors.New("the email must be verified"))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package searches_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/searches"
+	"lahaus/domain/usecases/searches/mocks"
+	"testing"
+)
+
+type CreateSavedSearchSuite struct {
+	suite.Suite
+	mockCtrl                 *gomock.Controller
+	database                 *mocks.MockStorageManager
+	createSavedSearchUseCase *searches.CreateSavedSearchUseCase
+}
+
+func TestCreateSavedSearchSuite(t *testing.T) {
+	suite.Run(t, new(CreateSavedSearchSuite))
+}
+
+func (suite *CreateSavedSearchSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.createSavedSearchUseCase = searches.NewCreateSavedSearchUseCase(&config.EmailVerification{},
+		&config.SearchAlerts{MaxSavedSearches: 2}, suite.database)
+}
+
+func (suite *CreateSavedSearchSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func polanco() model.SearchFilters {
+	return model.SearchFilters{Polygon: []model.Location{
+		{Longitude: -99.21, Latitude: 19.42},
+		{Longitude: -99.18, Latitude: 19.42},
+		{Longitude: -99.18, Latitude: 19.44},
+	}}
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+	suite.database.EXPECT().SaveSavedSearch(&model.SavedSearch{UserID: 1, Name: "Polanco", Filters: polanco(), Frequency: model.DAILY}).
+		Return(&model.SavedSearch{ID: 3, UserID: 1, Name: "Polanco", Filters: polanco(), Frequency: model.DAILY}, nil)
+	search, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: " Polanco ", Filters: polanco()})
+	suite.NoError(err)
+	suite.Equal(int64(3), search.ID)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_EmptyName() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: " "})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_DuplicatedName() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return([]*model.SavedSearch{{ID: 2, UserID: 1, Name: "Polanco"}}, nil)
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "polanco"})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_TooManySearches() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return([]*model.SavedSearch{{ID: 2}, {ID: 3}}, nil)
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco"})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_InvalidFrequency() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco", Frequency: "HOURLY"})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_InvalidPolygon() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+	filters := polanco()
+	filters.Polygon = filters.Polygon[:2]
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco", Filters: filters})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_InvalidBbox() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+	filters := model.SearchFilters{Bbox: &model.BoundingBox{MinLongitude: -99, MinLatitude: 19.5, MaxLongitude: -99.2, MaxLatitude: 19.6}}
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco", Filters: filters})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_InvalidOperation() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+	filters := model.SearchFilters{Operation: "LEASE"}
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco", Filters: filters})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_InvalidAttributes() {
+	two, five := 2, 5
+	for _, filters := range []model.SearchFilters{
+		{Amenities: []model.Amenity{"SAUNA"}},
+		{Condition: "USED"},
+		{Orientation: "UP"},
+		{MinFloor: &five, MaxFloor: &two},
+	} {
+		suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+		_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco", Filters: filters})
+		suite.IsType(&model.DomainError{}, err)
+	}
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_EmailNotVerified() {
+	createSavedSearchUseCase := searches.NewCreateSavedSearchUseCase(&config.EmailVerification{Required: true},
+		&config.SearchAlerts{MaxSavedSearches: 2}, suite.database)
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1}, true, nil)
+	_, err := createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco"})
+	suite.IsType(&model.ForbiddenError{}, err)
+}
+
+func (suite *CreateSavedSearchSuite) TestCreateSavedSearchUseCase_ExecuteError_Save() {
+	suite.database.EXPECT().ListSavedSearches(int64(1)).Return(nil, nil)
+	suite.database.EXPECT().SaveSavedSearch(gomock.Any()).Return(nil, errors.New("fail"))
+	_, err := suite.createSavedSearchUseCase.Execute(&model.SavedSearch{UserID: 1, Name: "Polanco"})
+	suite.Error(err)
+}
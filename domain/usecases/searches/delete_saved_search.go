@@ -0,0 +1,19 @@
+package searches
+
+type DeleteSavedSearchUseCase struct {
+	database StorageManager
+}
+
+func NewDeleteSavedSearchUseCase(database StorageManager) *DeleteSavedSearchUseCase {
+	return &DeleteSavedSearchUseCase{
+		database: database,
+	}
+}
+
+// Execute deletes the saved search with its pending matches
+func (uc *DeleteSavedSearchUseCase) Execute(userID, savedSearchID int64) error {
+	if _, err := getOwnedSavedSearch(uc.database, userID, savedSearchID); err != nil {
+		return err
+	}
+	return uc.database.DeleteSavedSearch(savedSearchID)
+}
@@ -0,0 +1,68 @@
+package searches
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"time"
+)
+
+var digestPeriods = map[model.AlertFrequency]time.Duration{
+	model.INSTANT: 0,
+	model.DAILY:   24 * time.Hour,
+	model.WEEKLY:  7 * 24 * time.Hour,
+}
+
+type DeliverSearchAlertsUseCase struct {
+	database StorageManager
+	notifier Notifier
+}
+
+func NewDeliverSearchAlertsUseCase(database StorageManager, notifier Notifier) *DeliverSearchAlertsUseCase {
+	return &DeliverSearchAlertsUseCase{
+		database: database,
+		notifier: notifier,
+	}
+}
+
+// Execute sends the digest of every due search of the frequency with pending matches
+func (uc *DeliverSearchAlertsUseCase) Execute(frequency model.AlertFrequency) error {
+	period, found := digestPeriods[frequency]
+	if !found {
+		return model.NewDomainError(fmt.Errorf("invalid frequency [%v]", frequency))
+	}
+
+	now := time.Now().UTC()
+	due, err := uc.database.ListDueSavedSearches(frequency, now.Add(-period))
+	if err != nil {
+		return err
+	}
+
+	for _, search := range due {
+		if err := deliverAlert(uc.database, uc.notifier, search, now); err != nil {
+			logger.GetInstance().Error("error delivering search alert", zap.Error(err), zap.Int64("savedSearchId", search.ID))
+		}
+	}
+	return nil
+}
+
+type DeliverDueSearchAlertsUseCase struct {
+	deliver *DeliverSearchAlertsUseCase
+}
+
+func NewDeliverDueSearchAlertsUseCase(deliver *DeliverSearchAlertsUseCase) *DeliverDueSearchAlertsUseCase {
+	return &DeliverDueSearchAlertsUseCase{
+		deliver: deliver,
+	}
+}
+
+// Execute sends the due alerts of every frequency, a failing frequency does not stop the others
+func (uc *DeliverDueSearchAlertsUseCase) Execute() error {
+	for _, frequency := range []model.AlertFrequency{model.INSTANT, model.DAILY, model.WEEKLY} {
+		if err := uc.deliver.Execute(frequency); err != nil {
+			logger.GetInstance().Error("error delivering search alerts", zap.Error(err), zap.String("frequency", string(frequency)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package searches
+
+import "lahaus/domain/model"
+
+type GetSavedSearchUseCase struct {
+	database StorageManager
+}
+
+func NewGetSavedSearchUseCase(database StorageManager) *GetSavedSearchUseCase {
+	return &GetSavedSearchUseCase{
+		database: database,
+	}
+}
+
+func (uc *GetSavedSearchUseCase) Execute(userID, savedSearchID int64) (*model.SavedSearch, error) {
+	return getOwnedSavedSearch(uc.database, userID, savedSearchID)
+}
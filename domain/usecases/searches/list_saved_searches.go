@@ -0,0 +1,17 @@
+package searches
+
+import "lahaus/domain/model"
+
+type ListSavedSearchesUseCase struct {
+	database StorageManager
+}
+
+func NewListSavedSearchesUseCase(database StorageManager) *ListSavedSearchesUseCase {
+	return &ListSavedSearchesUseCase{
+		database: database,
+	}
+}
+
+func (uc *ListSavedSearchesUseCase) Execute(userID int64) ([]*model.SavedSearch, error) {
+	return uc.database.ListSavedSearches(userID)
+}
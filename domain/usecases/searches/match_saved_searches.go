@@ -0,0 +1,72 @@
+package searches
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"time"
+)
+
+type MatchSavedSearchesUseCase struct {
+	database StorageManager
+	notifier Notifier
+}
+
+func NewMatchSavedSearchesUseCase(database StorageManager, notifier Notifier) *MatchSavedSearchesUseCase {
+	return &MatchSavedSearchesUseCase{
+		database: database,
+		notifier: notifier,
+	}
+}
+
+// Execute records a match for every saved search the ACTIVE property fits and alerts the INSTANT ones
+func (uc *MatchSavedSearchesUseCase) Execute(property *model.Property) error {
+	if property.Status != model.ACTIVE {
+		return nil
+	}
+
+	savedSearches, err := uc.database.ListAllSavedSearches()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, search := range savedSearches {
+		if search.UserID == property.OwnerID || !matches(search.Filters, property) {
+			continue
+		}
+		created, err := uc.database.SaveSearchMatch(search.ID, property.ID, now)
+		if err != nil {
+			return err
+		}
+		if created && search.Frequency == model.INSTANT {
+			if err := deliverAlert(uc.database, uc.notifier, search, now); err != nil {
+				logger.GetInstance().Error("error delivering search alert", zap.Error(err), zap.Int64("savedSearchId", search.ID))
+			}
+		}
+	}
+	return nil
+}
+
+// deliverAlert notifies the pending matches of the search while they are locked
+func deliverAlert(database StorageManager, notifier Notifier, search *model.SavedSearch, now time.Time) error {
+	return database.DeliverSearchMatches(search.ID, now, func(matched []*model.Property) error {
+		user, found, err := database.GetUserByID(search.UserID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return model.NewEntityNotFoundError(errors.New("user not found"))
+		}
+
+		alerted := *search
+		alerted.LastAlertedAt = &now
+		return notifier.Notify(&model.SearchAlert{
+			UserID:      user.ID,
+			Email:       user.Email,
+			SavedSearch: &alerted,
+			Properties:  matched,
+		})
+	})
+}
@@ -0,0 +1,155 @@
+package searches_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/searches"
+	"lahaus/domain/usecases/searches/mocks"
+	"testing"
+	"time"
+)
+
+type MatchSavedSearchesSuite struct {
+	suite.Suite
+	mockCtrl       *gomock.Controller
+	database       *mocks.MockStorageManager
+	notifier       *mocks.MockNotifier
+	matchUseCase   *searches.MatchSavedSearchesUseCase
+	deliverUseCase *searches.DeliverSearchAlertsUseCase
+}
+
+func TestMatchSavedSearchesSuite(t *testing.T) {
+	suite.Run(t, new(MatchSavedSearchesSuite))
+}
+
+func (suite *MatchSavedSearchesSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.notifier = mocks.NewMockNotifier(suite.mockCtrl)
+	suite.matchUseCase = searches.NewMatchSavedSearchesUseCase(suite.database, suite.notifier)
+	suite.deliverUseCase = searches.NewDeliverSearchAlertsUseCase(suite.database, suite.notifier)
+}
+
+func (suite *MatchSavedSearchesSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func inPolanco() *model.Property {
+	return &model.Property{ID: 10, OwnerID: 7, Status: model.ACTIVE, Location: model.Location{Longitude: -99.19, Latitude: 19.425}}
+}
+
+func (suite *MatchSavedSearchesSuite) TestMatchSavedSearchesUseCase_ExecuteSuccess() {
+	property := inPolanco()
+	instant := &model.SavedSearch{ID: 1, UserID: 1, Filters: polanco(), Frequency: model.INSTANT}
+	daily := &model.SavedSearch{ID: 2, UserID: 2, Frequency: model.DAILY}
+	outside := &model.SavedSearch{ID: 3, UserID: 3, Frequency: model.INSTANT,
+		Filters: model.SearchFilters{Bbox: &model.BoundingBox{MinLongitude: -99.1, MinLatitude: 19.3, MaxLongitude: -99, MaxLatitude: 19.4}}}
+	own := &model.SavedSearch{ID: 4, UserID: property.OwnerID, Frequency: model.INSTANT}
+
+	suite.database.EXPECT().ListAllSavedSearches().Return([]*model.SavedSearch{instant, daily, outside, own}, nil)
+	suite.database.EXPECT().SaveSearchMatch(int64(1), property.ID, gomock.Any()).Return(true, nil)
+	suite.database.EXPECT().DeliverSearchMatches(int64(1), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(savedSearchID int64, deliveredAt time.Time, deliver func([]*model.Property) error) error {
+			return deliver([]*model.Property{property})
+		})
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, Email: "b@b.com"}, true, nil)
+	suite.notifier.EXPECT().Notify(gomock.Any()).DoAndReturn(func(alert *model.SearchAlert) error {
+		suite.Equal("b@b.com", alert.Email)
+		suite.Equal([]*model.Property{property}, alert.Properties)
+		suite.NotNil(alert.SavedSearch.LastAlertedAt)
+		return nil
+	})
+	suite.database.EXPECT().SaveSearchMatch(int64(2), property.ID, gomock.Any()).Return(true, nil)
+
+	suite.NoError(suite.matchUseCase.Execute(property))
+}
+
+func (suite *MatchSavedSearchesSuite) TestMatchSavedSearchesUseCase_ExecuteSuccess_AlreadyMatched() {
+	property := inPolanco()
+	suite.database.EXPECT().ListAllSavedSearches().Return([]*model.SavedSearch{{ID: 1, UserID: 1, Frequency: model.INSTANT}}, nil)
+	suite.database.EXPECT().SaveSearchMatch(int64(1), property.ID, gomock.Any()).Return(false, nil)
+
+	suite.NoError(suite.matchUseCase.Execute(property))
+}
+
+func (suite *MatchSavedSearchesSuite) TestMatchSavedSearchesUseCase_ExecuteSuccess_NotActive() {
+	property := inPolanco()
+	property.Status = model.INACTIVE
+
+	suite.NoError(suite.matchUseCase.Execute(property))
+}
+
+func (suite *MatchSavedSearchesSuite) TestMatchSavedSearchesUseCase_ExecuteSuccess_NotifyFails() {
+	property := inPolanco()
+	suite.database.EXPECT().ListAllSavedSearches().Return([]*model.SavedSearch{
+		{ID: 1, UserID: 1, Frequency: model.INSTANT}, {ID: 2, UserID: 2, Frequency: model.WEEKLY},
+	}, nil)
+	suite.database.EXPECT().SaveSearchMatch(int64(1), property.ID, gomock.Any()).Return(true, nil)
+	suite.database.EXPECT().DeliverSearchMatches(int64(1), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(savedSearchID int64, deliveredAt time.Time, deliver func([]*model.Property) error) error {
+			return deliver([]*model.Property{property})
+		})
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, Email: "b@b.com"}, true, nil)
+	suite.notifier.EXPECT().Notify(gomock.Any()).Return(errors.New("fail"))
+	suite.database.EXPECT().SaveSearchMatch(int64(2), property.ID, gomock.Any()).Return(true, nil)
+
+	suite.NoError(suite.matchUseCase.Execute(property))
+}
+
+func (suite *MatchSavedSearchesSuite) TestMatchSavedSearchesUseCase_ExecuteError() {
+	suite.database.EXPECT().ListAllSavedSearches().Return(nil, errors.New("fail"))
+	suite.Error(suite.matchUseCase.Execute(inPolanco()))
+}
+
+func (suite *MatchSavedSearchesSuite) TestDeliverSearchAlertsUseCase_ExecuteSuccess() {
+	property := inPolanco()
+	suite.database.EXPECT().ListDueSavedSearches(model.DAILY, gomock.Any()).Return([]*model.SavedSearch{
+		{ID: 1, UserID: 1, Frequency: model.DAILY}, {ID: 2, UserID: 2, Frequency: model.DAILY},
+	}, nil)
+	suite.database.EXPECT().DeliverSearchMatches(int64(1), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(savedSearchID int64, deliveredAt time.Time, deliver func([]*model.Property) error) error {
+			return deliver([]*model.Property{property})
+		})
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, Email: "b@b.com"}, true, nil)
+	suite.notifier.EXPECT().Notify(gomock.Any()).Return(nil)
+	suite.database.EXPECT().DeliverSearchMatches(int64(2), gomock.Any(), gomock.Any()).Return(nil)
+
+	suite.NoError(suite.deliverUseCase.Execute(model.DAILY))
+}
+
+func (suite *MatchSavedSearchesSuite) TestDeliverSearchAlertsUseCase_ExecuteError_InvalidFrequency() {
+	err := suite.deliverUseCase.Execute("HOURLY")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *MatchSavedSearchesSuite) TestDeliverDueSearchAlertsUseCase_ExecuteSuccess() {
+	due := searches.NewDeliverDueSearchAlertsUseCase(suite.deliverUseCase)
+	suite.database.EXPECT().ListDueSavedSearches(model.INSTANT, gomock.Any()).Return(nil, errors.New("fail"))
+	suite.database.EXPECT().ListDueSavedSearches(model.DAILY, gomock.Any()).Return([]*model.SavedSearch{}, nil)
+	suite.database.EXPECT().ListDueSavedSearches(model.WEEKLY, gomock.Any()).Return([]*model.SavedSearch{}, nil)
+
+	suite.NoError(due.Execute())
+}
+
+func (suite *MatchSavedSearchesSuite) TestSweepSearchMatchesUseCase_ExecuteSuccess() {
+	sweep := searches.NewSweepSearchMatchesUseCase(&config.SearchAlerts{SweepWindowInMinutes: 60}, suite.database, suite.matchUseCase)
+	property := inPolanco()
+	suite.database.EXPECT().ListActivePropertiesUpdatedSince(gomock.Any()).DoAndReturn(func(since time.Time) ([]*model.Property, error) {
+		suite.WithinDuration(time.Now().Add(-time.Hour), since, time.Minute)
+		return []*model.Property{property}, nil
+	})
+	suite.database.EXPECT().ListAllSavedSearches().Return([]*model.SavedSearch{{ID: 2, UserID: 2, Frequency: model.DAILY}}, nil)
+	suite.database.EXPECT().SaveSearchMatch(int64(2), property.ID, gomock.Any()).Return(false, nil)
+
+	suite.NoError(sweep.Execute())
+}
+
+func (suite *MatchSavedSearchesSuite) TestSweepSearchMatchesUseCase_ExecuteError() {
+	sweep := searches.NewSweepSearchMatchesUseCase(&config.SearchAlerts{SweepWindowInMinutes: 60}, suite.database, suite.matchUseCase)
+	suite.database.EXPECT().ListActivePropertiesUpdatedSince(gomock.Any()).Return(nil, errors.New("fail"))
+
+	suite.Error(sweep.Execute())
+}
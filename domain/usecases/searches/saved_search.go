@@ -0,0 +1,164 @@
+package searches
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/domain/model"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxSavedSearchNameLength = 100
+
+// getOwnedSavedSearch returns not found when the saved search belongs to another user
+func getOwnedSavedSearch(database StorageManager, userID, savedSearchID int64) (*model.SavedSearch, error) {
+	search, found, err := database.GetSavedSearch(savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+	if !found || search.UserID != userID {
+		return nil, model.NewEntityNotFoundError(errors.New("saved search not found"))
+	}
+	return search, nil
+}
+
+// validateSavedSearch normalizes the name and the frequency of the search, savedSearches are the searches the user already has
+func validateSavedSearch(savedSearches []*model.SavedSearch, search *model.SavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	if search.Name == "" {
+		return model.NewDomainError(errors.New("name field is a must"))
+	}
+	if utf8.RuneCountInString(search.Name) > maxSavedSearchNameLength {
+		return model.NewDomainError(fmt.Errorf("name can not have more than %d characters", maxSavedSearchNameLength))
+	}
+	for _, savedSearch := range savedSearches {
+		if savedSearch.ID != search.ID && strings.EqualFold(savedSearch.Name, search.Name) {
+			return model.NewDomainError(errors.New("a saved search with the same name already exists"))
+		}
+	}
+
+	switch search.Frequency {
+	case "":
+		search.Frequency = model.DAILY
+	case model.INSTANT, model.DAILY, model.WEEKLY:
+	default:
+		return model.NewDomainError(fmt.Errorf("invalid frequency [%v]", search.Frequency))
+	}
+
+	return validateFilters(search.Filters)
+}
+
+func validateFilters(filters model.SearchFilters) error {
+	if filters.Bbox != nil {
+		if !validLocation(filters.Bbox.MinLongitude, filters.Bbox.MinLatitude) || !validLocation(filters.Bbox.MaxLongitude, filters.Bbox.MaxLatitude) ||
+			filters.Bbox.MinLongitude > filters.Bbox.MaxLongitude || filters.Bbox.MinLatitude > filters.Bbox.MaxLatitude {
+			return model.NewDomainError(errors.New("bbox is not valid"))
+		}
+	}
+	if filters.Polygon != nil {
+		if len(filters.Polygon) < model.MinPolygonVertices || len(filters.Polygon) > model.MaxPolygonVertices {
+			return model.NewDomainError(fmt.Errorf("polygon must have between %d and %d vertices", model.MinPolygonVertices, model.MaxPolygonVertices))
+		}
+		for _, vertex := range filters.Polygon {
+			if !validLocation(vertex.Longitude, vertex.Latitude) {
+				return model.NewDomainError(errors.New("polygon is not valid"))
+			}
+		}
+	}
+	switch filters.Operation {
+	case "", model.SALE, model.RENT, model.SALE_AND_RENT:
+	default:
+		return model.NewDomainError(fmt.Errorf("invalid operation [%v]", filters.Operation))
+	}
+	for _, amenity := range filters.Amenities {
+		if !amenity.IsValid() {
+			return model.NewDomainError(fmt.Errorf("invalid amenity [%v]", amenity))
+		}
+	}
+	if filters.Condition != "" && !filters.Condition.IsValid() {
+		return model.NewDomainError(fmt.Errorf("invalid condition [%v]", filters.Condition))
+	}
+	if filters.Orientation != "" && !filters.Orientation.IsValid() {
+		return model.NewDomainError(fmt.Errorf("invalid orientation [%v]", filters.Orientation))
+	}
+	if filters.MinFloor != nil && filters.MaxFloor != nil && *filters.MinFloor > *filters.MaxFloor {
+		return model.NewDomainError(errors.New("minFloor should not be greater than maxFloor"))
+	}
+	if filters.MinYearBuilt != nil && filters.MaxYearBuilt != nil && *filters.MinYearBuilt > *filters.MaxYearBuilt {
+		return model.NewDomainError(errors.New("minYearBuilt should not be greater than maxYearBuilt"))
+	}
+	return nil
+}
+
+func validLocation(longitude, latitude float64) bool {
+	return longitude >= -180 && longitude <= 180 && latitude >= -90 && latitude <= 90
+}
+
+// matches applies the filters of a saved search to a property, the same way the property search does
+func matches(filters model.SearchFilters, property *model.Property) bool {
+	location := property.Location
+	if filters.Bbox != nil {
+		if location.Latitude < filters.Bbox.MinLatitude || location.Latitude > filters.Bbox.MaxLatitude ||
+			location.Longitude < filters.Bbox.MinLongitude || location.Longitude > filters.Bbox.MaxLongitude {
+			return false
+		}
+	}
+	if len(filters.Polygon) > 0 && !polygonContains(filters.Polygon, location) {
+		return false
+	}
+	if filters.Operation != "" && !property.OperationType.Offers(filters.Operation) {
+		return false
+	}
+	if !property.HasAmenities(filters.Amenities) {
+		return false
+	}
+	if !withinBounds(property.Floor, filters.MinFloor, filters.MaxFloor) || !withinBounds(property.YearBuilt, filters.MinYearBuilt, filters.MaxYearBuilt) {
+		return false
+	}
+	if filters.Condition != "" && property.Condition != filters.Condition {
+		return false
+	}
+	if filters.Orientation != "" && property.Orientation != filters.Orientation {
+		return false
+	}
+	return true
+}
+
+// withinBounds reports whether the value is between the bounds, a missing value is out of any bound
+func withinBounds(value, min, max *int) bool {
+	if min == nil && max == nil {
+		return true
+	}
+	if value == nil {
+		return false
+	}
+	return (min == nil || *value >= *min) && (max == nil || *value <= *max)
+}
+
+// polygonContains uses ray casting, a location on the border counts as inside
+func polygonContains(polygon []model.Location, location model.Location) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if onSegment(a, b, location) {
+			return true
+		}
+		if (a.Latitude > location.Latitude) != (b.Latitude > location.Latitude) {
+			crossing := (b.Longitude-a.Longitude)*(location.Latitude-a.Latitude)/(b.Latitude-a.Latitude) + a.Longitude
+			if location.Longitude < crossing {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+func onSegment(a, b, location model.Location) bool {
+	cross := (b.Longitude-a.Longitude)*(location.Latitude-a.Latitude) - (b.Latitude-a.Latitude)*(location.Longitude-a.Longitude)
+	if cross != 0 {
+		return false
+	}
+	return location.Longitude >= math.Min(a.Longitude, b.Longitude) && location.Longitude <= math.Max(a.Longitude, b.Longitude) &&
+		location.Latitude >= math.Min(a.Latitude, b.Latitude) && location.Latitude <= math.Max(a.Latitude, b.Latitude)
+}
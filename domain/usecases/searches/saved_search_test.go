@@ -0,0 +1,77 @@
+package searches
+
+import (
+	"lahaus/domain/model"
+	"testing"
+)
+
+func TestPolygonContains(t *testing.T) {
+	square := []model.Location{{Longitude: 0, Latitude: 0}, {Longitude: 2, Latitude: 0}, {Longitude: 2, Latitude: 2}, {Longitude: 0, Latitude: 2}}
+	cases := []struct {
+		location model.Location
+		inside   bool
+	}{
+		{model.Location{Longitude: 1, Latitude: 1}, true},
+		{model.Location{Longitude: 2, Latitude: 1}, true},
+		{model.Location{Longitude: 0, Latitude: 0}, true},
+		{model.Location{Longitude: 3, Latitude: 1}, false},
+		{model.Location{Longitude: 1, Latitude: -0.5}, false},
+	}
+	for _, c := range cases {
+		if polygonContains(square, c.location) != c.inside {
+			t.Errorf("polygonContains(%v) should be %v", c.location, c.inside)
+		}
+	}
+}
+
+func TestMatchesOperation(t *testing.T) {
+	cases := []struct {
+		operation model.OperationType
+		property  model.OperationType
+		matches   bool
+	}{
+		{"", model.RENT, true},
+		{model.RENT, model.RENT, true},
+		{model.RENT, model.SALE_AND_RENT, true},
+		{model.RENT, model.SALE, false},
+		{model.SALE, "", true},
+		{model.SALE_AND_RENT, model.SALE, false},
+	}
+	for _, c := range cases {
+		if matches(model.SearchFilters{Operation: c.operation}, &model.Property{OperationType: c.property}) != c.matches {
+			t.Errorf("matches(%v, %v) should be %v", c.operation, c.property, c.matches)
+		}
+	}
+}
+
+func TestMatchesAttributes(t *testing.T) {
+	two, five, year := 2, 5, 2010
+	property := &model.Property{
+		Amenities:   []model.Amenity{model.POOL, model.ELEVATOR},
+		Floor:       &five,
+		YearBuilt:   &year,
+		Condition:   model.GOOD,
+		Orientation: model.NORTH,
+	}
+	cases := []struct {
+		filters model.SearchFilters
+		matches bool
+	}{
+		{model.SearchFilters{}, true},
+		{model.SearchFilters{Amenities: []model.Amenity{model.POOL}}, true},
+		{model.SearchFilters{Amenities: []model.Amenity{model.POOL, model.GYM}}, false},
+		{model.SearchFilters{MinFloor: &two, MaxFloor: &five}, true},
+		{model.SearchFilters{MaxFloor: &two}, false},
+		{model.SearchFilters{MinYearBuilt: &year}, true},
+		{model.SearchFilters{Condition: model.NEW}, false},
+		{model.SearchFilters{Orientation: model.NORTH}, true},
+	}
+	for _, c := range cases {
+		if matches(c.filters, property) != c.matches {
+			t.Errorf("matches(%+v) should be %v", c.filters, c.matches)
+		}
+	}
+	if matches(model.SearchFilters{MinFloor: &two}, &model.Property{}) {
+		t.Errorf("the properties without a floor should not match a floor filter")
+	}
+}
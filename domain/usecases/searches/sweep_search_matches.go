@@ -0,0 +1,37 @@
+package searches
+
+import (
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/logger"
+	"time"
+)
+
+type SweepSearchMatchesUseCase struct {
+	config   *config.SearchAlerts
+	database StorageManager
+	matcher  MatchSavedSearchesExecutor
+}
+
+func NewSweepSearchMatchesUseCase(config *config.SearchAlerts, database StorageManager, matcher MatchSavedSearchesExecutor) *SweepSearchMatchesUseCase {
+	return &SweepSearchMatchesUseCase{
+		config:   config,
+		database: database,
+		matcher:  matcher,
+	}
+}
+
+// Execute matches again the ACTIVE properties updated within the window
+func (uc *SweepSearchMatchesUseCase) Execute() error {
+	since := time.Now().UTC().Add(-time.Duration(uc.config.SweepWindowInMinutes) * time.Minute)
+	updated, err := uc.database.ListActivePropertiesUpdatedSince(since)
+	if err != nil {
+		return err
+	}
+	for _, property := range updated {
+		if err := uc.matcher.Execute(property); err != nil {
+			logger.GetInstance().Error("error sweeping saved searches", zap.Error(err), zap.Int64("propertyId", property.ID))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package searches
+
+import "lahaus/domain/model"
+
+type UpdateSavedSearchUseCase struct {
+	database StorageManager
+}
+
+func NewUpdateSavedSearchUseCase(database StorageManager) *UpdateSavedSearchUseCase {
+	return &UpdateSavedSearchUseCase{
+		database: database,
+	}
+}
+
+// Execute replaces the name, filters and frequency of the saved search, the matches already found are kept
+func (uc *UpdateSavedSearchUseCase) Execute(search *model.SavedSearch) (*model.SavedSearch, error) {
+	if _, err := getOwnedSavedSearch(uc.database, search.UserID, search.ID); err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := uc.database.ListSavedSearches(search.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSavedSearch(savedSearches, search); err != nil {
+		return nil, err
+	}
+	return uc.database.UpdateSavedSearch(search)
+}
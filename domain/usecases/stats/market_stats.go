@@ -0,0 +1,178 @@
+package stats
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/properties"
+	"sync"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_stats.go -package=mocks -source=./market_stats.go
+
+type StorageManager interface {
+	MarketStats(search properties.PropertySearchParams, grouping model.MarketGrouping) ([]*model.MarketStats, error)
+}
+
+type ExchangeRates interface {
+	Current() model.ExchangeRates
+}
+
+// MarketStatsParams are the filters of the property search and how the statistics are grouped
+type MarketStatsParams struct {
+	properties.PropertySearchParams
+	GroupBy          model.MarketGroupBy
+	GeohashPrecision int
+}
+
+// MarketStatsUseCase aggregates the prices of the properties that match the search and caches the reports
+type MarketStatsUseCase struct {
+	config   *config.MarketStats
+	zones    []model.MarketZone
+	database StorageManager
+	rates    ExchangeRates
+	mutex    sync.Mutex
+	cache    map[string]*list.Element
+	recent   *list.List
+	now      func() time.Time
+}
+
+type cachedReport struct {
+	key       string
+	report    *model.MarketReport
+	expiresAt time.Time
+}
+
+func NewMarketStatsUseCase(conf *config.Config, database StorageManager, rates ExchangeRates) *MarketStatsUseCase {
+	settings := conf.SystemSettings.MarketStats
+	if settings == nil {
+		settings = &config.MarketStats{}
+	}
+	zones := make([]model.MarketZone, 0, len(settings.Zones))
+	for _, zone := range settings.Zones {
+		zones = append(zones, model.MarketZone{
+			Name: zone.Name,
+			Box: model.BoundingBox{
+				MinLongitude: zone.Longitude.LowerBound,
+				MinLatitude:  zone.Latitude.LowerBound,
+				MaxLongitude: zone.Longitude.UpperBound,
+				MaxLatitude:  zone.Latitude.UpperBound,
+			},
+		})
+	}
+	return &MarketStatsUseCase{
+		config:   settings,
+		zones:    zones,
+		database: database,
+		rates:    rates,
+		cache:    map[string]*list.Element{},
+		recent:   list.New(),
+		now:      time.Now,
+	}
+}
+
+// Execute returns the statistics of every group in the currency of the params
+func (uc *MarketStatsUseCase) Execute(params MarketStatsParams) (*model.MarketReport, error) {
+	if !params.GroupBy.IsValid() {
+		return nil, model.NewDomainError(fmt.Errorf("invalid groupBy [%v]", params.GroupBy))
+	}
+	if params.GroupBy == model.GROUP_BY_ZONE && len(uc.zones) == 0 {
+		return nil, model.NewDomainError(fmt.Errorf("there are no zones configured to group by"))
+	}
+	if params.Currency == "" {
+		return nil, model.NewDomainError(fmt.Errorf("the market statistics need a currency"))
+	}
+	if params.GroupBy == model.GROUP_BY_GEOHASH {
+		if params.GeohashPrecision == 0 {
+			params.GeohashPrecision = uc.config.GeohashPrecision
+		}
+		if params.GeohashPrecision < model.MinGeohashPrecision || params.GeohashPrecision > model.MaxGeohashPrecision {
+			return nil, model.NewDomainError(fmt.Errorf("the geohash precision must be between %d and %d",
+				model.MinGeohashPrecision, model.MaxGeohashPrecision))
+		}
+	} else {
+		params.GeohashPrecision = 0
+	}
+
+	rates := uc.rates.Current()
+	if _, found := rates[params.Currency]; !found {
+		return nil, model.NewDomainError(fmt.Errorf("there is no exchange rate for the currency %q", params.Currency))
+	}
+	// the statistics cover every matching property, the paging and the order of the search do not change them
+	params.Page, params.PageSize = 0, 0
+	params.SortBy, params.SortDescending = properties.SortByUpdatedAt, false
+	params.Rates = nil
+
+	key, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	if report, found := uc.cached(string(key)); found {
+		return report, nil
+	}
+
+	params.Rates = rates
+	groups, err := uc.database.MarketStats(params.PropertySearchParams, model.MarketGrouping{
+		By:               params.GroupBy,
+		Zones:            uc.zones,
+		GeohashPrecision: params.GeohashPrecision,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if groups == nil {
+		groups = []*model.MarketStats{}
+	}
+	report := &model.MarketReport{
+		GroupBy:     params.GroupBy,
+		Currency:    params.Currency,
+		Groups:      groups,
+		GeneratedAt: uc.now(),
+	}
+	uc.store(string(key), report)
+	return report, nil
+}
+
+func (uc *MarketStatsUseCase) cached(key string) (*model.MarketReport, bool) {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	element, found := uc.cache[key]
+	if !found {
+		return nil, false
+	}
+	entry := element.Value.(*cachedReport)
+	if !uc.now().Before(entry.expiresAt) {
+		uc.recent.Remove(element)
+		delete(uc.cache, key)
+		return nil, false
+	}
+	uc.recent.MoveToFront(element)
+	return entry.report, true
+}
+
+// store keeps up to MaxCachedReports reports, the least recently used one is dropped to make room
+func (uc *MarketStatsUseCase) store(key string, report *model.MarketReport) {
+	ttl := time.Duration(uc.config.CacheTTLInMinutes) * time.Minute
+	if ttl <= 0 || uc.config.MaxCachedReports <= 0 {
+		return
+	}
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	entry := &cachedReport{key: key, report: report, expiresAt: uc.now().Add(ttl)}
+	if element, found := uc.cache[key]; found {
+		element.Value = entry
+		uc.recent.MoveToFront(element)
+		return
+	}
+	uc.cache[key] = uc.recent.PushFront(entry)
+	for uc.recent.Len() > uc.config.MaxCachedReports {
+		oldest := uc.recent.Back()
+		uc.recent.Remove(oldest)
+		delete(uc.cache, oldest.Value.(*cachedReport).key)
+	}
+}
@@ -0,0 +1,185 @@
+package stats_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/properties"
+	"lahaus/domain/usecases/stats"
+	"lahaus/domain/usecases/stats/mocks"
+	"testing"
+)
+
+type MarketStatsSuite struct {
+	suite.Suite
+	mockCtrl           *gomock.Controller
+	database           *mocks.MockStorageManager
+	rates              *mocks.MockExchangeRates
+	conf               *config.Config
+	marketStatsUseCase *stats.MarketStatsUseCase
+}
+
+var currentRates = model.ExchangeRates{"USD": 1, "MXN": 17.5}
+
+func TestMarketStatsSuite(t *testing.T) {
+	suite.Run(t, new(MarketStatsSuite))
+}
+
+func (suite *MarketStatsSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.rates = mocks.NewMockExchangeRates(suite.mockCtrl)
+	suite.conf = &config.Config{
+		SystemSettings: &config.SystemSettings{
+			MarketStats: &config.MarketStats{CacheTTLInMinutes: 10, MaxCachedReports: 2, GeohashPrecision: 5, Zones: []config.MarketZone{{
+				Name:      "Polanco",
+				Longitude: config.BetweenFloat{LowerBound: -99.215, UpperBound: -99.17},
+				Latitude:  config.BetweenFloat{LowerBound: 19.42, UpperBound: 19.445},
+			}}},
+		},
+	}
+	suite.marketStatsUseCase = stats.NewMarketStatsUseCase(suite.conf, suite.database, suite.rates)
+}
+
+func (suite *MarketStatsSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func marketParams(groupBy model.MarketGroupBy) stats.MarketStatsParams {
+	return stats.MarketStatsParams{
+		PropertySearchParams: properties.PropertySearchParams{Status: "ACTIVE", Currency: "MXN", Page: 2, PageSize: 10},
+		GroupBy:              groupBy,
+	}
+}
+
+func (suite *MarketStatsSuite) TestMarketStatsUseCase_ExecuteSuccess() {
+	groups := []*model.MarketStats{
+		{Group: "Polanco", Count: 3, Price: &model.Distribution{Mean: 200, Median: 150, P10: 100, P90: 300}, AverageArea: 80},
+	}
+	suite.rates.EXPECT().Current().Return(currentRates)
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(search properties.PropertySearchParams, grouping model.MarketGrouping) ([]*model.MarketStats, error) {
+			suite.Equal(model.Currency("MXN"), search.Currency)
+			suite.Equal(currentRates, search.Rates)
+			suite.Equal(int64(0), search.Page)
+			suite.Equal(int64(0), search.PageSize)
+			suite.Equal(model.GROUP_BY_ZONE, grouping.By)
+			suite.Equal([]model.MarketZone{{Name: "Polanco",
+				Box: model.BoundingBox{MinLongitude: -99.215, MinLatitude: 19.42, MaxLongitude: -99.17, MaxLatitude: 19.445}}}, grouping.Zones)
+			suite.Equal(0, grouping.GeohashPrecision)
+			return groups, nil
+		})
+
+	report, err := suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_ZONE))
+
+	suite.NoError(err)
+	suite.Equal(model.GROUP_BY_ZONE, report.GroupBy)
+	suite.Equal(model.Currency("MXN"), report.Currency)
+	suite.Equal(groups, report.Groups)
+	suite.False(report.GeneratedAt.IsZero())
+}
+
+func (suite *MarketStatsSuite) TestMarketStatsUseCase_ExecuteSuccessCached() {
+	suite.rates.EXPECT().Current().Return(currentRates).Times(3)
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).Return([]*model.MarketStats{}, nil).Times(2)
+
+	first, err := suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_BEDROOMS))
+	suite.NoError(err)
+	// another page of the same search is the same question
+	params := marketParams(model.GROUP_BY_BEDROOMS)
+	params.Page = 5
+	second, err := suite.marketStatsUseCase.Execute(params)
+	suite.NoError(err)
+	suite.Same(first, second)
+
+	_, err = suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_PROPERTY_TYPE))
+	suite.NoError(err)
+}
+
+func (suite *MarketStatsSuite) TestMarketStatsUseCase_ExecuteSuccessCacheFull() {
+	suite.rates.EXPECT().Current().Return(currentRates).Times(6)
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).Return([]*model.MarketStats{}, nil).Times(4)
+
+	for _, groupBy := range []model.MarketGroupBy{
+		// bedrooms is used again before propertyType, so the third report drops propertyType
+		model.GROUP_BY_BEDROOMS, model.GROUP_BY_PROPERTY_TYPE, model.GROUP_BY_BEDROOMS, model.GROUP_BY_GEOHASH,
+		model.GROUP_BY_BEDROOMS, model.GROUP_BY_PROPERTY_TYPE,
+	} {
+		_, err := suite.marketStatsUseCase.Execute(marketParams(groupBy))
+		suite.NoError(err)
+	}
+}
+
+func (suite *MarketStatsSuite) TestMarketStatsUseCase_ExecuteSuccessWithoutCache() {
+	suite.conf.SystemSettings.MarketStats.CacheTTLInMinutes = 0
+	suite.marketStatsUseCase = stats.NewMarketStatsUseCase(suite.conf, suite.database, suite.rates)
+	suite.rates.EXPECT().Current().Return(currentRates).Times(2)
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+	report, err := suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_BEDROOMS))
+	suite.NoError(err)
+	suite.NotNil(report.Groups)
+	_, err = suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_BEDROOMS))
+	suite.NoError(err)
+}
+
+func (suite *MarketStatsSuite) TestMarketStatsUseCase_ExecuteSuccessGeohash() {
+	suite.rates.EXPECT().Current().Return(currentRates).Times(2)
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(search properties.PropertySearchParams, grouping model.MarketGrouping) ([]*model.MarketStats, error) {
+			suite.Equal(5, grouping.GeohashPrecision)
+			return []*model.MarketStats{}, nil
+		})
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(search properties.PropertySearchParams, grouping model.MarketGrouping) ([]*model.MarketStats, error) {
+			suite.Equal(7, grouping.GeohashPrecision)
+			return []*model.MarketStats{}, nil
+		})
+
+	_, err := suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_GEOHASH))
+	suite.NoError(err)
+	params := marketParams(model.GROUP_BY_GEOHASH)
+	params.GeohashPrecision = 7
+	_, err = suite.marketStatsUseCase.Execute(params)
+	suite.NoError(err)
+}
+
+func (suite *MarketStatsSuite) TestMarketStatsUseCase_ExecuteError_InvalidParams() {
+	params := marketParams("city")
+	_, err := suite.marketStatsUseCase.Execute(params)
+	suite.IsType(&model.DomainError{}, err)
+
+	params = marketParams(model.GROUP_BY_ZONE)
+	params.Currency = ""
+	_, err = suite.marketStatsUseCase.Execute(params)
+	suite.IsType(&model.DomainError{}, err)
+
+	params = marketParams(model.GROUP_BY_GEOHASH)
+	params.GeohashPrecision = 13
+	_, err = suite.marketStatsUseCase.Execute(params)
+	suite.IsType(&model.DomainError{}, err)
+
+	suite.rates.EXPECT().Current().Return(currentRates)
+	params = marketParams(model.GROUP_BY_ZONE)
+	params.Currency = "EUR"
+	_, err = suite.marketStatsUseCase.Execute(params)
+	suite.IsType(&model.DomainError{}, err)
+
+	suite.conf.SystemSettings.MarketStats.Zones = nil
+	_, err = stats.NewMarketStatsUseCase(suite.conf, suite.database, suite.rates).Execute(marketParams(model.GROUP_BY_ZONE))
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *MarketStatsSuite) TestMarketStatsUseCase_ExecuteError_Storage() {
+	suite.rates.EXPECT().Current().Return(currentRates).Times(2)
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).Return(nil, errors.New("fail"))
+	suite.database.EXPECT().MarketStats(gomock.Any(), gomock.Any()).Return([]*model.MarketStats{}, nil)
+
+	_, err := suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_ZONE))
+	suite.Error(err)
+	// the failures are not cached
+	_, err = suite.marketStatsUseCase.Execute(marketParams(model.GROUP_BY_ZONE))
+	suite.NoError(err)
+}
@@ -2,30 +2,56 @@ package users
 
 import (
 	"errors"
+	"lahaus/config"
 	"lahaus/domain/model"
 )
 
 type AddFavouriteUseCase struct {
-	database StorageManager
+	database     StorageManager
+	verification *config.EmailVerification
 }
 
-func NewAddFavouriteUseCase(database StorageManager) *AddFavouriteUseCase {
+func NewAddFavouriteUseCase(verification *config.EmailVerification, database StorageManager) *AddFavouriteUseCase {
 	return &AddFavouriteUseCase{
-		database: database,
+		database:     database,
+		verification: verification,
 	}
 }
 
-func (uc *AddFavouriteUseCase) Execute(userID int64, propertyID int64) error {
+// Execute adds the property to the favourites of the actor, returns false when it was already a favourite
+func (uc *AddFavouriteUseCase) Execute(actor *model.Actor, propertyID int64) (bool, error) {
+	if uc.verification.Required {
+		if err := checkEmailVerified(uc.database, actor.UserID); err != nil {
+			return false, err
+		}
+	}
 	_, found, err := uc.database.GetProperty(propertyID)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if !found {
-		return model.NewEntityNotFoundError(errors.New("property not found"))
+		return false, model.NewEntityNotFoundError(errors.New("property not found"))
 	}
-	err = uc.database.AddFavourite(userID, propertyID)
+	audit := model.NewAuditEntry(model.USER, actor.UserID, model.FAVOURITE_ADD, actor, map[string]model.AuditChange{
+		"propertyId": {After: propertyID},
+	})
+	created, err := uc.database.AddFavourite(actor.UserID, propertyID, audit)
+	if err != nil {
+		return false, err
+	}
+	return created, nil
+}
+
+func checkEmailVerified(database StorageManager, userID int64) error {
+	user, found, err := database.GetUserByID(userID)
 	if err != nil {
 		return err
 	}
+	if !found {
+		return model.NewUnauthorizedError(errors.New("user not found"))
+	}
+	if user.EmailVerifiedAt == nil {
+		return model.NewForbiddenError(errors.New("the email must be verified"))
+	}
 	return nil
 }
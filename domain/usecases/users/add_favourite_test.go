@@ -4,11 +4,16 @@ import (
 	"errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
 	"lahaus/domain/usecases/users"
 	"lahaus/domain/usecases/users/mocks"
 	"testing"
+	"time"
 )
 
+var buyer = &model.Actor{UserID: 1, Role: model.BUYER, RequestID: "request-1"}
+
 type AddFavouriteSuite struct {
 	suite.Suite
 	mockCtrl            *gomock.Controller
@@ -23,7 +28,7 @@ func TestAddFavouriteSuite(t *testing.T) {
 func (suite *AddFavouriteSuite) SetupTest() {
 	suite.mockCtrl = gomock.NewController(suite.T())
 	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
-	suite.addFavouriteUseCase = users.NewAddFavouriteUseCase(suite.database)
+	suite.addFavouriteUseCase = users.NewAddFavouriteUseCase(&config.EmailVerification{}, suite.database)
 }
 
 func (suite *AddFavouriteSuite) TearDownSuite() {
@@ -32,27 +37,72 @@ func (suite *AddFavouriteSuite) TearDownSuite() {
 
 func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteSuccess() {
 	suite.database.EXPECT().GetProperty(gomock.Any()).Return(nil, true, nil)
-	suite.database.EXPECT().AddFavourite(gomock.Any(), gomock.Any()).Return(nil)
-	err := suite.addFavouriteUseCase.Execute(1, 1)
+	suite.database.EXPECT().AddFavourite(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+	_, err := suite.addFavouriteUseCase.Execute(buyer, 1)
+	suite.NoError(err)
+}
+
+func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteSuccess_AlreadyFavourite() {
+	suite.database.EXPECT().GetProperty(gomock.Any()).Return(nil, true, nil)
+	suite.database.EXPECT().AddFavourite(int64(1), int64(1), gomock.Any()).Return(false, nil)
+	created, err := suite.addFavouriteUseCase.Execute(buyer, 1)
 	suite.NoError(err)
+	suite.False(created)
 }
 
 func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteError_GetProperty() {
 
 	suite.database.EXPECT().GetProperty(gomock.Any()).Return(nil, false, errors.New("fail"))
-	err := suite.addFavouriteUseCase.Execute(1, 1)
+	_, err := suite.addFavouriteUseCase.Execute(buyer, 1)
 	suite.Error(err)
 }
 
 func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteError_GetPropertyNotFound() {
 	suite.database.EXPECT().GetProperty(gomock.Any()).Return(nil, false, nil)
-	err := suite.addFavouriteUseCase.Execute(1, 1)
+	_, err := suite.addFavouriteUseCase.Execute(buyer, 1)
 	suite.Error(err)
 }
 
 func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteError_AddFavouriteError() {
 	suite.database.EXPECT().GetProperty(gomock.Any()).Return(nil, true, nil)
-	suite.database.EXPECT().AddFavourite(gomock.Any(), gomock.Any()).Return(errors.New("fail"))
-	err := suite.addFavouriteUseCase.Execute(1, 1)
+	suite.database.EXPECT().AddFavourite(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("fail"))
+	_, err := suite.addFavouriteUseCase.Execute(buyer, 1)
 	suite.Error(err)
 }
+
+func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteError_EmailNotVerified() {
+	addFavouriteUseCase := users.NewAddFavouriteUseCase(&config.EmailVerification{Required: true}, suite.database)
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1}, true, nil)
+	_, err := addFavouriteUseCase.Execute(buyer, 1)
+	suite.IsType(&model.ForbiddenError{}, err)
+}
+
+func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteError_UserNotFound() {
+	addFavouriteUseCase := users.NewAddFavouriteUseCase(&config.EmailVerification{Required: true}, suite.database)
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(nil, false, nil)
+	_, err := addFavouriteUseCase.Execute(buyer, 1)
+	suite.IsType(&model.UnauthorizedError{}, err)
+}
+
+func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteSuccess_EmailVerified() {
+	addFavouriteUseCase := users.NewAddFavouriteUseCase(&config.EmailVerification{Required: true}, suite.database)
+	verifiedAt := time.Now()
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, EmailVerifiedAt: &verifiedAt}, true, nil)
+	suite.database.EXPECT().GetProperty(gomock.Any()).Return(nil, true, nil)
+	suite.database.EXPECT().AddFavourite(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+	_, err := addFavouriteUseCase.Execute(buyer, 1)
+	suite.NoError(err)
+}
+
+func (suite *AddFavouriteSuite) TestAddFavouriteUseCase_ExecuteSuccess_Audit() {
+	suite.database.EXPECT().GetProperty(int64(5)).Return(nil, true, nil)
+	suite.database.EXPECT().AddFavourite(int64(1), int64(5), gomock.Any()).DoAndReturn(func(userID, propertyID int64, audit *model.AuditEntry) (bool, error) {
+		suite.Equal(model.USER, audit.Entity)
+		suite.Equal(userID, audit.EntityID)
+		suite.Equal(model.FAVOURITE_ADD, audit.Action)
+		suite.Equal(map[string]model.AuditChange{"propertyId": {After: propertyID}}, audit.Changes)
+		return true, nil
+	})
+	_, err := suite.addFavouriteUseCase.Execute(buyer, 5)
+	suite.NoError(err)
+}
@@ -0,0 +1,50 @@
+package users
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"lahaus/logger"
+)
+
+type EmailRequestExecutor interface {
+	Execute(email string) error
+}
+
+// BackgroundEmailRequest runs the requests that email a user outside of the HTTP request, so its timing does not reveal the accounts
+type BackgroundEmailRequest struct {
+	name     string
+	executor EmailRequestExecutor
+	queue    chan string
+}
+
+func NewBackgroundEmailRequest(name string, executor EmailRequestExecutor, queueSize int) *BackgroundEmailRequest {
+	return &BackgroundEmailRequest{
+		name:     name,
+		executor: executor,
+		queue:    make(chan string, queueSize),
+	}
+}
+
+// Execute queues the email without blocking, when the queue is full the request is dropped and the user can ask again
+func (b *BackgroundEmailRequest) Execute(email string) error {
+	select {
+	case b.queue <- email:
+	default:
+		logger.GetInstance().Warn("email requests queue is full, request dropped", zap.String("request", b.name))
+	}
+	return nil
+}
+
+// Run executes the queued requests one at a time until the context is done
+func (b *BackgroundEmailRequest) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case email := <-b.queue:
+			if err := b.executor.Execute(email); err != nil {
+				logger.GetInstance().Error("error running email request", zap.Error(err), zap.String("request", b.name))
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/domain/model"
+)
+
+const maxFavouriteIDs = 100
+
+type FavouriteIDsUseCase struct {
+	database StorageManager
+}
+
+func NewFavouriteIDsUseCase(database StorageManager) *FavouriteIDsUseCase {
+	return &FavouriteIDsUseCase{
+		database: database,
+	}
+}
+
+// Execute returns which of the given properties are favourites of the user
+func (uc *FavouriteIDsUseCase) Execute(userID int64, propertyIDs []int64) ([]int64, error) {
+	if len(propertyIDs) == 0 {
+		return nil, model.NewDomainError(errors.New("propertyIds is required"))
+	}
+	if len(propertyIDs) > maxFavouriteIDs {
+		return nil, model.NewDomainError(fmt.Errorf("propertyIds can not have more than %d values", maxFavouriteIDs))
+	}
+	ids, err := uc.database.ListFavouriteIDs(userID, propertyIDs)
+	if err != nil {
+		return nil, err
+	}
+	if ids == nil {
+		ids = []int64{}
+	}
+	return ids, nil
+}
@@ -0,0 +1,71 @@
+package users_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
+	"lahaus/domain/usecases/users/mocks"
+	"testing"
+)
+
+type FavouriteIDsSuite struct {
+	suite.Suite
+	mockCtrl            *gomock.Controller
+	database            *mocks.MockStorageManager
+	favouriteIDsUseCase *users.FavouriteIDsUseCase
+	removeUseCase       *users.RemoveFavouriteUseCase
+}
+
+func TestFavouriteIDsSuite(t *testing.T) {
+	suite.Run(t, new(FavouriteIDsSuite))
+}
+
+func (suite *FavouriteIDsSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.favouriteIDsUseCase = users.NewFavouriteIDsUseCase(suite.database)
+	suite.removeUseCase = users.NewRemoveFavouriteUseCase(suite.database)
+}
+
+func (suite *FavouriteIDsSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *FavouriteIDsSuite) TestFavouriteIDsUseCase_ExecuteSuccess() {
+	suite.database.EXPECT().ListFavouriteIDs(int64(1), []int64{1, 2, 3}).Return([]int64{2}, nil)
+	ids, err := suite.favouriteIDsUseCase.Execute(1, []int64{1, 2, 3})
+	suite.NoError(err)
+	suite.Equal([]int64{2}, ids)
+}
+
+func (suite *FavouriteIDsSuite) TestFavouriteIDsUseCase_ExecuteSuccess_NoneFavourite() {
+	suite.database.EXPECT().ListFavouriteIDs(int64(1), []int64{1}).Return(nil, nil)
+	ids, err := suite.favouriteIDsUseCase.Execute(1, []int64{1})
+	suite.NoError(err)
+	suite.NotNil(ids)
+	suite.Empty(ids)
+}
+
+func (suite *FavouriteIDsSuite) TestFavouriteIDsUseCase_ExecuteError_Empty() {
+	_, err := suite.favouriteIDsUseCase.Execute(1, nil)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *FavouriteIDsSuite) TestFavouriteIDsUseCase_ExecuteError_TooMany() {
+	_, err := suite.favouriteIDsUseCase.Execute(1, make([]int64, 101))
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *FavouriteIDsSuite) TestRemoveFavouriteUseCase_ExecuteSuccess_NotFavourite() {
+	suite.database.EXPECT().RemoveFavourite(int64(1), int64(2), gomock.Any()).Return(false, nil)
+	err := suite.removeUseCase.Execute(buyer, 2)
+	suite.NoError(err)
+}
+
+func (suite *FavouriteIDsSuite) TestRemoveFavouriteUseCase_ExecuteError() {
+	suite.database.EXPECT().RemoveFavourite(int64(1), int64(2), gomock.Any()).Return(false, errors.New("fail"))
+	err := suite.removeUseCase.Execute(buyer, 2)
+	suite.Error(err)
+}
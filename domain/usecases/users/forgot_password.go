@@ -0,0 +1,59 @@
+package users
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/internal/token"
+	"lahaus/logger"
+	"time"
+)
+
+const passwordResetSubject = "Reset your password"
+
+type ForgotPasswordUseCase struct {
+	database StorageManager
+	mailer   Mailer
+	config   *config.PasswordReset
+}
+
+func NewForgotPasswordUseCase(config *config.PasswordReset, database StorageManager, mailer Mailer) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		database: database,
+		mailer:   mailer,
+		config:   config,
+	}
+}
+
+// Execute sends a reset link to the user, it behaves the same whether the email is registered or not
+func (uc *ForgotPasswordUseCase) Execute(email string) error {
+	user, found, err := uc.database.GetUser(email)
+	if err != nil {
+		return err
+	}
+	if !found {
+		logger.GetInstance().Info("password reset requested for unknown email")
+		return nil
+	}
+
+	resetToken, err := token.Generate()
+	if err != nil {
+		return err
+	}
+	err = uc.database.SavePasswordResetToken(&model.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: token.Hash(resetToken),
+		ExpiresAt: time.Now().UTC().Add(time.Duration(uc.config.TokenDurationInMinutes) * time.Minute),
+	})
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("Follow this link to reset your password: %s?token=%s\nThe link expires in %d minutes and can be used only once.",
+		uc.config.ResetURL, resetToken, uc.config.TokenDurationInMinutes)
+	if err := uc.mailer.Send(user.Email, passwordResetSubject, body); err != nil {
+		logger.GetInstance().Error("error sending password reset email", zap.Error(err), zap.Int64("userId", user.ID))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package users_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
+	"lahaus/domain/usecases/users/mocks"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ForgotPasswordSuite struct {
+	suite.Suite
+	mockCtrl              *gomock.Controller
+	database              *mocks.MockStorageManager
+	mailer                *mocks.MockMailer
+	forgotPasswordUseCase *users.ForgotPasswordUseCase
+}
+
+func TestForgotPasswordSuite(t *testing.T) {
+	suite.Run(t, new(ForgotPasswordSuite))
+}
+
+func (suite *ForgotPasswordSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.mailer = mocks.NewMockMailer(suite.mockCtrl)
+	conf := &config.PasswordReset{
+		TokenDurationInMinutes: 30,
+		ResetURL:               "http://localhost/reset",
+	}
+	suite.forgotPasswordUseCase = users.NewForgotPasswordUseCase(conf, suite.database, suite.mailer)
+}
+
+func (suite *ForgotPasswordSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ForgotPasswordSuite) TestForgotPasswordUseCase_ExecuteSuccess() {
+	var tokenStored *model.PasswordResetToken
+	suite.database.EXPECT().GetUser("d@d.com").Return(&model.User{ID: 1, Email: "d@d.com"}, true, nil)
+	suite.database.EXPECT().SavePasswordResetToken(gomock.Any()).DoAndReturn(func(token *model.PasswordResetToken) error {
+		tokenStored = token
+		return nil
+	})
+	suite.mailer.EXPECT().Send("d@d.com", gomock.Any(), gomock.Any()).DoAndReturn(func(to, subject, body string) error {
+		suite.Contains(body, "http://localhost/reset?token=")
+		token := body[strings.Index(body, "token=")+len("token=") : strings.Index(body, "\n")]
+		suite.NotEqual(token, tokenStored.TokenHash)
+		return nil
+	})
+	err := suite.forgotPasswordUseCase.Execute("d@d.com")
+	suite.NoError(err)
+	suite.Equal(int64(1), tokenStored.UserID)
+	suite.True(tokenStored.ExpiresAt.After(time.Now().UTC().Add(29 * time.Minute)))
+}
+
+func (suite *ForgotPasswordSuite) TestForgotPasswordUseCase_ExecuteUnknownEmail() {
+	suite.database.EXPECT().GetUser("d@d.com").Return(nil, false, nil)
+	err := suite.forgotPasswordUseCase.Execute("d@d.com")
+	suite.NoError(err)
+}
+
+func (suite *ForgotPasswordSuite) TestForgotPasswordUseCase_ExecuteMailerErrorIsHidden() {
+	suite.database.EXPECT().GetUser("d@d.com").Return(&model.User{ID: 1, Email: "d@d.com"}, true, nil)
+	suite.database.EXPECT().SavePasswordResetToken(gomock.Any()).Return(nil)
+	suite.mailer.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("smtp down"))
+	err := suite.forgotPasswordUseCase.Execute("d@d.com")
+	suite.NoError(err)
+}
+
+func (suite *ForgotPasswordSuite) TestForgotPasswordUseCase_ExecuteError_GetUser() {
+	suite.database.EXPECT().GetUser("d@d.com").Return(nil, false, errors.New("fail"))
+	err := suite.forgotPasswordUseCase.Execute("d@d.com")
+	suite.Error(err)
+}
+
+func (suite *ForgotPasswordSuite) TestForgotPasswordUseCase_ExecuteError_SaveToken() {
+	suite.database.EXPECT().GetUser("d@d.com").Return(&model.User{ID: 1, Email: "d@d.com"}, true, nil)
+	suite.database.EXPECT().SavePasswordResetToken(gomock.Any()).Return(errors.New("fail"))
+	err := suite.forgotPasswordUseCase.Execute("d@d.com")
+	suite.Error(err)
+}
+
+func (suite *ForgotPasswordSuite) TestBackgroundEmailRequest_Execute() {
+	background := users.NewBackgroundEmailRequest("password-reset", suite.forgotPasswordUseCase, 1)
+	// nothing is looked up while the request is answered, and a full queue drops the request
+	suite.NoError(background.Execute("d@d.com"))
+	suite.NoError(background.Execute("e@e.com"))
+
+	done := make(chan struct{})
+	suite.database.EXPECT().GetUser("d@d.com").DoAndReturn(func(email string) (*model.User, bool, error) {
+		close(done)
+		return nil, false, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go background.Run(ctx)
+	<-done
+}
@@ -13,6 +13,8 @@ type FavouritesSearchParams struct {
 	Page     int64
 	PageSize int64
 	UserID   int64
+	// IncludeUnavailable also returns the favourites that are not ACTIVE anymore
+	IncludeUnavailable bool
 }
 
 func NewListFavouriteUseCase(database StorageManager) *ListFavouritesUseCase {
@@ -21,7 +23,7 @@ func NewListFavouriteUseCase(database StorageManager) *ListFavouritesUseCase {
 	}
 }
 
-func (uc *ListFavouritesUseCase) Execute(search FavouritesSearchParams) (*model.PropertiesPaging, error) {
+func (uc *ListFavouritesUseCase) Execute(search FavouritesSearchParams) (*model.FavouritesPaging, error) {
 	results, err := uc.database.ListFavourites(search)
 	if err != nil {
 		return nil, err
@@ -32,7 +32,7 @@ func (suite *ListFavouritesSuite) TearDownSuite() {
 }
 
 func (suite *ListFavouritesSuite) TestListFavouritesUseCase_ExecuteSuccess() {
-	suite.database.EXPECT().ListFavourites(gomock.Any()).Return(&model.PropertiesPaging{
+	suite.database.EXPECT().ListFavourites(gomock.Any()).Return(&model.FavouritesPaging{
 		Page:       1,
 		PageSize:   10,
 		TotalPages: 0,
@@ -0,0 +1,17 @@
+package users
+
+import (
+	"lahaus/domain/model"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_login_attempts.go -package=mocks -source=./login_attempts.go
+
+// LoginAttemptStore keeps the failed login attempts per key (email or ip)
+type LoginAttemptStore interface {
+	GetLoginAttempts(key string) (*model.LoginAttempts, error)
+	// RegisterLoginFailure adds a failure atomically, the failures registered before windowStart are discarded
+	RegisterLoginFailure(key string, now, windowStart time.Time) (*model.LoginAttempts, error)
+	LockLogin(key string, until time.Time) error
+	ResetLoginAttempts(key string) error
+}
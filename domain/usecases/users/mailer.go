@@ -0,0 +1,8 @@
+package users
+
+//go:generate mockgen -destination=./mocks/mock_mailer.go -package=mocks -source=./mailer.go
+
+// Mailer delivers emails to the users
+type Mailer interface {
+	Send(to, subject, body string) error
+}
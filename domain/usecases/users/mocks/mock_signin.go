@@ -35,17 +35,17 @@ func (m *MockStorageManager) EXPECT() *MockStorageManagerMockRecorder {
 }
 
 // SaveUser mocks base method
-func (m *MockStorageManager) SaveUser(user *model.User) error {
+func (m *MockStorageManager) SaveUser(user *model.User, audit *model.AuditEntry) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveUser", user)
+	ret := m.ctrl.Call(m, "SaveUser", user, audit)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SaveUser indicates an expected call of SaveUser
-func (mr *MockStorageManagerMockRecorder) SaveUser(user interface{}) *gomock.Call {
+func (mr *MockStorageManagerMockRecorder) SaveUser(user, audit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUser", reflect.TypeOf((*MockStorageManager)(nil).SaveUser), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUser", reflect.TypeOf((*MockStorageManager)(nil).SaveUser), user, audit)
 }
 
 // GetUser mocks base method
@@ -64,6 +64,52 @@ func (mr *MockStorageManagerMockRecorder) GetUser(emil interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStorageManager)(nil).GetUser), emil)
 }
 
+// GetUserByID mocks base method
+func (m *MockStorageManager) GetUserByID(userID int64) (*model.User, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserByID indicates an expected call of GetUserByID
+func (mr *MockStorageManagerMockRecorder) GetUserByID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockStorageManager)(nil).GetUserByID), userID)
+}
+
+// VerifyEmail mocks base method
+func (m *MockStorageManager) VerifyEmail(email string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", email)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail
+func (mr *MockStorageManagerMockRecorder) VerifyEmail(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockStorageManager)(nil).VerifyEmail), email)
+}
+
+// UpdateUserRole mocks base method
+func (m *MockStorageManager) UpdateUserRole(userID int64, role model.Role, audit *model.AuditEntry) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", userID, role, audit)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole
+func (mr *MockStorageManagerMockRecorder) UpdateUserRole(userID, role, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStorageManager)(nil).UpdateUserRole), userID, role, audit)
+}
+
 // GetProperty mocks base method
 func (m *MockStorageManager) GetProperty(id int64) (*model.Property, bool, error) {
 	m.ctrl.T.Helper()
@@ -81,24 +127,55 @@ func (mr *MockStorageManagerMockRecorder) GetProperty(id interface{}) *gomock.Ca
 }
 
 // AddFavourite mocks base method
-func (m *MockStorageManager) AddFavourite(email, propertyID int64) error {
+func (m *MockStorageManager) AddFavourite(userID, propertyID int64, audit *model.AuditEntry) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddFavourite", email, propertyID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddFavourite", userID, propertyID, audit)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddFavourite indicates an expected call of AddFavourite
-func (mr *MockStorageManagerMockRecorder) AddFavourite(email, propertyID interface{}) *gomock.Call {
+func (mr *MockStorageManagerMockRecorder) AddFavourite(userID, propertyID, audit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavourite", reflect.TypeOf((*MockStorageManager)(nil).AddFavourite), email, propertyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavourite", reflect.TypeOf((*MockStorageManager)(nil).AddFavourite), userID, propertyID, audit)
+}
+
+// RemoveFavourite mocks base method
+func (m *MockStorageManager) RemoveFavourite(userID, propertyID int64, audit *model.AuditEntry) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavourite", userID, propertyID, audit)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFavourite indicates an expected call of RemoveFavourite
+func (mr *MockStorageManagerMockRecorder) RemoveFavourite(userID, propertyID, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavourite", reflect.TypeOf((*MockStorageManager)(nil).RemoveFavourite), userID, propertyID, audit)
+}
+
+// ListFavouriteIDs mocks base method
+func (m *MockStorageManager) ListFavouriteIDs(userID int64, propertyIDs []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavouriteIDs", userID, propertyIDs)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavouriteIDs indicates an expected call of ListFavouriteIDs
+func (mr *MockStorageManagerMockRecorder) ListFavouriteIDs(userID, propertyIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavouriteIDs", reflect.TypeOf((*MockStorageManager)(nil).ListFavouriteIDs), userID, propertyIDs)
 }
 
 // ListFavourites mocks base method
-func (m *MockStorageManager) ListFavourites(search users.FavouritesSearchParams) (*model.PropertiesPaging, error) {
+func (m *MockStorageManager) ListFavourites(search users.FavouritesSearchParams) (*model.FavouritesPaging, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListFavourites", search)
-	ret0, _ := ret[0].(*model.PropertiesPaging)
+	ret0, _ := ret[0].(*model.FavouritesPaging)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -108,3 +185,62 @@ func (mr *MockStorageManagerMockRecorder) ListFavourites(search interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavourites", reflect.TypeOf((*MockStorageManager)(nil).ListFavourites), search)
 }
+
+// SavePasswordResetToken mocks base method
+func (m *MockStorageManager) SavePasswordResetToken(token *model.PasswordResetToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePasswordResetToken", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePasswordResetToken indicates an expected call of SavePasswordResetToken
+func (mr *MockStorageManagerMockRecorder) SavePasswordResetToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePasswordResetToken", reflect.TypeOf((*MockStorageManager)(nil).SavePasswordResetToken), token)
+}
+
+// GetPasswordResetToken mocks base method
+func (m *MockStorageManager) GetPasswordResetToken(tokenHash string) (*model.PasswordResetToken, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordResetToken", tokenHash)
+	ret0, _ := ret[0].(*model.PasswordResetToken)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPasswordResetToken indicates an expected call of GetPasswordResetToken
+func (mr *MockStorageManagerMockRecorder) GetPasswordResetToken(tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordResetToken", reflect.TypeOf((*MockStorageManager)(nil).GetPasswordResetToken), tokenHash)
+}
+
+// ResetPassword mocks base method
+func (m *MockStorageManager) ResetPassword(token *model.PasswordResetToken, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", token, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword
+func (mr *MockStorageManagerMockRecorder) ResetPassword(token, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockStorageManager)(nil).ResetPassword), token, password)
+}
+
+// SaveAuditEntry mocks base method
+func (m *MockStorageManager) SaveAuditEntry(audit *model.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAuditEntry", audit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAuditEntry indicates an expected call of SaveAuditEntry
+func (mr *MockStorageManagerMockRecorder) SaveAuditEntry(audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuditEntry", reflect.TypeOf((*MockStorageManager)(nil).SaveAuditEntry), audit)
+}
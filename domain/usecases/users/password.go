@@ -0,0 +1,14 @@
+package users
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// dummyPasswordHash is compared with the password of the unknown emails
+var dummyPasswordHash = hashPassword("unknown-user")
+
+func hashPassword(password string) string {
+	passwordEncrypt := sha256.Sum256([]byte(password))
+	return base64.URLEncoding.EncodeToString(passwordEncrypt[:])
+}
@@ -0,0 +1,25 @@
+package users
+
+import "lahaus/domain/model"
+
+type RemoveFavouriteUseCase struct {
+	database StorageManager
+}
+
+func NewRemoveFavouriteUseCase(database StorageManager) *RemoveFavouriteUseCase {
+	return &RemoveFavouriteUseCase{
+		database: database,
+	}
+}
+
+// Execute removes the property from the favourites of the actor, removing a property that is not a favourite is not an error
+func (uc *RemoveFavouriteUseCase) Execute(actor *model.Actor, propertyID int64) error {
+	audit := model.NewAuditEntry(model.USER, actor.UserID, model.FAVOURITE_REMOVE, actor, map[string]model.AuditChange{
+		"propertyId": {Before: propertyID},
+	})
+	_, err := uc.database.RemoveFavourite(actor.UserID, propertyID, audit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package users
+
+import (
+	"go.uber.org/zap"
+	"lahaus/config"
+	"lahaus/logger"
+)
+
+type ResendVerificationUseCase struct {
+	database StorageManager
+	sender   *verificationSender
+}
+
+func NewResendVerificationUseCase(security *config.Security, verification *config.EmailVerification, database StorageManager, mailer Mailer) *ResendVerificationUseCase {
+	return &ResendVerificationUseCase{
+		database: database,
+		sender: &verificationSender{
+			security:     security,
+			verification: verification,
+			mailer:       mailer,
+		},
+	}
+}
+
+// Execute sends a new verification link, it does not tell the caller whether the email is registered or already verified
+func (uc *ResendVerificationUseCase) Execute(email string) error {
+	user, found, err := uc.database.GetUser(email)
+	if err != nil {
+		return err
+	}
+	if !found || user.EmailVerifiedAt != nil {
+		return nil
+	}
+	if err := uc.sender.send(user.Email); err != nil {
+		logger.GetInstance().Error("error sending verification email", zap.Error(err), zap.Int64("userId", user.ID))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/internal/token"
+	"time"
+)
+
+const minPasswordLength = 8
+
+type ResetPasswordUseCase struct {
+	database StorageManager
+}
+
+func NewResetPasswordUseCase(database StorageManager) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		database: database,
+	}
+}
+
+// Execute changes the password of the token owner and consumes the token
+func (uc *ResetPasswordUseCase) Execute(receivedToken, password string) error {
+	if len(password) < minPasswordLength {
+		return model.NewDomainError(fmt.Errorf("password must have at least %d characters", minPasswordLength))
+	}
+	invalidToken := model.NewDomainError(errors.New("the reset token is invalid or expired"))
+
+	resetToken, found, err := uc.database.GetPasswordResetToken(token.Hash(receivedToken))
+	if err != nil {
+		return err
+	}
+	if !found || resetToken.UsedAt != nil || time.Now().UTC().After(resetToken.ExpiresAt) {
+		return invalidToken
+	}
+
+	consumed, err := uc.database.ResetPassword(resetToken, hashPassword(password))
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return invalidToken
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package users_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
+	"lahaus/domain/usecases/users/mocks"
+	"testing"
+	"time"
+)
+
+type ResetPasswordSuite struct {
+	suite.Suite
+	mockCtrl             *gomock.Controller
+	database             *mocks.MockStorageManager
+	resetPasswordUseCase *users.ResetPasswordUseCase
+}
+
+func TestResetPasswordSuite(t *testing.T) {
+	suite.Run(t, new(ResetPasswordSuite))
+}
+
+func (suite *ResetPasswordSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.resetPasswordUseCase = users.NewResetPasswordUseCase(suite.database)
+}
+
+func (suite *ResetPasswordSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ResetPasswordSuite) TestResetPasswordUseCase_ExecuteSuccess() {
+	token := &model.PasswordResetToken{ID: 1, UserID: 1, ExpiresAt: time.Now().UTC().Add(time.Minute)}
+	suite.database.EXPECT().GetPasswordResetToken("PEaenWxYddN6Q_NT1PiOYfz4EsZu7jRXRlpAsNpBU-A=").Return(token, true, nil)
+	suite.database.EXPECT().ResetPassword(token, "uLn48jmS68Jhf-vAPZLssXY_unt3pdBTtpxBa60Yo2k=").Return(true, nil)
+	err := suite.resetPasswordUseCase.Execute("token", "new-password")
+	suite.NoError(err)
+}
+
+func (suite *ResetPasswordSuite) TestResetPasswordUseCase_ExecuteError_ShortPassword() {
+	err := suite.resetPasswordUseCase.Execute("token", "short")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ResetPasswordSuite) TestResetPasswordUseCase_ExecuteError_TokenNotFound() {
+	suite.database.EXPECT().GetPasswordResetToken(gomock.Any()).Return(nil, false, nil)
+	err := suite.resetPasswordUseCase.Execute("token", "new-password")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ResetPasswordSuite) TestResetPasswordUseCase_ExecuteError_TokenExpired() {
+	token := &model.PasswordResetToken{ID: 1, UserID: 1, ExpiresAt: time.Now().UTC().Add(-time.Minute)}
+	suite.database.EXPECT().GetPasswordResetToken(gomock.Any()).Return(token, true, nil)
+	err := suite.resetPasswordUseCase.Execute("token", "new-password")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ResetPasswordSuite) TestResetPasswordUseCase_ExecuteError_TokenUsed() {
+	usedAt := time.Now().UTC()
+	token := &model.PasswordResetToken{ID: 1, UserID: 1, ExpiresAt: time.Now().UTC().Add(time.Minute), UsedAt: &usedAt}
+	suite.database.EXPECT().GetPasswordResetToken(gomock.Any()).Return(token, true, nil)
+	err := suite.resetPasswordUseCase.Execute("token", "new-password")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ResetPasswordSuite) TestResetPasswordUseCase_ExecuteError_TokenConsumedConcurrently() {
+	token := &model.PasswordResetToken{ID: 1, UserID: 1, ExpiresAt: time.Now().UTC().Add(time.Minute)}
+	suite.database.EXPECT().GetPasswordResetToken(gomock.Any()).Return(token, true, nil)
+	suite.database.EXPECT().ResetPassword(token, gomock.Any()).Return(false, nil)
+	err := suite.resetPasswordUseCase.Execute("token", "new-password")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *ResetPasswordSuite) TestResetPasswordUseCase_ExecuteError_ResetPassword() {
+	token := &model.PasswordResetToken{ID: 1, UserID: 1, ExpiresAt: time.Now().UTC().Add(time.Minute)}
+	suite.database.EXPECT().GetPasswordResetToken(gomock.Any()).Return(token, true, nil)
+	suite.database.EXPECT().ResetPassword(token, gomock.Any()).Return(false, errors.New("fail"))
+	err := suite.resetPasswordUseCase.Execute("token", "new-password")
+	suite.Error(err)
+}
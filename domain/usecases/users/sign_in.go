@@ -1,33 +1,64 @@
 package users
 
 import (
-	"crypto/sha256"
-	"encoding/base64"
+	"go.uber.org/zap"
+	"lahaus/config"
 	"lahaus/domain/model"
+	"lahaus/logger"
 )
 
 //go:generate mockgen -destination=./mocks/mock_signin.go -package=mocks -source=./sign_in.go
 
 type StorageManager interface {
-	SaveUser(user *model.User) error
+	SaveUser(user *model.User, audit *model.AuditEntry) error
 	GetUser(emil string) (*model.User, bool, error)
+	GetUserByID(userID int64) (*model.User, bool, error)
+	VerifyEmail(email string) (bool, error)
+	UpdateUserRole(userID int64, role model.Role, audit *model.AuditEntry) (bool, error)
 	GetProperty(id int64) (*model.Property, bool, error)
-	AddFavourite(userID, propertyID int64) error
-	ListFavourites(search FavouritesSearchParams) (*model.PropertiesPaging, error)
+	AddFavourite(userID, propertyID int64, audit *model.AuditEntry) (bool, error)
+	RemoveFavourite(userID, propertyID int64, audit *model.AuditEntry) (bool, error)
+	ListFavouriteIDs(userID int64, propertyIDs []int64) ([]int64, error)
+	ListFavourites(search FavouritesSearchParams) (*model.FavouritesPaging, error)
+	SavePasswordResetToken(token *model.PasswordResetToken) error
+	GetPasswordResetToken(tokenHash string) (*model.PasswordResetToken, bool, error)
+	ResetPassword(token *model.PasswordResetToken, password string) (bool, error)
+	SaveAuditEntry(audit *model.AuditEntry) error
 }
 
 type SignInUserUseCase struct {
 	database StorageManager
+	sender   *verificationSender
 }
 
-func NewSignInUserUseCase(database StorageManager) *SignInUserUseCase {
+func NewSignInUserUseCase(security *config.Security, verification *config.EmailVerification, database StorageManager, mailer Mailer) *SignInUserUseCase {
 	return &SignInUserUseCase{
 		database: database,
+		sender: &verificationSender{
+			security:     security,
+			verification: verification,
+			mailer:       mailer,
+		},
 	}
 }
 
-func (c *SignInUserUseCase) Execute(user *model.User) error {
-	passwordEncrypt := sha256.Sum256([]byte(user.Password))
-	user.Password = base64.URLEncoding.EncodeToString(passwordEncrypt[:])
-	return c.database.SaveUser(user)
+// Execute saves the user, the actor is anonymous unless an authenticated user creates it
+func (c *SignInUserUseCase) Execute(user *model.User, actor *model.Actor) error {
+	if user.Role == "" {
+		user.Role = model.BUYER
+	}
+	user.Password = hashPassword(user.Password)
+	audit := model.NewAuditEntry(model.USER, 0, model.CREATE, actor, map[string]model.AuditChange{
+		"email": {After: user.Email},
+		"role":  {After: user.Role},
+	})
+	err := c.database.SaveUser(user, audit)
+	if err != nil {
+		return err
+	}
+	// the user can ask for a new link, so a failure sending it must not fail the sign in
+	if err := c.sender.send(user.Email); err != nil {
+		logger.GetInstance().Error("error sending verification email", zap.Error(err))
+	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"lahaus/config"
 	"lahaus/domain/model"
 	"lahaus/domain/usecases/users"
 	"lahaus/domain/usecases/users/mocks"
@@ -14,6 +15,7 @@ type SignInSuite struct {
 	suite.Suite
 	mockCtrl      *gomock.Controller
 	database      *mocks.MockStorageManager
+	mailer        *mocks.MockMailer
 	signInUseCase *users.SignInUserUseCase
 }
 
@@ -24,7 +26,9 @@ func TestSignInSuite(t *testing.T) {
 func (suite *SignInSuite) SetupTest() {
 	suite.mockCtrl = gomock.NewController(suite.T())
 	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
-	suite.signInUseCase = users.NewSignInUserUseCase(suite.database)
+	suite.mailer = mocks.NewMockMailer(suite.mockCtrl)
+	suite.signInUseCase = users.NewSignInUserUseCase(&config.Security{Secret: "s3cr3t", Issuer: "lahaus"},
+		&config.EmailVerification{TokenDurationInMinutes: 60, VerifyURL: "http://localhost/verify"}, suite.database, suite.mailer)
 }
 
 func (suite *SignInSuite) TearDownSuite() {
@@ -32,15 +36,27 @@ func (suite *SignInSuite) TearDownSuite() {
 }
 
 func (suite *SignInSuite) TestSignInUseCase_ExecuteSuccess() {
-	user := &model.User{Password: "1"}
-	suite.database.EXPECT().SaveUser(gomock.Any()).Return(nil)
-	err := suite.signInUseCase.Execute(user)
+	user := &model.User{Email: "d@d.com", Password: "1"}
+	suite.database.EXPECT().SaveUser(gomock.Any(), gomock.Any()).Return(nil)
+	suite.mailer.EXPECT().Send("d@d.com", gomock.Any(), gomock.Any()).DoAndReturn(func(to, subject, body string) error {
+		suite.Contains(body, "http://localhost/verify?token=")
+		return nil
+	})
+	err := suite.signInUseCase.Execute(user, &model.Actor{RequestID: "request-1"})
 	suite.NoError(err)
 	suite.Equal("a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=", user.Password)
+	suite.Equal(model.BUYER, user.Role)
+}
+
+func (suite *SignInSuite) TestSignInUseCase_ExecuteSuccess_MailerError() {
+	suite.database.EXPECT().SaveUser(gomock.Any(), gomock.Any()).Return(nil)
+	suite.mailer.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("smtp down"))
+	err := suite.signInUseCase.Execute(&model.User{Email: "d@d.com", Password: "1"}, &model.Actor{RequestID: "request-1"})
+	suite.NoError(err)
 }
 
 func (suite *SignInSuite) TestSignInUseCase_ExecuteError() {
-	suite.database.EXPECT().SaveUser(gomock.Any()).Return(errors.New("failed to get user"))
-	err := suite.signInUseCase.Execute(&model.User{})
+	suite.database.EXPECT().SaveUser(gomock.Any(), gomock.Any()).Return(errors.New("failed to get user"))
+	err := suite.signInUseCase.Execute(&model.User{}, &model.Actor{RequestID: "request-1"})
 	suite.Error(err)
 }
@@ -1,53 +1,85 @@
 package users
 
 import (
-	"crypto/sha256"
-	"encoding/base64"
+	"crypto/subtle"
 	"errors"
 	"github.com/dgrijalva/jwt-go"
+	"go.uber.org/zap"
 	"lahaus/config"
 	"lahaus/domain/model"
+	"lahaus/logger"
+	"math"
+	"strings"
 	"time"
 )
 
 type SignUpUserUseCase struct {
-	database StorageManager
-	config   *config.Security
+	database   StorageManager
+	attempts   LoginAttemptStore
+	config     *config.Security
+	protection *config.LoginProtection
 }
 
-func NewSignUpUserUseCase(config *config.Security, database StorageManager) *SignUpUserUseCase {
+func NewSignUpUserUseCase(config *config.Security, protection *config.LoginProtection, database StorageManager, attempts LoginAttemptStore) *SignUpUserUseCase {
 	return &SignUpUserUseCase{
-		database: database,
-		config:   config,
+		database:   database,
+		attempts:   attempts,
+		config:     config,
+		protection: protection,
 	}
 }
 
 type UserTokenClaims struct {
-	Email  string `json:"email"`
-	UserID int64  `json:"userId"`
+	Email  string     `json:"email"`
+	UserID int64      `json:"userId"`
+	Role   model.Role `json:"role"`
 	jwt.StandardClaims
 }
 
 type Token string
 
-func (s *SignUpUserUseCase) Execute(email, password string) (string, error) {
+// Execute returns the token of the user, the actor is the anonymous actor of the request and the login is audited as the user
+func (s *SignUpUserUseCase) Execute(email, password, ip string, actor *model.Actor) (string, error) {
+	now := time.Now().UTC()
+	emailKey := "email:" + email
+	ipKey := "ip:" + ip
+	for _, key := range []string{emailKey, ipKey} {
+		if err := s.checkLocked(key, now); err != nil {
+			return "", err
+		}
+	}
+
+	passwordEncrypt := hashPassword(password)
 	user, found, err := s.database.GetUser(email)
 	if err != nil {
 		return "", err
 	}
-	if !found {
-		return "", model.NewUnauthorizedError(errors.New(""))
+	// the unknown emails are compared too, so the answer takes the same time
+	storedPassword := dummyPasswordHash
+	var userID int64
+	if found {
+		storedPassword = user.Password
+		userID = user.ID
 	}
-
-	passwordEncrypt := sha256.Sum256([]byte(password))
-	passwordEncryptAsString := base64.URLEncoding.EncodeToString(passwordEncrypt[:])
-	if passwordEncryptAsString != user.Password {
+	if subtle.ConstantTimeCompare([]byte(passwordEncrypt), []byte(storedPassword)) != 1 || !found {
+		s.registerFailure(emailKey, s.protection.MaxFailuresPerEmail, userID, actor, now)
+		s.registerFailure(ipKey, s.protection.MaxFailuresPerIP, 0, actor, now)
 		return "", model.NewUnauthorizedError(errors.New("invalid credentials"))
 	}
+
+	// the ip counter is kept, a login to an own account must not clear the failures of guessing the others from that ip
+	if err := s.attempts.ResetLoginAttempts(emailKey); err != nil {
+		logger.GetInstance().Error("error resetting login attempts", zap.Error(err))
+	}
+	userActor := &model.Actor{UserID: user.ID, Role: user.Role, RequestID: actor.RequestID}
+	if err := s.database.SaveAuditEntry(model.NewAuditEntry(model.USER, user.ID, model.LOGIN, userActor, nil)); err != nil {
+		return "", err
+	}
 	expireTime := time.Now().Add(time.Duration(s.config.TokenDurationInMinutes) * time.Minute).Unix()
 	claims := UserTokenClaims{
 		email,
 		user.ID,
+		user.Role,
 		jwt.StandardClaims{
 			ExpiresAt: expireTime,
 			Issuer:    s.config.Issuer,
@@ -61,3 +93,53 @@ func (s *SignUpUserUseCase) Execute(email, password string) (string, error) {
 	return tokenSigned, nil
 
 }
+
+func (s *SignUpUserUseCase) checkLocked(key string, now time.Time) error {
+	attempts, err := s.attempts.GetLoginAttempts(key)
+	if err != nil {
+		return err
+	}
+	if attempts != nil && attempts.LockedUntil != nil && now.Before(*attempts.LockedUntil) {
+		retryAfter := int64(math.Ceil(attempts.LockedUntil.Sub(now).Seconds()))
+		return model.NewTooManyRequestsError(errors.New("too many failed login attempts"), retryAfter)
+	}
+	return nil
+}
+
+// registerFailure locks the key once it reaches maxFailures, doubling the lockout with every further failure
+func (s *SignUpUserUseCase) registerFailure(key string, maxFailures int, userID int64, actor *model.Actor, now time.Time) {
+	windowStart := now.Add(-time.Duration(s.protection.FailureWindowInMinutes) * time.Minute)
+	attempts, err := s.attempts.RegisterLoginFailure(key, now, windowStart)
+	if err != nil {
+		logger.GetInstance().Error("error registering login failure", zap.Error(err))
+		return
+	}
+	if attempts.Failures < maxFailures {
+		return
+	}
+
+	lockout := time.Duration(s.protection.BaseLockoutInSeconds) * time.Second
+	maxLockout := time.Duration(s.protection.MaxLockoutInMinutes) * time.Minute
+	for i := maxFailures; i < attempts.Failures && lockout < maxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > maxLockout {
+		lockout = maxLockout
+	}
+	until := now.Add(lockout)
+	if err := s.attempts.LockLogin(key, until); err != nil {
+		logger.GetInstance().Error("error locking login", zap.Error(err))
+		return
+	}
+
+	changes := map[string]model.AuditChange{
+		"failures":    {After: attempts.Failures},
+		"lockedUntil": {After: until},
+	}
+	if strings.HasPrefix(key, "ip:") {
+		changes["ip"] = model.AuditChange{After: strings.TrimPrefix(key, "ip:")}
+	}
+	if err := s.database.SaveAuditEntry(model.NewAuditEntry(model.USER, userID, model.LOGIN_LOCKOUT, actor, changes)); err != nil {
+		logger.GetInstance().Error("error auditing login lockout", zap.Error(err))
+	}
+}
@@ -2,6 +2,7 @@ package users_test
 
 import (
 	"errors"
+	"github.com/dgrijalva/jwt-go"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 	"lahaus/config"
@@ -10,12 +11,14 @@ import (
 	"lahaus/domain/usecases/users/mocks"
 	"strings"
 	"testing"
+	"time"
 )
 
 type SignUpSuite struct {
 	suite.Suite
 	mockCtrl      *gomock.Controller
 	database      *mocks.MockStorageManager
+	attempts      *mocks.MockLoginAttemptStore
 	signUpUseCase *users.SignUpUserUseCase
 }
 
@@ -31,43 +34,210 @@ func (suite *SignUpSuite) SetupTest() {
 		TokenDurationInMinutes: 1,
 		Issuer:                 "lahaus",
 	}
-	suite.signUpUseCase = users.NewSignUpUserUseCase(conf, suite.database)
+	protection := &config.LoginProtection{
+		MaxFailuresPerEmail:    5,
+		MaxFailuresPerIP:       20,
+		BaseLockoutInSeconds:   30,
+		MaxLockoutInMinutes:    60,
+		FailureWindowInMinutes: 15,
+	}
+	suite.attempts = mocks.NewMockLoginAttemptStore(suite.mockCtrl)
+	suite.signUpUseCase = users.NewSignUpUserUseCase(conf, protection, suite.database, suite.attempts)
 }
 
 func (suite *SignUpSuite) TearDownSuite() {
 	suite.mockCtrl.Finish()
 }
 
+func (suite *SignUpSuite) expectNotLocked() {
+	suite.attempts.EXPECT().GetLoginAttempts("email:d@d.com").Return(nil, nil)
+	suite.attempts.EXPECT().GetLoginAttempts("ip:127.0.0.1").Return(nil, nil)
+}
+
+func (suite *SignUpSuite) expectFailure(emailFailures, ipFailures int) {
+	suite.attempts.EXPECT().RegisterLoginFailure("email:d@d.com", gomock.Any(), gomock.Any()).Return(&model.LoginAttempts{Key: "email:d@d.com", Failures: emailFailures}, nil)
+	suite.attempts.EXPECT().RegisterLoginFailure("ip:127.0.0.1", gomock.Any(), gomock.Any()).Return(&model.LoginAttempts{Key: "ip:127.0.0.1", Failures: ipFailures}, nil)
+}
+
 func (suite *SignUpSuite) TestSignUpUseCase_ExecuteSuccess() {
+	suite.expectNotLocked()
+	suite.attempts.EXPECT().ResetLoginAttempts("email:d@d.com").Return(nil)
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Return(nil)
 	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
 		ID:       1,
 		Email:    "d@d.com",
 		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
 	}, true, nil)
-	token, err := suite.signUpUseCase.Execute("d@d.com", "1")
+	token, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
 	suite.NoError(err)
 	v := strings.Split(token, ".")
 	suite.Len(v, 3)
 }
 
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteSuccess_RoleClaim() {
+	suite.expectNotLocked()
+	suite.attempts.EXPECT().ResetLoginAttempts("email:d@d.com").Return(nil)
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Return(nil)
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
+		ID:       1,
+		Email:    "d@d.com",
+		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
+		Role:     model.AGENT,
+	}, true, nil)
+	token, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.NoError(err)
+	claims := &users.UserTokenClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte("s3cr3t"), nil
+	})
+	suite.NoError(err)
+	suite.Equal(model.AGENT, claims.Role)
+}
+
 func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_GetUser() {
+	suite.expectNotLocked()
 	suite.database.EXPECT().GetUser(gomock.Any()).Return(nil, false, errors.New("fail to get user from database"))
-	_, err := suite.signUpUseCase.Execute("d@d.com", "1")
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
 	suite.Error(err)
 }
 
 func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_UserNotFound() {
+	suite.expectNotLocked()
+	suite.expectFailure(1, 1)
 	suite.database.EXPECT().GetUser(gomock.Any()).Return(nil, false, nil)
-	_, err := suite.signUpUseCase.Execute("d@d.com", "1")
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
 	suite.Error(err)
 }
 
 func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_PasswordDiffer() {
+	suite.expectNotLocked()
+	suite.expectFailure(1, 1)
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
+		ID:       1,
+		Email:    "d@d.com",
+		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
+	}, true, nil)
+	_, err := suite.signUpUseCase.Execute("d@d.com", "11", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.Error(err)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_LocksEmail() {
+	suite.expectNotLocked()
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(nil, false, nil)
+	suite.expectFailure(6, 6)
+	var lockedUntil time.Time
+	suite.attempts.EXPECT().LockLogin("email:d@d.com", gomock.Any()).DoAndReturn(func(key string, until time.Time) error {
+		lockedUntil = until
+		return nil
+	})
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Do(func(audit *model.AuditEntry) {
+		suite.Equal(model.USER, audit.Entity)
+		suite.Equal(int64(0), audit.EntityID)
+		suite.Equal(model.LOGIN_LOCKOUT, audit.Action)
+		suite.Nil(audit.ActorID)
+		suite.Equal("request-1", audit.RequestID)
+		suite.Equal(6, audit.Changes["failures"].After)
+		suite.NotContains(audit.Changes, "ip")
+	}).Return(nil)
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.IsType(&model.UnauthorizedError{}, err)
+	suite.InDelta(60, time.Until(lockedUntil).Seconds(), 2)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_LocksIP() {
+	suite.expectNotLocked()
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
+		ID:       1,
+		Email:    "d@d.com",
+		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
+	}, true, nil)
+	suite.expectFailure(1, 20)
+	suite.attempts.EXPECT().LockLogin("ip:127.0.0.1", gomock.Any()).Return(nil)
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Do(func(audit *model.AuditEntry) {
+		suite.Equal(int64(0), audit.EntityID)
+		suite.Equal(model.LOGIN_LOCKOUT, audit.Action)
+		suite.Equal("127.0.0.1", audit.Changes["ip"].After)
+	}).Return(errors.New("fail"))
+	_, err := suite.signUpUseCase.Execute("d@d.com", "11", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.IsType(&model.UnauthorizedError{}, err)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_LockoutCapped() {
+	suite.expectNotLocked()
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(nil, false, nil)
+	suite.expectFailure(50, 1)
+	var lockedUntil time.Time
+	suite.attempts.EXPECT().LockLogin("email:d@d.com", gomock.Any()).DoAndReturn(func(key string, until time.Time) error {
+		lockedUntil = until
+		return nil
+	})
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Return(nil)
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.Error(err)
+	suite.InDelta(3600, time.Until(lockedUntil).Seconds(), 2)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_Locked() {
+	lockedUntil := time.Now().UTC().Add(30 * time.Second)
+	suite.attempts.EXPECT().GetLoginAttempts("email:d@d.com").Return(&model.LoginAttempts{Key: "email:d@d.com", Failures: 5, LockedUntil: &lockedUntil}, nil)
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.IsType(&model.TooManyRequestsError{}, err)
+	suite.InDelta(30, err.(*model.TooManyRequestsError).RetryAfter, 1)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_IPLocked() {
+	lockedUntil := time.Now().UTC().Add(time.Minute)
+	suite.attempts.EXPECT().GetLoginAttempts("email:d@d.com").Return(nil, nil)
+	suite.attempts.EXPECT().GetLoginAttempts("ip:127.0.0.1").Return(&model.LoginAttempts{Key: "ip:127.0.0.1", Failures: 20, LockedUntil: &lockedUntil}, nil)
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.IsType(&model.TooManyRequestsError{}, err)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteSuccess_ExpiredLock() {
+	lockedUntil := time.Now().UTC().Add(-time.Minute)
+	suite.attempts.EXPECT().GetLoginAttempts("email:d@d.com").Return(&model.LoginAttempts{Key: "email:d@d.com", Failures: 5, LockedUntil: &lockedUntil}, nil)
+	suite.attempts.EXPECT().GetLoginAttempts("ip:127.0.0.1").Return(nil, nil)
+	suite.attempts.EXPECT().ResetLoginAttempts("email:d@d.com").Return(nil)
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Return(nil)
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
+		ID:       1,
+		Email:    "d@d.com",
+		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
+	}, true, nil)
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.NoError(err)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteSuccess_Audit() {
+	suite.expectNotLocked()
+	suite.attempts.EXPECT().ResetLoginAttempts("email:d@d.com").Return(nil)
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
+		ID:       1,
+		Email:    "d@d.com",
+		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
+		Role:     model.BUYER,
+	}, true, nil)
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Do(func(audit *model.AuditEntry) {
+		suite.Equal(model.USER, audit.Entity)
+		suite.Equal(int64(1), audit.EntityID)
+		suite.Equal(model.LOGIN, audit.Action)
+		suite.Equal(int64(1), *audit.ActorID)
+		suite.Equal(model.BUYER, audit.ActorRole)
+		suite.Equal("request-1", audit.RequestID)
+	}).Return(nil)
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.NoError(err)
+}
+
+func (suite *SignUpSuite) TestSignUpUseCase_ExecuteError_Audit() {
+	suite.expectNotLocked()
+	suite.attempts.EXPECT().ResetLoginAttempts("email:d@d.com").Return(nil)
 	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
 		ID:       1,
 		Email:    "d@d.com",
 		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
 	}, true, nil)
-	_, err := suite.signUpUseCase.Execute("d@d.com", "11")
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Return(errors.New("fail"))
+	_, err := suite.signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
 	suite.Error(err)
 }
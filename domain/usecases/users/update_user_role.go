@@ -0,0 +1,47 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"lahaus/domain/model"
+)
+
+type UpdateUserRoleUseCase struct {
+	database StorageManager
+}
+
+func NewUpdateUserRoleUseCase(database StorageManager) *UpdateUserRoleUseCase {
+	return &UpdateUserRoleUseCase{
+		database: database,
+	}
+}
+
+// Execute lets an admin make a user an AGENT or a BUYER again, the admins are managed in the database
+func (uc *UpdateUserRoleUseCase) Execute(userID int64, role model.Role, actor *model.Actor) error {
+	if role != model.BUYER && role != model.AGENT {
+		return model.NewDomainError(fmt.Errorf("role not allowed [%v]", role))
+	}
+	user, found, err := uc.database.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewEntityNotFoundError(errors.New("user not found"))
+	}
+	if user.Role == model.ADMIN {
+		return model.NewDomainError(errors.New("the role of an admin cannot be changed"))
+	}
+	if user.Role == role {
+		return nil
+	}
+
+	found, err = uc.database.UpdateUserRole(user.ID, role, model.NewAuditEntry(model.USER, user.ID, model.UPDATE, actor,
+		map[string]model.AuditChange{"role": {Before: user.Role, After: role}}))
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewEntityNotFoundError(errors.New("user not found"))
+	}
+	return nil
+}
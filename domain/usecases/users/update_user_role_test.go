@@ -0,0 +1,76 @@
+package users_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
+	"lahaus/domain/usecases/users/mocks"
+	"testing"
+)
+
+type UpdateUserRoleSuite struct {
+	suite.Suite
+	mockCtrl              *gomock.Controller
+	database              *mocks.MockStorageManager
+	updateUserRoleUseCase *users.UpdateUserRoleUseCase
+}
+
+func TestUpdateUserRoleSuite(t *testing.T) {
+	suite.Run(t, new(UpdateUserRoleSuite))
+}
+
+func (suite *UpdateUserRoleSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.updateUserRoleUseCase = users.NewUpdateUserRoleUseCase(suite.database)
+}
+
+func (suite *UpdateUserRoleSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *UpdateUserRoleSuite) TestUpdateUserRoleUseCase_ExecuteSuccess() {
+	actor := &model.Actor{UserID: 9, Role: model.ADMIN, RequestID: "request-1"}
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, Email: "d@d.com", Role: model.BUYER}, true, nil)
+	suite.database.EXPECT().UpdateUserRole(int64(1), model.AGENT, gomock.Any()).DoAndReturn(
+		func(userID int64, role model.Role, audit *model.AuditEntry) (bool, error) {
+			suite.Equal(model.USER, audit.Entity)
+			suite.Equal(model.UPDATE, audit.Action)
+			suite.Equal(int64(9), *audit.ActorID)
+			suite.Equal(model.AuditChange{Before: model.BUYER, After: model.AGENT}, audit.Changes["role"])
+			return true, nil
+		})
+
+	suite.NoError(suite.updateUserRoleUseCase.Execute(1, model.AGENT, actor))
+}
+
+func (suite *UpdateUserRoleSuite) TestUpdateUserRoleUseCase_ExecuteSuccess_SameRole() {
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, Role: model.AGENT}, true, nil)
+
+	suite.NoError(suite.updateUserRoleUseCase.Execute(1, model.AGENT, &model.Actor{UserID: 9, Role: model.ADMIN}))
+}
+
+func (suite *UpdateUserRoleSuite) TestUpdateUserRoleUseCase_ExecuteError_RoleNotAllowed() {
+	err := suite.updateUserRoleUseCase.Execute(1, model.ADMIN, &model.Actor{UserID: 9, Role: model.ADMIN})
+	suite.IsType(&model.DomainError{}, err)
+
+	suite.database.EXPECT().GetUserByID(int64(2)).Return(&model.User{ID: 2, Role: model.ADMIN}, true, nil)
+	err = suite.updateUserRoleUseCase.Execute(2, model.BUYER, &model.Actor{UserID: 9, Role: model.ADMIN})
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *UpdateUserRoleSuite) TestUpdateUserRoleUseCase_ExecuteError_UserNotFound() {
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(nil, false, nil)
+
+	err := suite.updateUserRoleUseCase.Execute(1, model.AGENT, &model.Actor{UserID: 9, Role: model.ADMIN})
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *UpdateUserRoleSuite) TestUpdateUserRoleUseCase_ExecuteError_Storage() {
+	suite.database.EXPECT().GetUserByID(int64(1)).Return(&model.User{ID: 1, Role: model.BUYER}, true, nil)
+	suite.database.EXPECT().UpdateUserRole(int64(1), model.AGENT, gomock.Any()).Return(false, errors.New("fail"))
+
+	suite.Error(suite.updateUserRoleUseCase.Execute(1, model.AGENT, &model.Actor{UserID: 9, Role: model.ADMIN}))
+}
@@ -0,0 +1,64 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dgrijalva/jwt-go"
+	"lahaus/config"
+	"time"
+)
+
+const emailVerificationPurpose = "email_verification"
+const emailVerificationSubject = "Verify your email"
+
+type emailVerificationClaims struct {
+	Email   string `json:"email"`
+	Purpose string `json:"purpose"`
+	jwt.StandardClaims
+}
+
+// verificationSender sends the signed verification link, the link carries the email so no state is kept until the user follows it
+type verificationSender struct {
+	security     *config.Security
+	verification *config.EmailVerification
+	mailer       Mailer
+}
+
+func (vs *verificationSender) send(email string) error {
+	expireTime := time.Now().Add(time.Duration(vs.verification.TokenDurationInMinutes) * time.Minute).Unix()
+	claims := emailVerificationClaims{
+		email,
+		emailVerificationPurpose,
+		jwt.StandardClaims{
+			ExpiresAt: expireTime,
+			Issuer:    vs.security.Issuer,
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenSigned, err := token.SignedString([]byte(vs.security.Secret))
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("Follow this link to verify your email: %s?token=%s\nThe link expires in %d minutes.",
+		vs.verification.VerifyURL, tokenSigned, vs.verification.TokenDurationInMinutes)
+	return vs.mailer.Send(email, emailVerificationSubject, body)
+}
+
+// parseVerificationToken returns the email of a valid verification token
+func parseVerificationToken(security *config.Security, receivedToken string) (string, error) {
+	claims := &emailVerificationClaims{}
+	token, err := jwt.ParseWithClaims(receivedToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(security.Secret), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if !token.Valid || claims.Purpose != emailVerificationPurpose || claims.Email == "" {
+		return "", errors.New("invalid verification token")
+	}
+	return claims.Email, nil
+}
@@ -0,0 +1,35 @@
+package users
+
+import (
+	"errors"
+	"lahaus/config"
+	"lahaus/domain/model"
+)
+
+type VerifyEmailUseCase struct {
+	database StorageManager
+	security *config.Security
+}
+
+func NewVerifyEmailUseCase(security *config.Security, database StorageManager) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		database: database,
+		security: security,
+	}
+}
+
+// Execute marks as verified the email carried by the token, verifying twice is not an error
+func (uc *VerifyEmailUseCase) Execute(token string) error {
+	email, err := parseVerificationToken(uc.security, token)
+	if err != nil {
+		return model.NewDomainError(errors.New("the verification token is invalid or expired"))
+	}
+	found, err := uc.database.VerifyEmail(email)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return model.NewEntityNotFoundError(errors.New("user not found"))
+	}
+	return nil
+}
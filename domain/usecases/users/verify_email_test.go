@@ -0,0 +1,116 @@
+package users_test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
+	"lahaus/domain/usecases/users/mocks"
+	"strings"
+	"testing"
+	"time"
+)
+
+type VerifyEmailSuite struct {
+	suite.Suite
+	mockCtrl           *gomock.Controller
+	database           *mocks.MockStorageManager
+	mailer             *mocks.MockMailer
+	security           *config.Security
+	verifyEmailUseCase *users.VerifyEmailUseCase
+	resendUseCase      *users.ResendVerificationUseCase
+}
+
+func TestVerifyEmailSuite(t *testing.T) {
+	suite.Run(t, new(VerifyEmailSuite))
+}
+
+func (suite *VerifyEmailSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.database = mocks.NewMockStorageManager(suite.mockCtrl)
+	suite.mailer = mocks.NewMockMailer(suite.mockCtrl)
+	suite.security = &config.Security{Secret: "s3cr3t", Issuer: "lahaus", TokenDurationInMinutes: 1}
+	verification := &config.EmailVerification{Required: true, TokenDurationInMinutes: 60, VerifyURL: "http://localhost/verify"}
+	suite.verifyEmailUseCase = users.NewVerifyEmailUseCase(suite.security, suite.database)
+	suite.resendUseCase = users.NewResendVerificationUseCase(suite.security, verification, suite.database, suite.mailer)
+}
+
+func (suite *VerifyEmailSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+// sendToken asks for a new verification link and returns the token carried by it
+func (suite *VerifyEmailSuite) sendToken(email string) string {
+	var token string
+	suite.database.EXPECT().GetUser(email).Return(&model.User{ID: 1, Email: email}, true, nil)
+	suite.mailer.EXPECT().Send(email, gomock.Any(), gomock.Any()).DoAndReturn(func(to, subject, body string) error {
+		token = body[strings.Index(body, "token=")+len("token=") : strings.Index(body, "\n")]
+		return nil
+	})
+	suite.NoError(suite.resendUseCase.Execute(email))
+	return token
+}
+
+func (suite *VerifyEmailSuite) TestVerifyEmailUseCase_ExecuteSuccess() {
+	token := suite.sendToken("d@d.com")
+	suite.database.EXPECT().VerifyEmail("d@d.com").Return(true, nil)
+	err := suite.verifyEmailUseCase.Execute(token)
+	suite.NoError(err)
+}
+
+func (suite *VerifyEmailSuite) TestVerifyEmailUseCase_ExecuteError_InvalidToken() {
+	err := suite.verifyEmailUseCase.Execute("not-a-token")
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *VerifyEmailSuite) TestVerifyEmailUseCase_ExecuteError_LoginTokenRejected() {
+	attempts := mocks.NewMockLoginAttemptStore(suite.mockCtrl)
+	attempts.EXPECT().GetLoginAttempts(gomock.Any()).Return(nil, nil).Times(2)
+	attempts.EXPECT().ResetLoginAttempts("email:d@d.com").Return(nil)
+	signUpUseCase := users.NewSignUpUserUseCase(suite.security, &config.LoginProtection{}, suite.database, attempts)
+	suite.database.EXPECT().GetUser(gomock.Any()).Return(&model.User{
+		ID:       1,
+		Email:    "d@d.com",
+		Password: "a4ayc_80_OGda4BO_1o_V0etpOqiLx1JwB5S3beHW0s=",
+	}, true, nil)
+	suite.database.EXPECT().SaveAuditEntry(gomock.Any()).Return(nil)
+	loginToken, err := signUpUseCase.Execute("d@d.com", "1", "127.0.0.1", &model.Actor{RequestID: "request-1"})
+	suite.NoError(err)
+	err = suite.verifyEmailUseCase.Execute(loginToken)
+	suite.IsType(&model.DomainError{}, err)
+}
+
+func (suite *VerifyEmailSuite) TestVerifyEmailUseCase_ExecuteError_UserNotFound() {
+	token := suite.sendToken("d@d.com")
+	suite.database.EXPECT().VerifyEmail("d@d.com").Return(false, nil)
+	err := suite.verifyEmailUseCase.Execute(token)
+	suite.IsType(&model.EntityNotFoundError{}, err)
+}
+
+func (suite *VerifyEmailSuite) TestVerifyEmailUseCase_ExecuteError_Database() {
+	token := suite.sendToken("d@d.com")
+	suite.database.EXPECT().VerifyEmail("d@d.com").Return(false, errors.New("fail"))
+	err := suite.verifyEmailUseCase.Execute(token)
+	suite.Error(err)
+}
+
+func (suite *VerifyEmailSuite) TestResendVerificationUseCase_ExecuteUnknownEmail() {
+	suite.database.EXPECT().GetUser("d@d.com").Return(nil, false, nil)
+	err := suite.resendUseCase.Execute("d@d.com")
+	suite.NoError(err)
+}
+
+func (suite *VerifyEmailSuite) TestResendVerificationUseCase_ExecuteAlreadyVerified() {
+	verifiedAt := time.Now()
+	suite.database.EXPECT().GetUser("d@d.com").Return(&model.User{ID: 1, Email: "d@d.com", EmailVerifiedAt: &verifiedAt}, true, nil)
+	err := suite.resendUseCase.Execute("d@d.com")
+	suite.NoError(err)
+}
+
+func (suite *VerifyEmailSuite) TestResendVerificationUseCase_ExecuteError_GetUser() {
+	suite.database.EXPECT().GetUser("d@d.com").Return(nil, false, errors.New("fail"))
+	err := suite.resendUseCase.Execute("d@d.com")
+	suite.Error(err)
+}
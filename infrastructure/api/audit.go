@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/audits"
+	"lahaus/logger"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+//go:generate mockgen -destination=./mocks/mock_audit.go -package=mocks -source=./audit.go
+
+type ListAuditEntriesExecutor interface {
+	Execute(search audits.AuditSearchParams) (*model.AuditPaging, error)
+}
+
+// AuditHandler struct
+type AuditHandler struct {
+	listExecutor ListAuditEntriesExecutor
+}
+
+// NewAuditHandler creates a new AuditHandler
+func NewAuditHandler(listExecutor ListAuditEntriesExecutor) *AuditHandler {
+	return &AuditHandler{
+		listExecutor: listExecutor,
+	}
+}
+
+// ListAuditEntries handler the request
+func (handler *AuditHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+	searchParams, err := mapToAuditSearchParams(r.URL.Query())
+	if err != nil {
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	results, err := handler.listExecutor.Execute(searchParams)
+	if err != nil {
+		logger.GetInstance().Error("error listing audit entries", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, results, http.StatusOK)
+}
+
+func mapToAuditSearchParams(query url.Values) (audits.AuditSearchParams, error) {
+	searchParams := audits.AuditSearchParams{
+		Entity:   model.AuditEntity(query.Get("entity")),
+		Page:     1,
+		PageSize: 10,
+	}
+	if searchParams.Entity == "" {
+		return searchParams, errors.New("entity param is a must")
+	}
+
+	if id := query.Get("id"); id != "" {
+		idValue, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return searchParams, err
+		}
+		searchParams.EntityID = &idValue
+	}
+
+	if page := query.Get("page"); page != "" {
+		pageValue, err := strconv.ParseInt(page, 10, 64)
+		if err != nil {
+			return searchParams, err
+		}
+		if pageValue < 1 {
+			return searchParams, errors.New("page must be greater than 0")
+		}
+		searchParams.Page = pageValue
+	}
+
+	if pageSize := query.Get("pageSize"); pageSize != "" {
+		pageSizeValue, err := strconv.ParseInt(pageSize, 10, 64)
+		if err != nil {
+			return searchParams, err
+		}
+		if pageSizeValue < 10 || pageSizeValue > 50 {
+			return searchParams, errors.New("pageSize must be between 10 and 50")
+		}
+		searchParams.PageSize = pageSizeValue
+	}
+
+	return searchParams, nil
+}
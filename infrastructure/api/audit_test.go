@@ -0,0 +1,81 @@
+package api
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/audits"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type AuditSuite struct {
+	suite.Suite
+	mockCtrl     *gomock.Controller
+	listExecutor *mocks.MockListAuditEntriesExecutor
+	auditHandler *AuditHandler
+	chiRouter    *chi.Mux
+}
+
+func TestAuditSuite(t *testing.T) {
+	suite.Run(t, new(AuditSuite))
+}
+
+func (suite *AuditSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.listExecutor = mocks.NewMockListAuditEntriesExecutor(suite.mockCtrl)
+	suite.auditHandler = NewAuditHandler(suite.listExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Get("/v1/admin/audit", suite.auditHandler.ListAuditEntries)
+}
+
+func (suite *AuditSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *AuditSuite) TestListAuditEntries_Success() {
+	req, err := http.NewRequest("GET", "/v1/admin/audit?entity=property&id=3&page=2", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	entityID := int64(3)
+	suite.listExecutor.EXPECT().Execute(audits.AuditSearchParams{Entity: model.PROPERTY, EntityID: &entityID, Page: 2, PageSize: 10}).
+		Return(&model.AuditPaging{Page: 2, PageSize: 10, Data: []*model.AuditEntry{}}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *AuditSuite) TestListAuditEntries_MissingEntity() {
+	req, err := http.NewRequest("GET", "/v1/admin/audit?id=3", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *AuditSuite) TestListAuditEntries_InvalidID() {
+	req, err := http.NewRequest("GET", "/v1/admin/audit?entity=property&id=abc", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *AuditSuite) TestListAuditEntries_InvalidEntity() {
+	req, err := http.NewRequest("GET", "/v1/admin/audit?entity=collection", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listExecutor.EXPECT().Execute(gomock.Any()).Return(nil, model.NewDomainError(errors.New("invalid entity [collection]")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"bytes"
+	"lahaus/domain/model"
+	"net/http"
+)
+
+// bufferedResponse keeps what a handler writes, so the response can be stored before it is sent
+type bufferedResponse struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{header: http.Header{}}
+}
+
+func (buffer *bufferedResponse) Header() http.Header {
+	return buffer.header
+}
+
+// Write behaves as the http.ResponseWriter, the first write without a status sends 200
+func (buffer *bufferedResponse) Write(data []byte) (int, error) {
+	if buffer.statusCode == 0 {
+		buffer.statusCode = http.StatusOK
+	}
+	return buffer.body.Write(data)
+}
+
+// WriteHeader keeps the first status, as the http.ResponseWriter ignores the superfluous ones
+func (buffer *bufferedResponse) WriteHeader(statusCode int) {
+	if buffer.statusCode == 0 {
+		buffer.statusCode = statusCode
+	}
+}
+
+func (buffer *bufferedResponse) storedResponse() *model.StoredResponse {
+	statusCode := buffer.statusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	header := map[string]string{}
+	for name := range buffer.header {
+		header[name] = buffer.header.Get(name)
+	}
+	return &model.StoredResponse{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       buffer.body.Bytes(),
+	}
+}
@@ -0,0 +1,398 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/infrastructure/api/middlewares"
+	"lahaus/logger"
+	"net/http"
+	"strconv"
+)
+
+//go:generate mockgen -destination=./mocks/mock_collection.go -package=mocks -source=./collection.go
+
+type CreateCollectionExecutor interface {
+	Execute(userID int64, name string) (*model.Collection, error)
+}
+
+type ListCollectionsExecutor interface {
+	Execute(userID int64) ([]*model.Collection, error)
+}
+
+type GetCollectionExecutor interface {
+	Execute(userID, collectionID int64) (*model.Collection, error)
+}
+
+type UpdateCollectionExecutor interface {
+	Execute(userID, collectionID int64, name string) (*model.Collection, error)
+}
+
+type DeleteCollectionExecutor interface {
+	Execute(userID, collectionID int64) error
+}
+
+type ListCollectionItemsExecutor interface {
+	Execute(search collections.CollectionItemsSearchParams) (*model.CollectionItemsPaging, error)
+}
+
+type SaveCollectionItemExecutor interface {
+	Execute(userID, collectionID, propertyID int64, note *string) (bool, error)
+}
+
+type RemoveCollectionItemExecutor interface {
+	Execute(userID, collectionID, propertyID int64) error
+}
+
+type TransferCollectionItemExecutor interface {
+	Execute(userID, fromCollectionID, toCollectionID, propertyID int64, move bool) error
+}
+
+// CollectionHandler struct
+type CollectionHandler struct {
+	createExecutor       CreateCollectionExecutor
+	listExecutor         ListCollectionsExecutor
+	getExecutor          GetCollectionExecutor
+	updateExecutor       UpdateCollectionExecutor
+	deleteExecutor       DeleteCollectionExecutor
+	listItemsExecutor    ListCollectionItemsExecutor
+	saveItemExecutor     SaveCollectionItemExecutor
+	removeItemExecutor   RemoveCollectionItemExecutor
+	transferItemExecutor TransferCollectionItemExecutor
+}
+
+// NewCollectionHandler creates a new CollectionHandler
+func NewCollectionHandler(createExecutor CreateCollectionExecutor, listExecutor ListCollectionsExecutor, getExecutor GetCollectionExecutor,
+	updateExecutor UpdateCollectionExecutor, deleteExecutor DeleteCollectionExecutor, listItemsExecutor ListCollectionItemsExecutor,
+	saveItemExecutor SaveCollectionItemExecutor, removeItemExecutor RemoveCollectionItemExecutor, transferItemExecutor TransferCollectionItemExecutor) *CollectionHandler {
+	return &CollectionHandler{
+		createExecutor:       createExecutor,
+		listExecutor:         listExecutor,
+		getExecutor:          getExecutor,
+		updateExecutor:       updateExecutor,
+		deleteExecutor:       deleteExecutor,
+		listItemsExecutor:    listItemsExecutor,
+		saveItemExecutor:     saveItemExecutor,
+		removeItemExecutor:   removeItemExecutor,
+		transferItemExecutor: transferItemExecutor,
+	}
+}
+
+type collectionRequest struct {
+	Name string `json:"name"`
+}
+
+type collectionItemRequest struct {
+	PropertyID int64   `json:"propertyId"`
+	Note       *string `json:"note"`
+}
+
+type transferCollectionItemRequest struct {
+	TargetCollectionID int64 `json:"targetCollectionId"`
+}
+
+// CreateCollection handler the request
+func (handler *CollectionHandler) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	var request collectionRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	collection, err := handler.createExecutor.Execute(user.ID, request.Name)
+	if err != nil {
+		logger.GetInstance().Error("error creating collection", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, collection, http.StatusCreated)
+}
+
+// ListCollections handler the request
+func (handler *CollectionHandler) ListCollections(w http.ResponseWriter, r *http.Request) {
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	results, err := handler.listExecutor.Execute(user.ID)
+	if err != nil {
+		logger.GetInstance().Error("error listing collections", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, results, http.StatusOK)
+}
+
+// GetCollection handler the request
+func (handler *CollectionHandler) GetCollection(w http.ResponseWriter, r *http.Request) {
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	collection, err := handler.getExecutor.Execute(user.ID, collectionID)
+	if err != nil {
+		logger.GetInstance().Error("error getting collection", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, collection, http.StatusOK)
+}
+
+// UpdateCollection handler the request
+func (handler *CollectionHandler) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+	var request collectionRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	collection, err := handler.updateExecutor.Execute(user.ID, collectionID, request.Name)
+	if err != nil {
+		logger.GetInstance().Error("error updating collection", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, collection, http.StatusOK)
+}
+
+// DeleteCollection handler the request
+func (handler *CollectionHandler) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	err := handler.deleteExecutor.Execute(user.ID, collectionID)
+	if err != nil {
+		logger.GetInstance().Error("error deleting collection", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListCollectionItems handler the request
+func (handler *CollectionHandler) ListCollectionItems(w http.ResponseWriter, r *http.Request) {
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	favouriteParams, err := mapToFavouriteSearchParams(r.URL.Query())
+	if err != nil {
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	results, err := handler.listItemsExecutor.Execute(collections.CollectionItemsSearchParams{
+		Page:         favouriteParams.Page,
+		PageSize:     favouriteParams.PageSize,
+		UserID:       user.ID,
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		logger.GetInstance().Error("error listing collection items", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, results, http.StatusOK)
+}
+
+// AddCollectionItem handler the request, adding a property already in the collection updates its note
+func (handler *CollectionHandler) AddCollectionItem(w http.ResponseWriter, r *http.Request) {
+	var request collectionItemRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	handler.saveItem(w, r, collectionID, request.PropertyID, request.Note)
+}
+
+// UpdateCollectionItem handler the request
+func (handler *CollectionHandler) UpdateCollectionItem(w http.ResponseWriter, r *http.Request) {
+	var request collectionItemRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	propertyID, ok := urlParamID(w, r, "propertyId")
+	if !ok {
+		return
+	}
+	handler.saveItem(w, r, collectionID, propertyID, request.Note)
+}
+
+func (handler *CollectionHandler) saveItem(w http.ResponseWriter, r *http.Request, collectionID, propertyID int64, note *string) {
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := handler.saveItemExecutor.Execute(user.ID, collectionID, propertyID, note)
+	if err != nil {
+		logger.GetInstance().Error("error saving collection item", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	if !created {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// RemoveCollectionItem handler the request
+func (handler *CollectionHandler) RemoveCollectionItem(w http.ResponseWriter, r *http.Request) {
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	propertyID, ok := urlParamID(w, r, "propertyId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	err := handler.removeItemExecutor.Execute(user.ID, collectionID, propertyID)
+	if err != nil {
+		logger.GetInstance().Error("error removing collection item", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MoveCollectionItem handler the request
+func (handler *CollectionHandler) MoveCollectionItem(w http.ResponseWriter, r *http.Request) {
+	handler.transferItem(w, r, true)
+}
+
+// CopyCollectionItem handler the request
+func (handler *CollectionHandler) CopyCollectionItem(w http.ResponseWriter, r *http.Request) {
+	handler.transferItem(w, r, false)
+}
+
+func (handler *CollectionHandler) transferItem(w http.ResponseWriter, r *http.Request, move bool) {
+	var request transferCollectionItemRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	propertyID, ok := urlParamID(w, r, "propertyId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	err = handler.transferItemExecutor.Execute(user.ID, collectionID, request.TargetCollectionID, propertyID, move)
+	if err != nil {
+		logger.GetInstance().Error("error transferring collection item", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func collectionUser(w http.ResponseWriter, r *http.Request) (*model.User, bool) {
+	user := middlewares.UserFromContext(r)
+	if user == nil {
+		err := model.NewUnauthorizedError(errors.New("user not authenticated"))
+		logger.GetInstance().Error("error getting user", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusUnauthorized)
+		return nil, false
+	}
+	return user, true
+}
+
+func urlParamID(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, name), 10, 64)
+	if err != nil {
+		logger.GetInstance().Error("error in parsing "+name, zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+func writeJSONResponse(w http.ResponseWriter, r *http.Request, value interface{}, code int) {
+	response, err := json.Marshal(value)
+	if err != nil {
+		logger.GetInstance().Error("error marshalling response", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, err = w.Write(response)
+	if err != nil {
+		logger.GetInstance().Error("error writing response", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+	}
+}
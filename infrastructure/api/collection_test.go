@@ -0,0 +1,227 @@
+package api
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type CollectionSuite struct {
+	suite.Suite
+	mockCtrl          *gomock.Controller
+	createExecutor    *mocks.MockCreateCollectionExecutor
+	listExecutor      *mocks.MockListCollectionsExecutor
+	getExecutor       *mocks.MockGetCollectionExecutor
+	updateExecutor    *mocks.MockUpdateCollectionExecutor
+	deleteExecutor    *mocks.MockDeleteCollectionExecutor
+	listItemsExecutor *mocks.MockListCollectionItemsExecutor
+	saveItemExecutor  *mocks.MockSaveCollectionItemExecutor
+	removeExecutor    *mocks.MockRemoveCollectionItemExecutor
+	transferExecutor  *mocks.MockTransferCollectionItemExecutor
+	collectionHandler *CollectionHandler
+	chiRouter         *chi.Mux
+}
+
+func TestCollectionSuite(t *testing.T) {
+	suite.Run(t, new(CollectionSuite))
+}
+
+func (suite *CollectionSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.createExecutor = mocks.NewMockCreateCollectionExecutor(suite.mockCtrl)
+	suite.listExecutor = mocks.NewMockListCollectionsExecutor(suite.mockCtrl)
+	suite.getExecutor = mocks.NewMockGetCollectionExecutor(suite.mockCtrl)
+	suite.updateExecutor = mocks.NewMockUpdateCollectionExecutor(suite.mockCtrl)
+	suite.deleteExecutor = mocks.NewMockDeleteCollectionExecutor(suite.mockCtrl)
+	suite.listItemsExecutor = mocks.NewMockListCollectionItemsExecutor(suite.mockCtrl)
+	suite.saveItemExecutor = mocks.NewMockSaveCollectionItemExecutor(suite.mockCtrl)
+	suite.removeExecutor = mocks.NewMockRemoveCollectionItemExecutor(suite.mockCtrl)
+	suite.transferExecutor = mocks.NewMockTransferCollectionItemExecutor(suite.mockCtrl)
+	suite.collectionHandler = NewCollectionHandler(suite.createExecutor, suite.listExecutor, suite.getExecutor, suite.updateExecutor,
+		suite.deleteExecutor, suite.listItemsExecutor, suite.saveItemExecutor, suite.removeExecutor, suite.transferExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Route("/v1/users/me/collections", func(r chi.Router) {
+		r.Post("/", suite.collectionHandler.CreateCollection)
+		r.Get("/", suite.collectionHandler.ListCollections)
+		r.Get("/{collectionId}", suite.collectionHandler.GetCollection)
+		r.Put("/{collectionId}", suite.collectionHandler.UpdateCollection)
+		r.Delete("/{collectionId}", suite.collectionHandler.DeleteCollection)
+		r.Get("/{collectionId}/items", suite.collectionHandler.ListCollectionItems)
+		r.Post("/{collectionId}/items", suite.collectionHandler.AddCollectionItem)
+		r.Put("/{collectionId}/items/{propertyId}", suite.collectionHandler.UpdateCollectionItem)
+		r.Delete("/{collectionId}/items/{propertyId}", suite.collectionHandler.RemoveCollectionItem)
+		r.Post("/{collectionId}/items/{propertyId}/move", suite.collectionHandler.MoveCollectionItem)
+		r.Post("/{collectionId}/items/{propertyId}/copy", suite.collectionHandler.CopyCollectionItem)
+	})
+}
+
+func (suite *CollectionSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *CollectionSuite) TestCreateCollection_Success() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/", strings.NewReader(`{"name":"Polanco options"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.createExecutor.EXPECT().Execute(int64(1), "Polanco options").Return(&model.Collection{ID: 2, Name: "Polanco options"}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusCreated, rr.Code)
+	suite.Contains(rr.Body.String(), `"name":"Polanco options"`)
+}
+
+func (suite *CollectionSuite) TestCreateCollection_BadRequest() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/", strings.NewReader(`{"name":1}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *CollectionSuite) TestCreateCollection_DuplicatedName() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/", strings.NewReader(`{"name":"Polanco options"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.createExecutor.EXPECT().Execute(int64(1), "Polanco options").
+		Return(nil, model.NewDomainError(errors.New("a collection with the same name already exists")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *CollectionSuite) TestCreateCollection_Unauthenticated() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/", strings.NewReader(`{"name":"Polanco options"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (suite *CollectionSuite) TestListCollections_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/me/collections/", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listExecutor.EXPECT().Execute(int64(1)).Return([]*model.Collection{{ID: 1, Name: model.DefaultCollectionName, IsDefault: true}}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"isDefault":true`)
+}
+
+func (suite *CollectionSuite) TestGetCollection_NotFound() {
+	req, err := http.NewRequest("GET", "/v1/users/me/collections/2", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.getExecutor.EXPECT().Execute(int64(1), int64(2)).Return(nil, model.NewEntityNotFoundError(errors.New("collection not found")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *CollectionSuite) TestUpdateCollection_Success() {
+	req, err := http.NewRequest("PUT", "/v1/users/me/collections/2", strings.NewReader(`{"name":"For parents"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(int64(1), int64(2), "For parents").Return(&model.Collection{ID: 2, Name: "For parents"}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *CollectionSuite) TestDeleteCollection_Success() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/collections/2", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.deleteExecutor.EXPECT().Execute(int64(1), int64(2)).Return(nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *CollectionSuite) TestDeleteCollection_BadRequest() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/collections/abc", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *CollectionSuite) TestListCollectionItems_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/me/collections/2/items?page=2", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listItemsExecutor.EXPECT().Execute(collections.CollectionItemsSearchParams{Page: 2, PageSize: 10, UserID: 1, CollectionID: 2}).
+		Return(&model.CollectionItemsPaging{Page: 2, PageSize: 10}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *CollectionSuite) TestAddCollectionItem_Created() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/2/items", strings.NewReader(`{"propertyId":3,"note":"close to the park"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.saveItemExecutor.EXPECT().Execute(int64(1), int64(2), int64(3), gomock.Any()).
+		DoAndReturn(func(userID, collectionID, propertyID int64, note *string) (bool, error) {
+			suite.Equal("close to the park", *note)
+			return true, nil
+		})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusCreated, rr.Code)
+}
+
+func (suite *CollectionSuite) TestUpdateCollectionItem_Existing() {
+	req, err := http.NewRequest("PUT", "/v1/users/me/collections/2/items/3", strings.NewReader(`{"note":"ask for the parking"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.saveItemExecutor.EXPECT().Execute(int64(1), int64(2), int64(3), gomock.Any()).Return(false, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *CollectionSuite) TestRemoveCollectionItem_Success() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/collections/2/items/3", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.removeExecutor.EXPECT().Execute(int64(1), int64(2), int64(3)).Return(nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *CollectionSuite) TestMoveCollectionItem_Success() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/2/items/3/move", strings.NewReader(`{"targetCollectionId":4}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.transferExecutor.EXPECT().Execute(int64(1), int64(2), int64(4), int64(3), true).Return(nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *CollectionSuite) TestCopyCollectionItem_NotFound() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/2/items/3/copy", strings.NewReader(`{"targetCollectionId":4}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.transferExecutor.EXPECT().Execute(int64(1), int64(2), int64(4), int64(3), false).
+		Return(model.NewEntityNotFoundError(errors.New("property not found in the collection")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
@@ -6,6 +6,7 @@ import (
 	"lahaus/domain/model"
 	"lahaus/logger"
 	"net/http"
+	"strconv"
 )
 
 func wrapError(w http.ResponseWriter, err error, code int) {
@@ -22,11 +23,38 @@ func wrapError(w http.ResponseWriter, err error, code int) {
 	case *model.UnauthorizedError:
 		responseWriter(w, err, http.StatusUnauthorized)
 		return
+	case *model.ForbiddenError:
+		responseWriter(w, err, http.StatusForbidden)
+		return
+	case *model.PreconditionFailedError:
+		responseWriter(w, err, http.StatusPreconditionFailed)
+		return
+	case *model.PreconditionRequiredError:
+		responseWriter(w, err, http.StatusPreconditionRequired)
+		return
+	case *model.DuplicatePropertyError:
+		responseWriter(w, err, http.StatusConflict)
+		return
+	case *model.UnprocessableEntityError:
+		responseWriter(w, err, http.StatusUnprocessableEntity)
+		return
+	case *model.UnsupportedMediaTypeError:
+		responseWriter(w, err, http.StatusUnsupportedMediaType)
+		return
+	case *model.PayloadTooLargeError:
+		responseWriter(w, err, http.StatusRequestEntityTooLarge)
+		return
+	case *model.TooManyRequestsError:
+		w.Header().Set("Retry-After", strconv.FormatInt(err.(*model.TooManyRequestsError).RetryAfter, 10))
+		responseWriter(w, err, http.StatusTooManyRequests)
+		return
 	}
 
 	switch code {
 	case http.StatusUnauthorized:
 		responseWriter(w, model.NewUnauthorizedError(err), code)
+	case http.StatusForbidden:
+		responseWriter(w, model.NewForbiddenError(err), code)
 	case http.StatusBadRequest:
 		responseWriter(w, model.NewDomainError(err), code)
 	case http.StatusInternalServerError:
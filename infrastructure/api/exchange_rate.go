@@ -0,0 +1,76 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/middlewares"
+	"lahaus/logger"
+	"net/http"
+	"strings"
+)
+
+//go:generate mockgen -destination=./mocks/mock_exchange_rate.go -package=mocks -source=./exchange_rate.go
+
+type ListExchangeRatesExecutor interface {
+	Execute() []*model.ExchangeRate
+}
+
+type UpdateExchangeRateExecutor interface {
+	Execute(rate *model.ExchangeRate, actor *model.Actor) (*model.ExchangeRate, error)
+}
+
+// ExchangeRateHandler struct
+type ExchangeRateHandler struct {
+	listExecutor   ListExchangeRatesExecutor
+	updateExecutor UpdateExchangeRateExecutor
+}
+
+// NewExchangeRateHandler creates a new ExchangeRateHandler
+func NewExchangeRateHandler(listExecutor ListExchangeRatesExecutor, updateExecutor UpdateExchangeRateExecutor) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		listExecutor:   listExecutor,
+		updateExecutor: updateExecutor,
+	}
+}
+
+type exchangeRateRequest struct {
+	Rate *float64 `json:"rate"`
+}
+
+// ListExchangeRates handler the request
+func (handler *ExchangeRateHandler) ListExchangeRates(w http.ResponseWriter, r *http.Request) {
+	writeJSONResponse(w, r, handler.listExecutor.Execute(), http.StatusOK)
+}
+
+// UpdateExchangeRate handler the request
+func (handler *ExchangeRateHandler) UpdateExchangeRate(w http.ResponseWriter, r *http.Request) {
+	var request exchangeRateRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	if request.Rate == nil {
+		err = errors.New("rate field is a must")
+		logger.GetInstance().Error("error in exchange rate", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	rate, err := handler.updateExecutor.Execute(&model.ExchangeRate{
+		Currency: model.Currency(strings.ToUpper(chi.URLParam(r, "currency"))),
+		Rate:     *request.Rate,
+	}, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error updating exchange rate", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, rate, http.StatusOK)
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ExchangeRateSuite struct {
+	suite.Suite
+	mockCtrl            *gomock.Controller
+	listExecutor        *mocks.MockListExchangeRatesExecutor
+	updateExecutor      *mocks.MockUpdateExchangeRateExecutor
+	exchangeRateHandler *ExchangeRateHandler
+	chiRouter           *chi.Mux
+}
+
+func TestExchangeRateSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateSuite))
+}
+
+func (suite *ExchangeRateSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.listExecutor = mocks.NewMockListExchangeRatesExecutor(suite.mockCtrl)
+	suite.updateExecutor = mocks.NewMockUpdateExchangeRateExecutor(suite.mockCtrl)
+	suite.exchangeRateHandler = NewExchangeRateHandler(suite.listExecutor, suite.updateExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Get("/v1/exchange-rates", suite.exchangeRateHandler.ListExchangeRates)
+	suite.chiRouter.Put("/v1/admin/exchange-rates/{currency}", suite.exchangeRateHandler.UpdateExchangeRate)
+}
+
+func (suite *ExchangeRateSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ExchangeRateSuite) TestListExchangeRates_Success() {
+	req, err := http.NewRequest("GET", "/v1/exchange-rates", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listExecutor.EXPECT().Execute().Return([]*model.ExchangeRate{{Currency: model.USD, Rate: 1}})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.JSONEq(`[{"currency":"USD","rate":1}]`, rr.Body.String())
+}
+
+func (suite *ExchangeRateSuite) TestUpdateExchangeRate_Success() {
+	req, err := http.NewRequest("PUT", "/v1/admin/exchange-rates/cop", bytes.NewBufferString(`{"rate": 4100}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(&model.ExchangeRate{Currency: model.COP, Rate: 4100}, gomock.Any()).
+		Return(&model.ExchangeRate{Currency: model.COP, Rate: 4100}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *ExchangeRateSuite) TestUpdateExchangeRate_MissingRate() {
+	req, err := http.NewRequest("PUT", "/v1/admin/exchange-rates/COP", bytes.NewBufferString(`{}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *ExchangeRateSuite) TestUpdateExchangeRate_InvalidCurrency() {
+	req, err := http.NewRequest("PUT", "/v1/admin/exchange-rates/pesos", bytes.NewBufferString(`{"rate": 4100}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, model.NewDomainError(errors.New("invalid currency [PESOS]")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *ExchangeRateSuite) TestUpdateExchangeRate_Error() {
+	req, err := http.NewRequest("PUT", "/v1/admin/exchange-rates/COP", bytes.NewBufferString(`{"rate": 4100}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("fail"))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -10,6 +10,11 @@ import (
 
 const HOUSE = "HOUSE"
 const APARTMENT = "APARTMENT"
+const BUYER = "BUYER"
+const AGENT = "AGENT"
+const SALE = "SALE"
+const RENT = "RENT"
+const SALE_AND_RENT = "SALE_AND_RENT"
 
 func mapPropertyRequestToProperty(request propertyRequest) (*model.Property, error) {
 	if request.Title == nil || len(*request.Title) == 0 {
@@ -23,8 +28,27 @@ func mapPropertyRequestToProperty(request propertyRequest) (*model.Property, err
 		return nil, errors.New("bathrooms field is a must")
 	}
 
-	if request.Pricing.SalePrice == nil {
-		return nil, errors.New("salePrice field is a must")
+	operationType, err := mapStringToOperationType(request.OperationType)
+	if err != nil {
+		return nil, err
+	}
+	currency := model.Currency(strings.ToUpper(request.Pricing.Currency))
+	if currency != "" && !currency.IsValid() {
+		return nil, fmt.Errorf("currency not recognized [%s]", currency)
+	}
+	var salePrice model.Amount
+	if operationType.Offers(model.SALE) {
+		if request.Pricing.SalePrice == nil {
+			return nil, errors.New("salePrice field is a must")
+		}
+		salePrice = *request.Pricing.SalePrice
+	}
+	var rent *model.RentPricing
+	if operationType.Offers(model.RENT) {
+		rent, err = mapRentPricingRequest(request.Pricing.Rent)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if request.Area == nil {
 		return nil, errors.New("area field is a must")
@@ -33,6 +57,18 @@ func mapPropertyRequestToProperty(request propertyRequest) (*model.Property, err
 	if err != nil {
 		return nil, err
 	}
+	amenities, err := mapStringsToAmenities(request.Amenities)
+	if err != nil {
+		return nil, err
+	}
+	condition, err := mapStringToCondition(request.Condition)
+	if err != nil {
+		return nil, err
+	}
+	orientation, err := mapStringToOrientation(request.Orientation)
+	if err != nil {
+		return nil, err
+	}
 	return &model.Property{
 		Title:       *request.Title,
 		Description: request.Description,
@@ -41,18 +77,95 @@ func mapPropertyRequestToProperty(request propertyRequest) (*model.Property, err
 			Latitude:  request.Location.Latitude,
 		},
 		Pricing: model.Pricing{
-			SalePrice:         *request.Pricing.SalePrice,
+			Currency:          currency,
+			SalePrice:         salePrice,
 			AdministrativeFee: request.Pricing.AdministrativeFee,
+			Rent:              rent,
 		},
-		PropertyType: propertyType,
-		Bedrooms:     *request.Bedrooms,
-		Bathrooms:    *request.Bathrooms,
-		ParkingSpots: request.ParkingSpots,
-		Area:         *request.Area,
-		Photos:       request.Photos,
+		PropertyType:  propertyType,
+		OperationType: operationType,
+		Bedrooms:      *request.Bedrooms,
+		Bathrooms:     *request.Bathrooms,
+		ParkingSpots:  request.ParkingSpots,
+		Area:          *request.Area,
+		Photos:        request.Photos,
+		Amenities:     amenities,
+		Floor:         request.Floor,
+		TotalFloors:   request.TotalFloors,
+		YearBuilt:     request.YearBuilt,
+		Condition:     condition,
+		Orientation:   orientation,
+	}, nil
+}
+
+// mapStringsToAmenities drops the repeated amenities, the unknown ones are an error
+func mapStringsToAmenities(values []string) ([]model.Amenity, error) {
+	var amenities []model.Amenity
+	seen := map[model.Amenity]bool{}
+	for _, value := range values {
+		amenity := model.Amenity(strings.ToUpper(value))
+		if !amenity.IsValid() {
+			return nil, fmt.Errorf("amenity not recognized [%s]", amenity)
+		}
+		if !seen[amenity] {
+			seen[amenity] = true
+			amenities = append(amenities, amenity)
+		}
+	}
+	return amenities, nil
+}
+
+// mapStringToCondition leaves the condition empty when it is not given
+func mapStringToCondition(conditionAsString string) (model.PropertyCondition, error) {
+	condition := model.PropertyCondition(strings.ToUpper(conditionAsString))
+	if condition != "" && !condition.IsValid() {
+		return "", fmt.Errorf("condition not recognized [%s]", condition)
+	}
+	return condition, nil
+}
+
+// mapStringToOrientation leaves the orientation empty when it is not given
+func mapStringToOrientation(orientationAsString string) (model.Orientation, error) {
+	orientation := model.Orientation(strings.ToUpper(orientationAsString))
+	if orientation != "" && !orientation.IsValid() {
+		return "", fmt.Errorf("orientation not recognized [%s]", orientation)
+	}
+	return orientation, nil
+}
+
+// mapRentPricingRequest requires the whole rent pricing for the properties offered for rent
+func mapRentPricingRequest(request *rentPricing) (*model.RentPricing, error) {
+	if request == nil || request.MonthlyRent == nil {
+		return nil, errors.New("monthlyRent field is a must")
+	}
+	if request.DepositMonths == nil || *request.DepositMonths < 0 {
+		return nil, errors.New("depositMonths field is a must and it can not be negative")
+	}
+	if request.MinContractMonths == nil || *request.MinContractMonths < 1 {
+		return nil, errors.New("minContractMonths field is a must and it should be at least 1")
+	}
+	return &model.RentPricing{
+		MonthlyRent:       *request.MonthlyRent,
+		DepositMonths:     *request.DepositMonths,
+		MinContractMonths: *request.MinContractMonths,
 	}, nil
 }
 
+// mapStringToOperationType lists the properties for sale when the operation type is not given
+func mapStringToOperationType(operationTypeAsString string) (model.OperationType, error) {
+	input := strings.ToUpper(operationTypeAsString)
+	switch input {
+	case "", SALE:
+		return model.SALE, nil
+	case RENT:
+		return model.RENT, nil
+	case SALE_AND_RENT:
+		return model.SALE_AND_RENT, nil
+	default:
+		return model.SALE, fmt.Errorf("operation type not recognized [%s]", input)
+	}
+}
+
 func mapStringToPropertyType(propertyTypeAsString string) (model.PropertyType, error) {
 	input := strings.ToUpper(propertyTypeAsString)
 	switch input {
@@ -70,8 +183,24 @@ func mapCreateUserRequestToUser(request createUserRequest) (*model.User, error)
 	if err != nil {
 		return nil, err
 	}
+	role, err := mapStringToRole(request.Role)
+	if err != nil {
+		return nil, err
+	}
 	return &model.User{
 		Email:    request.Email,
 		Password: request.Email,
+		Role:     role,
 	}, nil
 }
+
+// mapStringToRole only accepts the role a user gets when signing in, admins grant the AGENT role afterwards
+func mapStringToRole(roleAsString string) (model.Role, error) {
+	input := strings.ToUpper(roleAsString)
+	switch input {
+	case "", BUYER:
+		return model.BUYER, nil
+	default:
+		return model.BUYER, fmt.Errorf("role not allowed [%s]", input)
+	}
+}
@@ -26,26 +26,51 @@ func (am *AuthenticationMiddleware) Execute(next http.Handler) http.Handler {
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
-		receivedToken := value[0]
-		receivedToken = strings.ReplaceAll(receivedToken, "Bearer ", "")
-		claims := &users.UserTokenClaims{}
-		token, err := jwt.ParseWithClaims(receivedToken, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(am.config.Secret), nil
-		})
-		if err != nil {
+		ctx, ok := am.authenticate(r, value[0])
+		if !ok {
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
-		if !token.Valid {
-			w.WriteHeader(http.StatusUnauthorized)
+		next.ServeHTTP(w, r.WithContext(ctx))
+
+	})
+}
+
+// Optional lets anonymous requests through, but still rejects a token that is present and not valid
+func (am *AuthenticationMiddleware) Optional(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value, ok := r.Header["Authorization"]
+		if !ok {
+			next.ServeHTTP(w, r)
 			return
 		}
-		values := map[string]interface{}{
-			"email":  claims.Email,
-			"userId": claims.UserID,
+		ctx, ok := am.authenticate(r, value[0])
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
 		}
-		ctx := context.WithValue(r.Context(), "user", values)
 		next.ServeHTTP(w, r.WithContext(ctx))
 
 	})
 }
+
+func (am *AuthenticationMiddleware) authenticate(r *http.Request, receivedToken string) (context.Context, bool) {
+	receivedToken = strings.ReplaceAll(receivedToken, "Bearer ", "")
+	claims := &users.UserTokenClaims{}
+	token, err := jwt.ParseWithClaims(receivedToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(am.config.Secret), nil
+	})
+	if err != nil {
+		return nil, false
+	}
+	// the verification links are signed with the same secret, they carry no user and are not sessions
+	if !token.Valid || claims.UserID == 0 {
+		return nil, false
+	}
+	values := map[string]interface{}{
+		"email":  claims.Email,
+		"userId": claims.UserID,
+		"role":   claims.Role,
+	}
+	return context.WithValue(r.Context(), "user", values), true
+}
@@ -0,0 +1,67 @@
+package middlewares
+
+import (
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/suite"
+	"lahaus/config"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type AuthenticationSuite struct {
+	suite.Suite
+	config  *config.Security
+	handler http.Handler
+}
+
+func TestAuthenticationSuite(t *testing.T) {
+	suite.Run(t, new(AuthenticationSuite))
+}
+
+func (suite *AuthenticationSuite) SetupTest() {
+	suite.config = &config.Security{Secret: "secret", Issuer: "lahaus"}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(int64(1), ActorFromContext(r).UserID)
+		w.WriteHeader(http.StatusOK)
+	})
+	suite.handler = NewAuthenticationMiddleware(suite.config).Execute(next)
+}
+
+func (suite *AuthenticationSuite) serve(claims jwt.Claims) int {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(suite.config.Secret))
+	suite.NoError(err)
+	req, err := http.NewRequest("GET", "/v1/users/me/favourites", nil)
+	suite.NoError(err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	suite.handler.ServeHTTP(rr, req)
+	return rr.Code
+}
+
+func (suite *AuthenticationSuite) TestExecute_Success() {
+	suite.Equal(http.StatusOK, suite.serve(users.UserTokenClaims{
+		Email:          "nn@nn.com",
+		UserID:         1,
+		Role:           model.BUYER,
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Minute).Unix()},
+	}))
+}
+
+func (suite *AuthenticationSuite) TestExecute_VerificationToken() {
+	suite.Equal(http.StatusUnauthorized, suite.serve(jwt.MapClaims{
+		"email":   "nn@nn.com",
+		"purpose": "email_verification",
+		"exp":     time.Now().Add(time.Minute).Unix(),
+	}))
+}
+
+func (suite *AuthenticationSuite) TestExecute_Expired() {
+	suite.Equal(http.StatusUnauthorized, suite.serve(users.UserTokenClaims{
+		UserID:         1,
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+	}))
+}
@@ -0,0 +1,84 @@
+package middlewares
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"net/http"
+)
+
+type AuthorizationMiddleware struct {
+}
+
+func NewAuthorizationMiddleware() *AuthorizationMiddleware {
+	return &AuthorizationMiddleware{}
+}
+
+// Allow only lets through the requests of authenticated users having one of the given roles, it must run after the AuthenticationMiddleware
+func (am *AuthorizationMiddleware) Allow(roles ...model.Role) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role := RoleFromContext(r)
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			writeForbidden(w, model.NewForbiddenError(errors.New("the user role is not allowed to perform this operation")))
+		})
+	}
+}
+
+// UserFromContext returns the authenticated user, or nil for anonymous requests
+func UserFromContext(r *http.Request) *model.User {
+	values, ok := r.Context().Value("user").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	id, _ := values["userId"].(int64)
+	email, _ := values["email"].(string)
+	role, _ := values["role"].(model.Role)
+	return &model.User{
+		ID:    id,
+		Email: email,
+		Role:  role,
+	}
+}
+
+// ActorFromContext returns the actor of the audited mutations, the user from the JWT claims and the request id
+func ActorFromContext(r *http.Request) *model.Actor {
+	actor := &model.Actor{RequestID: middleware.GetReqID(r.Context())}
+	if user := UserFromContext(r); user != nil {
+		actor.UserID = user.ID
+		actor.Role = user.Role
+	}
+	return actor
+}
+
+// RoleFromContext returns the role of the authenticated user, or an empty role for anonymous requests
+func RoleFromContext(r *http.Request) model.Role {
+	user := UserFromContext(r)
+	if user == nil {
+		return ""
+	}
+	return user.Role
+}
+
+func writeForbidden(w http.ResponseWriter, err *model.ForbiddenError) {
+	response, errMarshal := json.Marshal(err)
+	if errMarshal != nil {
+		logger.GetInstance().Error("error marshalling forbidden error", zap.Error(errMarshal))
+		http.Error(w, errMarshal.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, errWrite := w.Write(response)
+	if errWrite != nil {
+		logger.GetInstance().Error("error writing forbidden error", zap.Error(errWrite))
+	}
+}
@@ -0,0 +1,79 @@
+package middlewares
+
+import (
+	"context"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type AuthorizationSuite struct {
+	suite.Suite
+	handler http.Handler
+}
+
+func TestAuthorizationSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationSuite))
+}
+
+func (suite *AuthorizationSuite) SetupTest() {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	suite.handler = NewAuthorizationMiddleware().Allow(model.AGENT, model.ADMIN)(next)
+}
+
+func (suite *AuthorizationSuite) serve(role interface{}) int {
+	req, err := http.NewRequest("POST", "/v1/properties", nil)
+	suite.NoError(err)
+	if role != nil {
+		ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+			"email":  "nn@nn.com",
+			"userId": int64(1),
+			"role":   role,
+		})
+		req = req.WithContext(ctx)
+	}
+	rr := httptest.NewRecorder()
+	suite.handler.ServeHTTP(rr, req)
+	return rr.Code
+}
+
+func (suite *AuthorizationSuite) TestAllow_RoleAllowed() {
+	suite.Equal(http.StatusOK, suite.serve(model.AGENT))
+	suite.Equal(http.StatusOK, suite.serve(model.ADMIN))
+}
+
+func (suite *AuthorizationSuite) TestAllow_RoleNotAllowed() {
+	suite.Equal(http.StatusForbidden, suite.serve(model.BUYER))
+}
+
+func (suite *AuthorizationSuite) TestAllow_Anonymous() {
+	suite.Equal(http.StatusForbidden, suite.serve(nil))
+}
+
+func (suite *AuthorizationSuite) TestActorFromContext() {
+	req, err := http.NewRequest("POST", "/v1/properties", nil)
+	suite.NoError(err)
+	ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "request-1")
+	ctx = context.WithValue(ctx, "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+		"role":   model.AGENT,
+	})
+
+	actor := ActorFromContext(req.WithContext(ctx))
+	suite.Equal(&model.Actor{UserID: 1, Role: model.AGENT, RequestID: "request-1"}, actor)
+}
+
+func (suite *AuthorizationSuite) TestActorFromContext_Anonymous() {
+	req, err := http.NewRequest("POST", "/v1/users/login", nil)
+	suite.NoError(err)
+	ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "request-1")
+
+	actor := ActorFromContext(req.WithContext(ctx))
+	suite.Equal(&model.Actor{RequestID: "request-1"}, actor)
+}
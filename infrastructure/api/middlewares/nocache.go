@@ -0,0 +1,26 @@
+package middlewares
+
+import (
+	"net/http"
+	"time"
+)
+
+var noCacheHeaders = map[string]string{
+	"Expires":         time.Unix(0, 0).UTC().Format(http.TimeFormat),
+	"Cache-Control":   "no-cache, no-store, no-transform, must-revalidate, private, max-age=0",
+	"Pragma":          "no-cache",
+	"X-Accel-Expires": "0",
+}
+
+// NoCache sets the same headers as the chi NoCache middleware but keeps the If-Match header of the request
+func NoCache(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, header := range []string{"If-Modified-Since", "If-None-Match", "If-Range", "If-Unmodified-Since"} {
+			r.Header.Del(header)
+		}
+		for key, value := range noCacheHeaders {
+			w.Header().Set(key, value)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type NoCacheSuite struct {
+	suite.Suite
+}
+
+func TestNoCacheSuite(t *testing.T) {
+	suite.Run(t, new(NoCacheSuite))
+}
+
+func (suite *NoCacheSuite) TestNoCache_KeepsIfMatch() {
+	var ifMatch, ifNoneMatch string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch = r.Header.Get("If-Match")
+		ifNoneMatch = r.Header.Get("If-None-Match")
+		w.WriteHeader(http.StatusOK)
+	})
+	req, err := http.NewRequest("PUT", "/v1/properties/1", nil)
+	suite.NoError(err)
+	req.Header.Set("If-Match", `"3"`)
+	req.Header.Set("If-None-Match", `"2"`)
+	rr := httptest.NewRecorder()
+
+	NoCache(next).ServeHTTP(rr, req)
+
+	suite.Equal(`"3"`, ifMatch)
+	suite.Empty(ifNoneMatch)
+	suite.Equal("no-cache", rr.Header().Get("Pragma"))
+	suite.Contains(rr.Header().Get("Cache-Control"), "no-store")
+}
@@ -35,18 +35,18 @@ func (m *MockPropertyExecutor) EXPECT() *MockPropertyExecutorMockRecorder {
 }
 
 // Execute mocks base method
-func (m *MockPropertyExecutor) Execute(property *model.Property) (*model.Property, error) {
+func (m *MockPropertyExecutor) Execute(property *model.Property, actor *model.Actor) (*model.Property, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", property)
+	ret := m.ctrl.Call(m, "Execute", property, actor)
 	ret0, _ := ret[0].(*model.Property)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockPropertyExecutorMockRecorder) Execute(property interface{}) *gomock.Call {
+func (mr *MockPropertyExecutorMockRecorder) Execute(property, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPropertyExecutor)(nil).Execute), property)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPropertyExecutor)(nil).Execute), property, actor)
 }
 
 // MockSearchPropertyExecutor is a mock of SearchPropertyExecutor interface
@@ -86,3 +86,194 @@ func (mr *MockSearchPropertyExecutorMockRecorder) Execute(search interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchPropertyExecutor)(nil).Execute), search)
 }
+
+// MockEvaluatePropertyExecutor is a mock of EvaluatePropertyExecutor interface
+type MockEvaluatePropertyExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluatePropertyExecutorMockRecorder
+}
+
+// MockEvaluatePropertyExecutorMockRecorder is the mock recorder for MockEvaluatePropertyExecutor
+type MockEvaluatePropertyExecutorMockRecorder struct {
+	mock *MockEvaluatePropertyExecutor
+}
+
+// NewMockEvaluatePropertyExecutor creates a new mock instance
+func NewMockEvaluatePropertyExecutor(ctrl *gomock.Controller) *MockEvaluatePropertyExecutor {
+	mock := &MockEvaluatePropertyExecutor{ctrl: ctrl}
+	mock.recorder = &MockEvaluatePropertyExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEvaluatePropertyExecutor) EXPECT() *MockEvaluatePropertyExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockEvaluatePropertyExecutor) Execute(propertyID int64, actor *model.Actor) (*model.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", propertyID, actor)
+	ret0, _ := ret[0].(*model.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockEvaluatePropertyExecutorMockRecorder) Execute(propertyID, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEvaluatePropertyExecutor)(nil).Execute), propertyID, actor)
+}
+
+// MockGetPropertyExecutor is a mock of GetPropertyExecutor interface
+type MockGetPropertyExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPropertyExecutorMockRecorder
+}
+
+// MockGetPropertyExecutorMockRecorder is the mock recorder for MockGetPropertyExecutor
+type MockGetPropertyExecutorMockRecorder struct {
+	mock *MockGetPropertyExecutor
+}
+
+// NewMockGetPropertyExecutor creates a new mock instance
+func NewMockGetPropertyExecutor(ctrl *gomock.Controller) *MockGetPropertyExecutor {
+	mock := &MockGetPropertyExecutor{ctrl: ctrl}
+	mock.recorder = &MockGetPropertyExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetPropertyExecutor) EXPECT() *MockGetPropertyExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetPropertyExecutor) Execute(propertyID int64, user *model.User) (*model.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", propertyID, user)
+	ret0, _ := ret[0].(*model.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetPropertyExecutorMockRecorder) Execute(propertyID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetPropertyExecutor)(nil).Execute), propertyID, user)
+}
+
+// MockIdempotentRequestExecutor is a mock of IdempotentRequestExecutor interface
+type MockIdempotentRequestExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotentRequestExecutorMockRecorder
+}
+
+// MockIdempotentRequestExecutorMockRecorder is the mock recorder for MockIdempotentRequestExecutor
+type MockIdempotentRequestExecutorMockRecorder struct {
+	mock *MockIdempotentRequestExecutor
+}
+
+// NewMockIdempotentRequestExecutor creates a new mock instance
+func NewMockIdempotentRequestExecutor(ctrl *gomock.Controller) *MockIdempotentRequestExecutor {
+	mock := &MockIdempotentRequestExecutor{ctrl: ctrl}
+	mock.recorder = &MockIdempotentRequestExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIdempotentRequestExecutor) EXPECT() *MockIdempotentRequestExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockIdempotentRequestExecutor) Execute(request *model.IdempotentRequest, handler func() *model.StoredResponse) (*model.StoredResponse, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", request, handler)
+	ret0, _ := ret[0].(*model.StoredResponse)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockIdempotentRequestExecutorMockRecorder) Execute(request, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIdempotentRequestExecutor)(nil).Execute), request, handler)
+}
+
+// MockRenewPropertyExecutor is a mock of RenewPropertyExecutor interface
+type MockRenewPropertyExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockRenewPropertyExecutorMockRecorder
+}
+
+// MockRenewPropertyExecutorMockRecorder is the mock recorder for MockRenewPropertyExecutor
+type MockRenewPropertyExecutorMockRecorder struct {
+	mock *MockRenewPropertyExecutor
+}
+
+// NewMockRenewPropertyExecutor creates a new mock instance
+func NewMockRenewPropertyExecutor(ctrl *gomock.Controller) *MockRenewPropertyExecutor {
+	mock := &MockRenewPropertyExecutor{ctrl: ctrl}
+	mock.recorder = &MockRenewPropertyExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRenewPropertyExecutor) EXPECT() *MockRenewPropertyExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRenewPropertyExecutor) Execute(propertyID int64, actor *model.Actor) (*model.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", propertyID, actor)
+	ret0, _ := ret[0].(*model.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRenewPropertyExecutorMockRecorder) Execute(propertyID, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRenewPropertyExecutor)(nil).Execute), propertyID, actor)
+}
+
+// MockPriceHistoryExecutor is a mock of PriceHistoryExecutor interface
+type MockPriceHistoryExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceHistoryExecutorMockRecorder
+}
+
+// MockPriceHistoryExecutorMockRecorder is the mock recorder for MockPriceHistoryExecutor
+type MockPriceHistoryExecutorMockRecorder struct {
+	mock *MockPriceHistoryExecutor
+}
+
+// NewMockPriceHistoryExecutor creates a new mock instance
+func NewMockPriceHistoryExecutor(ctrl *gomock.Controller) *MockPriceHistoryExecutor {
+	mock := &MockPriceHistoryExecutor{ctrl: ctrl}
+	mock.recorder = &MockPriceHistoryExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPriceHistoryExecutor) EXPECT() *MockPriceHistoryExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockPriceHistoryExecutor) Execute(propertyID int64, user *model.User) ([]*model.PriceHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", propertyID, user)
+	ret0, _ := ret[0].([]*model.PriceHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockPriceHistoryExecutorMockRecorder) Execute(propertyID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPriceHistoryExecutor)(nil).Execute), propertyID, user)
+}
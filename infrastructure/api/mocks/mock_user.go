@@ -35,17 +35,17 @@ func (m *MockSignInUserExecutor) EXPECT() *MockSignInUserExecutorMockRecorder {
 }
 
 // Execute mocks base method
-func (m *MockSignInUserExecutor) Execute(user *model.User) error {
+func (m *MockSignInUserExecutor) Execute(user *model.User, actor *model.Actor) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", user)
+	ret := m.ctrl.Call(m, "Execute", user, actor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSignInUserExecutorMockRecorder) Execute(user interface{}) *gomock.Call {
+func (mr *MockSignInUserExecutorMockRecorder) Execute(user, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSignInUserExecutor)(nil).Execute), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSignInUserExecutor)(nil).Execute), user, actor)
 }
 
 // MockSignUpUserExecutor is a mock of SignUpUserExecutor interface
@@ -72,18 +72,18 @@ func (m *MockSignUpUserExecutor) EXPECT() *MockSignUpUserExecutorMockRecorder {
 }
 
 // Execute mocks base method
-func (m *MockSignUpUserExecutor) Execute(email, password string) (string, error) {
+func (m *MockSignUpUserExecutor) Execute(email, password, ip string, actor *model.Actor) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", email, password)
+	ret := m.ctrl.Call(m, "Execute", email, password, ip, actor)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSignUpUserExecutorMockRecorder) Execute(email, password interface{}) *gomock.Call {
+func (mr *MockSignUpUserExecutorMockRecorder) Execute(email, password, ip, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSignUpUserExecutor)(nil).Execute), email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSignUpUserExecutor)(nil).Execute), email, password, ip, actor)
 }
 
 // MockAddFavouriteExecutor is a mock of AddFavouriteExecutor interface
@@ -110,17 +110,93 @@ func (m *MockAddFavouriteExecutor) EXPECT() *MockAddFavouriteExecutorMockRecorde
 }
 
 // Execute mocks base method
-func (m *MockAddFavouriteExecutor) Execute(userID, property int64) error {
+func (m *MockAddFavouriteExecutor) Execute(actor *model.Actor, property int64) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", userID, property)
+	ret := m.ctrl.Call(m, "Execute", actor, property)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockAddFavouriteExecutorMockRecorder) Execute(actor, property interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAddFavouriteExecutor)(nil).Execute), actor, property)
+}
+
+// MockRemoveFavouriteExecutor is a mock of RemoveFavouriteExecutor interface
+type MockRemoveFavouriteExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveFavouriteExecutorMockRecorder
+}
+
+// MockRemoveFavouriteExecutorMockRecorder is the mock recorder for MockRemoveFavouriteExecutor
+type MockRemoveFavouriteExecutorMockRecorder struct {
+	mock *MockRemoveFavouriteExecutor
+}
+
+// NewMockRemoveFavouriteExecutor creates a new mock instance
+func NewMockRemoveFavouriteExecutor(ctrl *gomock.Controller) *MockRemoveFavouriteExecutor {
+	mock := &MockRemoveFavouriteExecutor{ctrl: ctrl}
+	mock.recorder = &MockRemoveFavouriteExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRemoveFavouriteExecutor) EXPECT() *MockRemoveFavouriteExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRemoveFavouriteExecutor) Execute(actor *model.Actor, property int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", actor, property)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockAddFavouriteExecutorMockRecorder) Execute(userID, property interface{}) *gomock.Call {
+func (mr *MockRemoveFavouriteExecutorMockRecorder) Execute(actor, property interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveFavouriteExecutor)(nil).Execute), actor, property)
+}
+
+// MockFavouriteIDsExecutor is a mock of FavouriteIDsExecutor interface
+type MockFavouriteIDsExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockFavouriteIDsExecutorMockRecorder
+}
+
+// MockFavouriteIDsExecutorMockRecorder is the mock recorder for MockFavouriteIDsExecutor
+type MockFavouriteIDsExecutorMockRecorder struct {
+	mock *MockFavouriteIDsExecutor
+}
+
+// NewMockFavouriteIDsExecutor creates a new mock instance
+func NewMockFavouriteIDsExecutor(ctrl *gomock.Controller) *MockFavouriteIDsExecutor {
+	mock := &MockFavouriteIDsExecutor{ctrl: ctrl}
+	mock.recorder = &MockFavouriteIDsExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFavouriteIDsExecutor) EXPECT() *MockFavouriteIDsExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockFavouriteIDsExecutor) Execute(userID int64, propertyIDs []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", userID, propertyIDs)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockFavouriteIDsExecutorMockRecorder) Execute(userID, propertyIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAddFavouriteExecutor)(nil).Execute), userID, property)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFavouriteIDsExecutor)(nil).Execute), userID, propertyIDs)
 }
 
 // MockListFavouritesExecutor is a mock of ListFavouritesExecutor interface
@@ -147,10 +223,10 @@ func (m *MockListFavouritesExecutor) EXPECT() *MockListFavouritesExecutorMockRec
 }
 
 // Execute mocks base method
-func (m *MockListFavouritesExecutor) Execute(search users.FavouritesSearchParams) (*model.PropertiesPaging, error) {
+func (m *MockListFavouritesExecutor) Execute(search users.FavouritesSearchParams) (*model.FavouritesPaging, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", search)
-	ret0, _ := ret[0].(*model.PropertiesPaging)
+	ret0, _ := ret[0].(*model.FavouritesPaging)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -160,3 +236,151 @@ func (mr *MockListFavouritesExecutorMockRecorder) Execute(search interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListFavouritesExecutor)(nil).Execute), search)
 }
+
+// MockForgotPasswordExecutor is a mock of ForgotPasswordExecutor interface
+type MockForgotPasswordExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockForgotPasswordExecutorMockRecorder
+}
+
+// MockForgotPasswordExecutorMockRecorder is the mock recorder for MockForgotPasswordExecutor
+type MockForgotPasswordExecutorMockRecorder struct {
+	mock *MockForgotPasswordExecutor
+}
+
+// NewMockForgotPasswordExecutor creates a new mock instance
+func NewMockForgotPasswordExecutor(ctrl *gomock.Controller) *MockForgotPasswordExecutor {
+	mock := &MockForgotPasswordExecutor{ctrl: ctrl}
+	mock.recorder = &MockForgotPasswordExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockForgotPasswordExecutor) EXPECT() *MockForgotPasswordExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockForgotPasswordExecutor) Execute(email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockForgotPasswordExecutorMockRecorder) Execute(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockForgotPasswordExecutor)(nil).Execute), email)
+}
+
+// MockResetPasswordExecutor is a mock of ResetPasswordExecutor interface
+type MockResetPasswordExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockResetPasswordExecutorMockRecorder
+}
+
+// MockResetPasswordExecutorMockRecorder is the mock recorder for MockResetPasswordExecutor
+type MockResetPasswordExecutorMockRecorder struct {
+	mock *MockResetPasswordExecutor
+}
+
+// NewMockResetPasswordExecutor creates a new mock instance
+func NewMockResetPasswordExecutor(ctrl *gomock.Controller) *MockResetPasswordExecutor {
+	mock := &MockResetPasswordExecutor{ctrl: ctrl}
+	mock.recorder = &MockResetPasswordExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockResetPasswordExecutor) EXPECT() *MockResetPasswordExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockResetPasswordExecutor) Execute(token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockResetPasswordExecutorMockRecorder) Execute(token, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResetPasswordExecutor)(nil).Execute), token, password)
+}
+
+// MockVerifyEmailExecutor is a mock of VerifyEmailExecutor interface
+type MockVerifyEmailExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyEmailExecutorMockRecorder
+}
+
+// MockVerifyEmailExecutorMockRecorder is the mock recorder for MockVerifyEmailExecutor
+type MockVerifyEmailExecutorMockRecorder struct {
+	mock *MockVerifyEmailExecutor
+}
+
+// NewMockVerifyEmailExecutor creates a new mock instance
+func NewMockVerifyEmailExecutor(ctrl *gomock.Controller) *MockVerifyEmailExecutor {
+	mock := &MockVerifyEmailExecutor{ctrl: ctrl}
+	mock.recorder = &MockVerifyEmailExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVerifyEmailExecutor) EXPECT() *MockVerifyEmailExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockVerifyEmailExecutor) Execute(token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockVerifyEmailExecutorMockRecorder) Execute(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockVerifyEmailExecutor)(nil).Execute), token)
+}
+
+// MockResendVerificationExecutor is a mock of ResendVerificationExecutor interface
+type MockResendVerificationExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockResendVerificationExecutorMockRecorder
+}
+
+// MockResendVerificationExecutorMockRecorder is the mock recorder for MockResendVerificationExecutor
+type MockResendVerificationExecutorMockRecorder struct {
+	mock *MockResendVerificationExecutor
+}
+
+// NewMockResendVerificationExecutor creates a new mock instance
+func NewMockResendVerificationExecutor(ctrl *gomock.Controller) *MockResendVerificationExecutor {
+	mock := &MockResendVerificationExecutor{ctrl: ctrl}
+	mock.recorder = &MockResendVerificationExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockResendVerificationExecutor) EXPECT() *MockResendVerificationExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockResendVerificationExecutor) Execute(email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockResendVerificationExecutorMockRecorder) Execute(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResendVerificationExecutor)(nil).Execute), email)
+}
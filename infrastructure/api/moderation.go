@@ -0,0 +1,134 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/moderation"
+	"lahaus/infrastructure/api/middlewares"
+	"lahaus/logger"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+//go:generate mockgen -destination=./mocks/mock_moderation.go -package=mocks -source=./moderation.go
+
+type ListModerationQueueExecutor interface {
+	Execute(search moderation.ModerationSearchParams) (*model.PropertiesPaging, error)
+}
+
+type ReviewPropertyExecutor interface {
+	Execute(review *model.PropertyReview, actor *model.Actor) (*model.Property, error)
+}
+
+type reviewRequest struct {
+	Reason *string `json:"reason"`
+}
+
+// ModerationHandler struct
+type ModerationHandler struct {
+	listExecutor   ListModerationQueueExecutor
+	reviewExecutor ReviewPropertyExecutor
+}
+
+// NewModerationHandler creates a new ModerationHandler
+func NewModerationHandler(listExecutor ListModerationQueueExecutor, reviewExecutor ReviewPropertyExecutor) *ModerationHandler {
+	return &ModerationHandler{
+		listExecutor:   listExecutor,
+		reviewExecutor: reviewExecutor,
+	}
+}
+
+// ListModerationQueue handler the request
+func (handler *ModerationHandler) ListModerationQueue(w http.ResponseWriter, r *http.Request) {
+	searchParams, err := mapToModerationSearchParams(r.URL.Query())
+	if err != nil {
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	results, err := handler.listExecutor.Execute(searchParams)
+	if err != nil {
+		logger.GetInstance().Error("error listing moderation queue", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, results, http.StatusOK)
+}
+
+// ApproveProperty handler the request
+func (handler *ModerationHandler) ApproveProperty(w http.ResponseWriter, r *http.Request) {
+	handler.review(w, r, model.APPROVE)
+}
+
+// RejectProperty handler the request
+func (handler *ModerationHandler) RejectProperty(w http.ResponseWriter, r *http.Request) {
+	handler.review(w, r, model.REJECT)
+}
+
+func (handler *ModerationHandler) review(w http.ResponseWriter, r *http.Request, decision model.ReviewDecision) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request reviewRequest
+	if r.ContentLength != 0 {
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+			wrapError(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+
+	review := &model.PropertyReview{
+		PropertyID: id,
+		Decision:   decision,
+		Reason:     request.Reason,
+	}
+	property, err := handler.reviewExecutor.Execute(review, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error reviewing property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, property, http.StatusOK)
+}
+
+func mapToModerationSearchParams(query url.Values) (moderation.ModerationSearchParams, error) {
+	searchParams := moderation.ModerationSearchParams{
+		Page:     1,
+		PageSize: 10,
+	}
+
+	if page := query.Get("page"); page != "" {
+		pageValue, err := strconv.ParseInt(page, 10, 64)
+		if err != nil {
+			return searchParams, err
+		}
+		if pageValue < 1 {
+			return searchParams, errors.New("page must be greater than 0")
+		}
+		searchParams.Page = pageValue
+	}
+
+	if pageSize := query.Get("pageSize"); pageSize != "" {
+		pageSizeValue, err := strconv.ParseInt(pageSize, 10, 64)
+		if err != nil {
+			return searchParams, err
+		}
+		if pageSizeValue < 10 || pageSizeValue > 50 {
+			return searchParams, errors.New("pageSize must be between 10 and 50")
+		}
+		searchParams.PageSize = pageSizeValue
+	}
+
+	return searchParams, nil
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/moderation"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ModerationSuite struct {
+	suite.Suite
+	mockCtrl          *gomock.Controller
+	listExecutor      *mocks.MockListModerationQueueExecutor
+	reviewExecutor    *mocks.MockReviewPropertyExecutor
+	moderationHandler *ModerationHandler
+	chiRouter         *chi.Mux
+}
+
+func TestModerationSuite(t *testing.T) {
+	suite.Run(t, new(ModerationSuite))
+}
+
+func (suite *ModerationSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.listExecutor = mocks.NewMockListModerationQueueExecutor(suite.mockCtrl)
+	suite.reviewExecutor = mocks.NewMockReviewPropertyExecutor(suite.mockCtrl)
+	suite.moderationHandler = NewModerationHandler(suite.listExecutor, suite.reviewExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Get("/v1/admin/moderation", suite.moderationHandler.ListModerationQueue)
+	suite.chiRouter.Post("/v1/admin/moderation/{id}/approve", suite.moderationHandler.ApproveProperty)
+	suite.chiRouter.Post("/v1/admin/moderation/{id}/reject", suite.moderationHandler.RejectProperty)
+}
+
+func (suite *ModerationSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ModerationSuite) TestListModerationQueue_Success() {
+	req, err := http.NewRequest("GET", "/v1/admin/moderation?page=2&pageSize=20", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listExecutor.EXPECT().Execute(moderation.ModerationSearchParams{Page: 2, PageSize: 20}).
+		Return(&model.PropertiesPaging{Page: 2, PageSize: 20, Data: []*model.Property{}}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *ModerationSuite) TestListModerationQueue_InvalidPageSize() {
+	req, err := http.NewRequest("GET", "/v1/admin/moderation?pageSize=5", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *ModerationSuite) TestApproveProperty_Success() {
+	req, err := http.NewRequest("POST", "/v1/admin/moderation/4/approve", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.reviewExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(review *model.PropertyReview, actor *model.Actor) (*model.Property, error) {
+			suite.Equal(int64(4), review.PropertyID)
+			suite.Equal(model.APPROVE, review.Decision)
+			suite.Nil(review.Reason)
+			suite.Equal(int64(1), actor.UserID)
+			return &model.Property{ID: 4, Status: model.ACTIVE}, nil
+		})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"status":"ACTIVE"`)
+}
+
+func (suite *ModerationSuite) TestRejectProperty_Success() {
+	req, err := http.NewRequest("POST", "/v1/admin/moderation/4/reject", bytes.NewBufferString(`{"reason": "fotos de otra propiedad"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.reviewExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(review *model.PropertyReview, actor *model.Actor) (*model.Property, error) {
+			suite.Equal(model.REJECT, review.Decision)
+			suite.Equal("fotos de otra propiedad", *review.Reason)
+			return &model.Property{ID: 4, Status: model.REJECTED}, nil
+		})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *ModerationSuite) TestRejectProperty_MissingReason() {
+	req, err := http.NewRequest("POST", "/v1/admin/moderation/4/reject", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.reviewExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, model.NewDomainError(errors.New("the rejection needs a reason")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *ModerationSuite) TestRejectProperty_InvalidBody() {
+	req, err := http.NewRequest("POST", "/v1/admin/moderation/4/reject", bytes.NewBufferString(`{"reason": `))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *ModerationSuite) TestApproveProperty_InvalidID() {
+	req, err := http.NewRequest("POST", "/v1/admin/moderation/abc/approve", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *ModerationSuite) TestApproveProperty_NotFound() {
+	req, err := http.NewRequest("POST", "/v1/admin/moderation/4/approve", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.reviewExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, model.NewEntityNotFoundError(errors.New("property not found")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,203 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/middlewares"
+	"lahaus/logger"
+	"net/http"
+)
+
+//go:generate mockgen -destination=./mocks/mock_photo.go -package=mocks -source=./photo.go
+
+// photoField is the multipart field of the uploaded file
+const photoField = "photo"
+
+type UploadPhotoExecutor interface {
+	Execute(propertyID int64, data []byte, actor *model.Actor) (*model.Photo, error)
+}
+
+type ListPhotosExecutor interface {
+	Execute(propertyID int64, user *model.User) ([]*model.Photo, error)
+}
+
+type ReorderPhotosExecutor interface {
+	Execute(propertyID int64, photoIDs []int64, actor *model.Actor) ([]*model.Photo, error)
+}
+
+type SetCoverPhotoExecutor interface {
+	Execute(propertyID int64, photoID int64, actor *model.Actor) ([]*model.Photo, error)
+}
+
+type DeletePhotoExecutor interface {
+	Execute(propertyID int64, photoID int64, actor *model.Actor) error
+}
+
+type reorderPhotosRequest struct {
+	PhotoIDs []int64 `json:"photoIds"`
+}
+
+// PhotoHandler struct
+type PhotoHandler struct {
+	maxSizeInBytes  int64
+	uploadExecutor  UploadPhotoExecutor
+	listExecutor    ListPhotosExecutor
+	reorderExecutor ReorderPhotosExecutor
+	coverExecutor   SetCoverPhotoExecutor
+	deleteExecutor  DeletePhotoExecutor
+}
+
+// NewPhotoHandler creates a new PhotoHandler, the uploads are read up to maxSizeInBytes
+func NewPhotoHandler(maxSizeInBytes int64, uploadExecutor UploadPhotoExecutor, listExecutor ListPhotosExecutor, reorderExecutor ReorderPhotosExecutor,
+	coverExecutor SetCoverPhotoExecutor, deleteExecutor DeletePhotoExecutor) *PhotoHandler {
+	return &PhotoHandler{
+		maxSizeInBytes:  maxSizeInBytes,
+		uploadExecutor:  uploadExecutor,
+		listExecutor:    listExecutor,
+		reorderExecutor: reorderExecutor,
+		coverExecutor:   coverExecutor,
+		deleteExecutor:  deleteExecutor,
+	}
+}
+
+// UploadPhoto handler the request, a multipart form with the file in the photo field
+func (handler *PhotoHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	data, err := handler.readPhoto(r)
+	if err != nil {
+		logger.GetInstance().Error("error reading photo", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	photo, err := handler.uploadExecutor.Execute(id, data, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error uploading photo", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, photo, http.StatusCreated)
+}
+
+// ListPhotos handler the request
+func (handler *PhotoHandler) ListPhotos(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	photos, err := handler.listExecutor.Execute(id, middlewares.UserFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error listing photos", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, photos, http.StatusOK)
+}
+
+// ReorderPhotos handler the request
+func (handler *PhotoHandler) ReorderPhotos(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request reorderPhotosRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	photos, err := handler.reorderExecutor.Execute(id, request.PhotoIDs, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error reordering photos", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, photos, http.StatusOK)
+}
+
+// SetCoverPhoto handler the request
+func (handler *PhotoHandler) SetCoverPhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+	photoID, ok := urlParamID(w, r, "photoId")
+	if !ok {
+		return
+	}
+
+	photos, err := handler.coverExecutor.Execute(id, photoID, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error setting cover photo", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, photos, http.StatusOK)
+}
+
+// DeletePhoto handler the request
+func (handler *PhotoHandler) DeletePhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+	photoID, ok := urlParamID(w, r, "photoId")
+	if !ok {
+		return
+	}
+
+	err := handler.deleteExecutor.Execute(id, photoID, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error deleting photo", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readPhoto streams the multipart form until the photo field and reads it up to the max size
+func (handler *PhotoHandler) readPhoto(r *http.Request) ([]byte, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, errors.New("the photo field is required")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() != photoField {
+			continue
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(part, handler.maxSizeInBytes+1))
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(data)) > handler.maxSizeInBytes {
+			return nil, model.NewPayloadTooLargeError(fmt.Errorf("the photo exceeds %d bytes", handler.maxSizeInBytes))
+		}
+		return data, nil
+	}
+}
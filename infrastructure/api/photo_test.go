@@ -0,0 +1,177 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/mocks"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type PhotoSuite struct {
+	suite.Suite
+	mockCtrl        *gomock.Controller
+	uploadExecutor  *mocks.MockUploadPhotoExecutor
+	listExecutor    *mocks.MockListPhotosExecutor
+	reorderExecutor *mocks.MockReorderPhotosExecutor
+	coverExecutor   *mocks.MockSetCoverPhotoExecutor
+	deleteExecutor  *mocks.MockDeletePhotoExecutor
+	photoHandler    *PhotoHandler
+	chiRouter       *chi.Mux
+}
+
+func TestPhotoSuite(t *testing.T) {
+	suite.Run(t, new(PhotoSuite))
+}
+
+func (suite *PhotoSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.uploadExecutor = mocks.NewMockUploadPhotoExecutor(suite.mockCtrl)
+	suite.listExecutor = mocks.NewMockListPhotosExecutor(suite.mockCtrl)
+	suite.reorderExecutor = mocks.NewMockReorderPhotosExecutor(suite.mockCtrl)
+	suite.coverExecutor = mocks.NewMockSetCoverPhotoExecutor(suite.mockCtrl)
+	suite.deleteExecutor = mocks.NewMockDeletePhotoExecutor(suite.mockCtrl)
+	suite.photoHandler = NewPhotoHandler(8, suite.uploadExecutor, suite.listExecutor, suite.reorderExecutor, suite.coverExecutor, suite.deleteExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Get("/v1/properties/{id}/photos", suite.photoHandler.ListPhotos)
+	suite.chiRouter.Post("/v1/properties/{id}/photos", suite.photoHandler.UploadPhoto)
+	suite.chiRouter.Put("/v1/properties/{id}/photos/order", suite.photoHandler.ReorderPhotos)
+	suite.chiRouter.Put("/v1/properties/{id}/photos/{photoId}/cover", suite.photoHandler.SetCoverPhoto)
+	suite.chiRouter.Delete("/v1/properties/{id}/photos/{photoId}", suite.photoHandler.DeletePhoto)
+}
+
+func (suite *PhotoSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *PhotoSuite) uploadRequest(field string, content string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	suite.NoError(writer.WriteField("description", "fachada"))
+	part, err := writer.CreateFormFile(field, "fachada.jpg")
+	suite.NoError(err)
+	_, err = part.Write([]byte(content))
+	suite.NoError(err)
+	suite.NoError(writer.Close())
+
+	req, err := http.NewRequest("POST", "/v1/properties/4/photos", &body)
+	suite.NoError(err)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return withUser(req, model.AGENT)
+}
+
+func (suite *PhotoSuite) TestUploadPhoto_Success() {
+	rr := httptest.NewRecorder()
+
+	suite.uploadExecutor.EXPECT().Execute(int64(4), []byte("\xff\xd8\xffjpeg"), gomock.Any()).
+		DoAndReturn(func(propertyID int64, data []byte, actor *model.Actor) (*model.Photo, error) {
+			suite.Equal(int64(1), actor.UserID)
+			return &model.Photo{ID: 2, PropertyID: 4, Cover: true, Keys: []string{"properties/4/a/web.jpg"}}, nil
+		})
+	suite.chiRouter.ServeHTTP(rr, suite.uploadRequest("photo", "\xff\xd8\xffjpeg"))
+	suite.Equal(http.StatusCreated, rr.Code)
+	suite.Contains(rr.Body.String(), `"cover":true`)
+	suite.NotContains(rr.Body.String(), "properties/4/a/web.jpg")
+}
+
+func (suite *PhotoSuite) TestUploadPhoto_TooLarge() {
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, suite.uploadRequest("photo", "\xff\xd8\xffjpeg too large"))
+	suite.Equal(http.StatusRequestEntityTooLarge, rr.Code)
+}
+
+func (suite *PhotoSuite) TestUploadPhoto_MissingField() {
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, suite.uploadRequest("file", "\xff\xd8\xffjpeg"))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PhotoSuite) TestUploadPhoto_NotMultipart() {
+	req, err := http.NewRequest("POST", "/v1/properties/4/photos", strings.NewReader(`{"photo": "a.jpg"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PhotoSuite) TestUploadPhoto_UnsupportedMediaType() {
+	rr := httptest.NewRecorder()
+
+	suite.uploadExecutor.EXPECT().Execute(int64(4), gomock.Any(), gomock.Any()).
+		Return(nil, model.NewUnsupportedMediaTypeError(errors.New("the photo must be a JPEG or PNG image")))
+	suite.chiRouter.ServeHTTP(rr, suite.uploadRequest("photo", "GIF89a"))
+	suite.Equal(http.StatusUnsupportedMediaType, rr.Code)
+}
+
+func (suite *PhotoSuite) TestListPhotos_Success() {
+	req, err := http.NewRequest("GET", "/v1/properties/4/photos", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listExecutor.EXPECT().Execute(int64(4), nil).Return([]*model.Photo{{ID: 2, Cover: true}, {ID: 3}}, nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"id":3`)
+}
+
+func (suite *PhotoSuite) TestReorderPhotos_Success() {
+	req, err := http.NewRequest("PUT", "/v1/properties/4/photos/order", strings.NewReader(`{"photoIds": [3, 2]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.reorderExecutor.EXPECT().Execute(int64(4), []int64{3, 2}, gomock.Any()).Return([]*model.Photo{{ID: 3}, {ID: 2}}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PhotoSuite) TestReorderPhotos_InvalidBody() {
+	req, err := http.NewRequest("PUT", "/v1/properties/4/photos/order", strings.NewReader(`{"photoIds": "3,2"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PhotoSuite) TestSetCoverPhoto_NotFound() {
+	req, err := http.NewRequest("PUT", "/v1/properties/4/photos/9/cover", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.coverExecutor.EXPECT().Execute(int64(4), int64(9), gomock.Any()).Return(nil, model.NewEntityNotFoundError(errors.New("photo not found")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *PhotoSuite) TestDeletePhoto_Success() {
+	req, err := http.NewRequest("DELETE", "/v1/properties/4/photos/2", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.deleteExecutor.EXPECT().Execute(int64(4), int64(2), gomock.Any()).Return(nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *PhotoSuite) TestDeletePhoto_Forbidden() {
+	req, err := http.NewRequest("DELETE", "/v1/properties/4/photos/2", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.deleteExecutor.EXPECT().Execute(int64(4), int64(2), gomock.Any()).Return(model.NewForbiddenError(errors.New("the property belongs to another user")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusForbidden, rr.Code)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"net/http"
+)
+
+//go:generate mockgen -destination=./mocks/mock_price_drop.go -package=mocks -source=./price_drop.go
+
+type GetPriceDropSettingsExecutor interface {
+	Execute(userID int64) (*model.PriceDropSettings, error)
+}
+
+type UpdatePriceDropSettingsExecutor interface {
+	Execute(settings *model.PriceDropSettings) (*model.PriceDropSettings, error)
+}
+
+// PriceDropHandler struct
+type PriceDropHandler struct {
+	getSettingsExecutor    GetPriceDropSettingsExecutor
+	updateSettingsExecutor UpdatePriceDropSettingsExecutor
+}
+
+// NewPriceDropHandler creates a new PriceDropHandler
+func NewPriceDropHandler(getSettingsExecutor GetPriceDropSettingsExecutor, updateSettingsExecutor UpdatePriceDropSettingsExecutor) *PriceDropHandler {
+	return &PriceDropHandler{
+		getSettingsExecutor:    getSettingsExecutor,
+		updateSettingsExecutor: updateSettingsExecutor,
+	}
+}
+
+type priceDropSettingsRequest struct {
+	Enabled        *bool `json:"enabled"`
+	MinDropPercent int   `json:"minDropPercent"`
+}
+
+// GetPriceDropSettings handler the request
+func (handler *PriceDropHandler) GetPriceDropSettings(w http.ResponseWriter, r *http.Request) {
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	settings, err := handler.getSettingsExecutor.Execute(user.ID)
+	if err != nil {
+		logger.GetInstance().Error("error getting price drop settings", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, settings, http.StatusOK)
+}
+
+// UpdatePriceDropSettings handler the request
+func (handler *PriceDropHandler) UpdatePriceDropSettings(w http.ResponseWriter, r *http.Request) {
+	var request priceDropSettingsRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	if request.Enabled == nil {
+		err = errors.New("enabled field is a must")
+		logger.GetInstance().Error("error in price drop settings", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	settings, err := handler.updateSettingsExecutor.Execute(&model.PriceDropSettings{
+		UserID:         user.ID,
+		Enabled:        *request.Enabled,
+		MinDropPercent: request.MinDropPercent,
+	})
+	if err != nil {
+		logger.GetInstance().Error("error updating price drop settings", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, settings, http.StatusOK)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type PriceDropSuite struct {
+	suite.Suite
+	mockCtrl               *gomock.Controller
+	getSettingsExecutor    *mocks.MockGetPriceDropSettingsExecutor
+	updateSettingsExecutor *mocks.MockUpdatePriceDropSettingsExecutor
+	priceDropHandler       *PriceDropHandler
+	chiRouter              *chi.Mux
+}
+
+func TestPriceDropSuite(t *testing.T) {
+	suite.Run(t, new(PriceDropSuite))
+}
+
+func (suite *PriceDropSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.getSettingsExecutor = mocks.NewMockGetPriceDropSettingsExecutor(suite.mockCtrl)
+	suite.updateSettingsExecutor = mocks.NewMockUpdatePriceDropSettingsExecutor(suite.mockCtrl)
+	suite.priceDropHandler = NewPriceDropHandler(suite.getSettingsExecutor, suite.updateSettingsExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Route("/v1/users/me/notifications/price-drops", func(r chi.Router) {
+		r.Get("/", suite.priceDropHandler.GetPriceDropSettings)
+		r.Put("/", suite.priceDropHandler.UpdatePriceDropSettings)
+	})
+}
+
+func (suite *PriceDropSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *PriceDropSuite) TestGetPriceDropSettings_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/me/notifications/price-drops/", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.getSettingsExecutor.EXPECT().Execute(int64(1)).Return(&model.PriceDropSettings{UserID: 1, Enabled: true}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.JSONEq(`{"enabled":true,"minDropPercent":0}`, rr.Body.String())
+}
+
+func (suite *PriceDropSuite) TestUpdatePriceDropSettings_Success() {
+	req, err := http.NewRequest("PUT", "/v1/users/me/notifications/price-drops/", strings.NewReader(`{"enabled":true,"minDropPercent":5}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateSettingsExecutor.EXPECT().Execute(&model.PriceDropSettings{UserID: 1, Enabled: true, MinDropPercent: 5}).
+		Return(&model.PriceDropSettings{UserID: 1, Enabled: true, MinDropPercent: 5}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PriceDropSuite) TestUpdatePriceDropSettings_MissingEnabled() {
+	req, err := http.NewRequest("PUT", "/v1/users/me/notifications/price-drops/", strings.NewReader(`{"minDropPercent":5}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PriceDropSuite) TestUpdatePriceDropSettings_DomainError() {
+	req, err := http.NewRequest("PUT", "/v1/users/me/notifications/price-drops/", strings.NewReader(`{"enabled":true,"minDropPercent":500}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateSettingsExecutor.EXPECT().Execute(gomock.Any()).Return(nil, model.NewDomainError(errors.New("invalid")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
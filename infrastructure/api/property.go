@@ -1,14 +1,17 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
+	"io/ioutil"
 	"lahaus/domain/model"
 	"lahaus/domain/usecases/properties"
+	"lahaus/infrastructure/api/middlewares"
 	"lahaus/logger"
 	"net/http"
 	"net/url"
@@ -20,7 +23,7 @@ import (
 
 // PropertyExecutor ...
 type PropertyExecutor interface {
-	Execute(property *model.Property) (*model.Property, error)
+	Execute(property *model.Property, actor *model.Actor) (*model.Property, error)
 }
 
 // SearchPropertyExecutor ...
@@ -28,6 +31,31 @@ type SearchPropertyExecutor interface {
 	Execute(search properties.PropertySearchParams) (*model.PropertiesPaging, error)
 }
 
+// EvaluatePropertyExecutor ...
+type EvaluatePropertyExecutor interface {
+	Execute(propertyID int64, actor *model.Actor) (*model.Property, error)
+}
+
+// GetPropertyExecutor ...
+type GetPropertyExecutor interface {
+	Execute(propertyID int64, user *model.User) (*model.Property, error)
+}
+
+// IdempotentRequestExecutor ...
+type IdempotentRequestExecutor interface {
+	Execute(request *model.IdempotentRequest, handler func() *model.StoredResponse) (*model.StoredResponse, bool, error)
+}
+
+// RenewPropertyExecutor ...
+type RenewPropertyExecutor interface {
+	Execute(propertyID int64, actor *model.Actor) (*model.Property, error)
+}
+
+// PriceHistoryExecutor ...
+type PriceHistoryExecutor interface {
+	Execute(propertyID int64, user *model.User) ([]*model.PriceHistoryEntry, error)
+}
+
 const minLongitudeValue = -180.0000000
 const maxLongitudeValue = 180.0000000
 const minLatitudeValue = -90.0000000
@@ -38,28 +66,47 @@ type PropertyHandler struct {
 	createPropertyExecutor PropertyExecutor
 	updatePropertyExecutor PropertyExecutor
 	searchExecutor         SearchPropertyExecutor
+	evaluateExecutor       EvaluatePropertyExecutor
+	priceHistoryExecutor   PriceHistoryExecutor
+	getPropertyExecutor    GetPropertyExecutor
+	idempotencyExecutor    IdempotentRequestExecutor
+	renewExecutor          RenewPropertyExecutor
 }
 
 // NewPropertyHandler creates a new PropertyHandler
-func NewPropertyHandler(createExecutor, updateExecutor PropertyExecutor, filterExecutor SearchPropertyExecutor) *PropertyHandler {
+func NewPropertyHandler(createExecutor, updateExecutor PropertyExecutor, filterExecutor SearchPropertyExecutor, evaluateExecutor EvaluatePropertyExecutor,
+	priceHistoryExecutor PriceHistoryExecutor, getPropertyExecutor GetPropertyExecutor, idempotencyExecutor IdempotentRequestExecutor,
+	renewExecutor RenewPropertyExecutor) *PropertyHandler {
 	return &PropertyHandler{
 		createPropertyExecutor: createExecutor,
 		updatePropertyExecutor: updateExecutor,
 		searchExecutor:         filterExecutor,
+		evaluateExecutor:       evaluateExecutor,
+		priceHistoryExecutor:   priceHistoryExecutor,
+		getPropertyExecutor:    getPropertyExecutor,
+		idempotencyExecutor:    idempotencyExecutor,
+		renewExecutor:          renewExecutor,
 	}
 }
 
 type propertyRequest struct {
-	Title        *string  `json:"title"`
-	Description  *string  `json:"description"`
-	Location     location `json:"location"`
-	Pricing      pricing  `json:"pricing"`
-	PropertyType string   `json:"propertyType"`
-	Bedrooms     *int     `json:"bedrooms"`
-	Bathrooms    *int     `json:"bathrooms"`
-	ParkingSpots *int     `json:"parkingSpots"`
-	Area         *int     `json:"area"`
-	Photos       []string `json:"photos"`
+	Title         *string  `json:"title"`
+	Description   *string  `json:"description"`
+	Location      location `json:"location"`
+	Pricing       pricing  `json:"pricing"`
+	PropertyType  string   `json:"propertyType"`
+	OperationType string   `json:"operationType"`
+	Bedrooms      *int     `json:"bedrooms"`
+	Bathrooms     *int     `json:"bathrooms"`
+	ParkingSpots  *int     `json:"parkingSpots"`
+	Area          *int     `json:"area"`
+	Photos        []string `json:"photos"`
+	Amenities     []string `json:"amenities"`
+	Floor         *int     `json:"floor"`
+	TotalFloors   *int     `json:"totalFloors"`
+	YearBuilt     *int     `json:"yearBuilt"`
+	Condition     string   `json:"condition"`
+	Orientation   string   `json:"orientation"`
 }
 
 type location struct {
@@ -68,12 +115,68 @@ type location struct {
 }
 
 type pricing struct {
-	SalePrice         *int `json:"salePrice"`
-	AdministrativeFee *int `json:"administrativeFee"`
+	Currency          string        `json:"currency"`
+	SalePrice         *model.Amount `json:"salePrice"`
+	AdministrativeFee *model.Amount `json:"administrativeFee"`
+	Rent              *rentPricing  `json:"rent"`
+}
+
+type rentPricing struct {
+	MonthlyRent       *model.Amount `json:"monthlyRent"`
+	DepositMonths     *int          `json:"depositMonths"`
+	MinContractMonths *int          `json:"minContractMonths"`
 }
 
-// CreateProperty property handler the request
+const maxIdempotencyKeyLength = 255
+
+// CreateProperty property handler the request, the retries with the same Idempotency-Key get the stored response
 func (handler *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Request) {
+	key := r.Header.Get("Idempotency-Key")
+	user := middlewares.UserFromContext(r)
+	if key == "" || user == nil {
+		handler.createProperty(w, r)
+		return
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		err := model.NewDomainError(fmt.Errorf("the Idempotency-Key must have at most %d characters", maxIdempotencyKeyLength))
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		logger.GetInstance().Error("error reading body", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	request := &model.IdempotentRequest{UserID: user.ID, Key: key, Method: r.Method, Path: r.URL.Path, Body: body}
+	response, replayed, err := handler.idempotencyExecutor.Execute(request, func() *model.StoredResponse {
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		buffer := newBufferedResponse()
+		handler.createProperty(buffer, r)
+		return buffer.storedResponse()
+	})
+	if err != nil {
+		logger.GetInstance().Error("error creating property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	for name, value := range response.Header {
+		w.Header().Set(name, value)
+	}
+	if replayed {
+		w.Header().Set("Idempotent-Replayed", "true")
+	}
+	w.WriteHeader(response.StatusCode)
+	_, err = w.Write(response.Body)
+	if err != nil {
+		logger.GetInstance().Error("error writing response", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+	}
+}
+
+func (handler *PropertyHandler) createProperty(w http.ResponseWriter, r *http.Request) {
 	var request propertyRequest
 
 	err := json.NewDecoder(r.Body).Decode(&request)
@@ -90,7 +193,14 @@ func (handler *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	property, err = handler.createPropertyExecutor.Execute(property)
+	if middlewares.UserFromContext(r) == nil {
+		err = model.NewUnauthorizedError(errors.New("user not authenticated"))
+		logger.GetInstance().Error("error getting user", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	property, err = handler.createPropertyExecutor.Execute(property, middlewares.ActorFromContext(r))
 	if err != nil {
 		logger.GetInstance().Error("error creating property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
 		wrapError(w, err, http.StatusInternalServerError)
@@ -103,6 +213,7 @@ func (handler *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Re
 		wrapError(w, err, http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set("ETag", propertyETag(property))
 	w.Header().Set("Content-Type", "application/json")
 	_, err = w.Write(response)
 	if err != nil {
@@ -141,8 +252,23 @@ func (handler *PropertyHandler) UpdateProperty(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if middlewares.UserFromContext(r) == nil {
+		err = model.NewUnauthorizedError(errors.New("user not authenticated"))
+		logger.GetInstance().Error("error getting user", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	version, err := ifMatchVersion(r)
+	if err != nil {
+		logger.GetInstance().Error("error in parsing If-Match", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusPreconditionRequired)
+		return
+	}
+
 	property.ID = id
-	property, err = handler.updatePropertyExecutor.Execute(property)
+	property.Version = version
+	property, err = handler.updatePropertyExecutor.Execute(property, middlewares.ActorFromContext(r))
 	if err != nil {
 		logger.GetInstance().Error("error updating property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
 		wrapError(w, err, http.StatusInternalServerError)
@@ -156,6 +282,7 @@ func (handler *PropertyHandler) UpdateProperty(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	w.Header().Set("ETag", propertyETag(property))
 	w.Header().Set("Content-Type", "application/json")
 	_, err = w.Write(response)
 	if err != nil {
@@ -168,6 +295,109 @@ func (handler *PropertyHandler) UpdateProperty(w http.ResponseWriter, r *http.Re
 
 }
 
+// PatchProperty property handler the request, the body is a JSON merge patch applied over the stored property
+func (handler *PropertyHandler) PatchProperty(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	user := middlewares.UserFromContext(r)
+	if user == nil {
+		err := model.NewUnauthorizedError(errors.New("user not authenticated"))
+		logger.GetInstance().Error("error getting user", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	version, err := ifMatchVersion(r)
+	if err != nil {
+		logger.GetInstance().Error("error in parsing If-Match", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusPreconditionRequired)
+		return
+	}
+
+	stored, err := handler.getPropertyExecutor.Execute(id, user)
+	if err != nil {
+		logger.GetInstance().Error("error getting property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	var request propertyRequest
+	current, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(current, &request)
+	}
+	if err != nil {
+		logger.GetInstance().Error("error mapping stored property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	property, err := mapPropertyRequestToProperty(request)
+	if err != nil {
+		logger.GetInstance().Error("error mapping to property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	property.ID = id
+	property.Version = version
+	property, err = handler.updatePropertyExecutor.Execute(property, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error updating property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", propertyETag(property))
+	writeJSONResponse(w, r, property, http.StatusOK)
+}
+
+// GetProperty property handler the request, anonymous users can see the properties that are not invalid
+func (handler *PropertyHandler) GetProperty(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	property, err := handler.getPropertyExecutor.Execute(id, middlewares.UserFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error getting property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", propertyETag(property))
+	writeJSONResponse(w, r, property, http.StatusOK)
+}
+
+// RenewProperty property handler the request
+func (handler *PropertyHandler) RenewProperty(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	property, err := handler.renewExecutor.Execute(id, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error renewing property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", propertyETag(property))
+	writeJSONResponse(w, r, property, http.StatusOK)
+}
+
 // SearchProperties property handler the request
 func (handler *PropertyHandler) SearchProperties(w http.ResponseWriter, r *http.Request) {
 	searchParams, err := mapToPropertySearchParams(r.URL.Query())
@@ -178,6 +408,16 @@ func (handler *PropertyHandler) SearchProperties(w http.ResponseWriter, r *http.
 		return
 	}
 
+	isAdmin := middlewares.RoleFromContext(r) == model.ADMIN
+	if model.PropertyStatus(searchParams.Status).Hidden() && !isAdmin {
+		err = model.NewForbiddenError(fmt.Errorf("only admins can list %s properties", searchParams.Status))
+		logger.GetInstance().Error("error validating input", zap.Error(err),
+			zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusForbidden)
+		return
+	}
+	searchParams.IncludeHidden = isAdmin
+
 	results, err := handler.searchExecutor.Execute(searchParams)
 	if err != nil {
 		logger.GetInstance().Error("error getting results", zap.Error(err),
@@ -207,13 +447,138 @@ func (handler *PropertyHandler) SearchProperties(w http.ResponseWriter, r *http.
 
 }
 
+// ListOwnProperties property handler the request, lists the properties of the authenticated user with the same filters as the search
+func (handler *PropertyHandler) ListOwnProperties(w http.ResponseWriter, r *http.Request) {
+	user := middlewares.UserFromContext(r)
+	if user == nil {
+		err := model.NewUnauthorizedError(errors.New("user not authenticated"))
+		logger.GetInstance().Error("error getting user", zap.Error(err),
+			zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	searchParams, err := mapToPropertySearchParams(r.URL.Query())
+	if err != nil {
+		logger.GetInstance().Error("error validating input", zap.Error(err),
+			zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	searchParams.OwnerID = &user.ID
+	searchParams.IncludeHidden = true
+
+	results, err := handler.searchExecutor.Execute(searchParams)
+	if err != nil {
+		logger.GetInstance().Error("error getting results", zap.Error(err),
+			zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	response, err := json.Marshal(results)
+	if err != nil {
+		logger.GetInstance().Error("error marshalling results ", zap.Error(err),
+			zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(response)
+	if err != nil {
+		logger.GetInstance().Error("error writing response", zap.Error(err),
+			zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+}
+
+// EvaluateProperty property handler the request
+func (handler *PropertyHandler) EvaluateProperty(w http.ResponseWriter, r *http.Request) {
+	idValue := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idValue, 10, 64)
+	if err != nil {
+		logger.GetInstance().Error("error in parsing id ", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	property, err := handler.evaluateExecutor.Execute(id, middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error evaluating property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	response, err := json.Marshal(property)
+	if err != nil {
+		logger.GetInstance().Error("error marshalling property", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(response)
+	if err != nil {
+		logger.GetInstance().Error("error writing response", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+}
+
+// GetPriceHistory property handler the request, anonymous users can see the history of the properties that are not invalid
+func (handler *PropertyHandler) GetPriceHistory(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	history, err := handler.priceHistoryExecutor.Execute(id, middlewares.UserFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error getting price history", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, history, http.StatusOK)
+}
+
+func propertyETag(property *model.Property) string {
+	return strconv.Quote(strconv.FormatInt(property.Version, 10))
+}
+
+// ifMatchVersion returns the property version sent in the If-Match header, the updates without it are rejected
+func ifMatchVersion(r *http.Request) (int64, error) {
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "" {
+		return 0, model.NewPreconditionRequiredError(errors.New("the If-Match header is required"))
+	}
+	value, err := strconv.Unquote(strings.TrimPrefix(ifMatch, "W/"))
+	if err != nil {
+		return 0, model.NewPreconditionFailedError(fmt.Errorf("invalid If-Match [%v]", ifMatch))
+	}
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, model.NewPreconditionFailedError(fmt.Errorf("invalid If-Match [%v]", ifMatch))
+	}
+	return version, nil
+}
+
 func mapToPropertySearchParams(query url.Values) (properties.PropertySearchParams, error) {
 	searchParams := properties.PropertySearchParams{}
 	status := query.Get("status")
 	if status == "" {
 		searchParams.Status = "ALL"
 	} else {
-		if status != "ALL" && status != "ACTIVE" && status != "INACTIVE" && status != "INVALID" {
+		if status != "ALL" && status != "ACTIVE" && status != "INACTIVE" && status != "INVALID" && status != "DUPLICATE_SUSPECT" &&
+			status != "PENDING_REVIEW" && status != "REJECTED" && status != "EXPIRED" {
 			return searchParams, fmt.Errorf("invalid status [%v]", status)
 		}
 		searchParams.Status = status
@@ -252,13 +617,54 @@ func mapToPropertySearchParams(query url.Values) (properties.PropertySearchParam
 			return searchParams, errors.New("location is not valid")
 		}
 
-		searchParams.Bbox = &properties.BBoxSearchParams{
+		searchParams.Bbox = &model.BoundingBox{
 			MinLongitude: minLongitude,
 			MinLatitude:  minLatitude,
 			MaxLongitude: maxLongitude,
 			MaxLatitude:  maxLatitude,
 		}
 	}
+	polygon := query.Get("polygon")
+	if polygon != "" {
+		polygonValue, err := mapToPolygon(polygon)
+		if err != nil {
+			return searchParams, err
+		}
+		searchParams.Polygon = polygonValue
+	}
+	operation := query.Get("operation")
+	if operation != "" {
+		if operation != SALE && operation != RENT && operation != SALE_AND_RENT {
+			return searchParams, fmt.Errorf("invalid operation [%v]", operation)
+		}
+		searchParams.Operation = model.OperationType(operation)
+	}
+	if err := mapToAttributeFilters(query, &searchParams.SearchFilters); err != nil {
+		return searchParams, err
+	}
+	currency := query.Get("currency")
+	if currency != "" {
+		searchParams.Currency = model.Currency(strings.ToUpper(currency))
+		if !searchParams.Currency.IsValid() {
+			return searchParams, fmt.Errorf("invalid currency [%v]", currency)
+		}
+	}
+	minPrice, err := mapToPrice(query, "minPrice", searchParams.Currency)
+	if err != nil {
+		return searchParams, err
+	}
+	searchParams.MinPrice = minPrice
+	maxPrice, err := mapToPrice(query, "maxPrice", searchParams.Currency)
+	if err != nil {
+		return searchParams, err
+	}
+	searchParams.MaxPrice = maxPrice
+	if searchParams.MinPrice != nil && searchParams.MaxPrice != nil && *searchParams.MinPrice > *searchParams.MaxPrice {
+		return searchParams, errors.New("minPrice should not be greater than maxPrice")
+	}
+	if err := mapToMetricFilters(query, &searchParams); err != nil {
+		return searchParams, err
+	}
 	page := query.Get("page")
 	if page != "" {
 		pageValues, err := strconv.ParseInt(page, 10, 64)
@@ -288,3 +694,136 @@ func mapToPropertySearchParams(query url.Values) (properties.PropertySearchParam
 	return searchParams, nil
 
 }
+
+// mapToAttributeFilters parses the filters of the amenities, the floor, the year built, the condition and the orientation
+func mapToAttributeFilters(query url.Values, filters *model.SearchFilters) error {
+	amenities := query.Get("amenities")
+	if amenities != "" {
+		values, err := mapStringsToAmenities(strings.Split(strings.ReplaceAll(amenities, " ", ""), ","))
+		if err != nil {
+			return err
+		}
+		filters.Amenities = values
+	}
+	var err error
+	if filters.MinFloor, err = mapToOptionalInt(query, "minFloor"); err != nil {
+		return err
+	}
+	if filters.MaxFloor, err = mapToOptionalInt(query, "maxFloor"); err != nil {
+		return err
+	}
+	if filters.MinFloor != nil && filters.MaxFloor != nil && *filters.MinFloor > *filters.MaxFloor {
+		return errors.New("minFloor should not be greater than maxFloor")
+	}
+	if filters.MinYearBuilt, err = mapToOptionalInt(query, "minYearBuilt"); err != nil {
+		return err
+	}
+	if filters.MaxYearBuilt, err = mapToOptionalInt(query, "maxYearBuilt"); err != nil {
+		return err
+	}
+	if filters.MinYearBuilt != nil && filters.MaxYearBuilt != nil && *filters.MinYearBuilt > *filters.MaxYearBuilt {
+		return errors.New("minYearBuilt should not be greater than maxYearBuilt")
+	}
+	if filters.Condition, err = mapStringToCondition(query.Get("condition")); err != nil {
+		return err
+	}
+	if filters.Orientation, err = mapStringToOrientation(query.Get("orientation")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// mapToMetricFilters parses the bounds and the sort of the price per square meter and the monthly cost
+func mapToMetricFilters(query url.Values, searchParams *properties.PropertySearchParams) error {
+	bounds := []struct {
+		min, max             string
+		minTarget, maxTarget **int64
+	}{
+		{"minPricePerSquareMeter", "maxPricePerSquareMeter", &searchParams.MinPricePerSquareMeter, &searchParams.MaxPricePerSquareMeter},
+		{"minMonthlyCost", "maxMonthlyCost", &searchParams.MinMonthlyCost, &searchParams.MaxMonthlyCost},
+	}
+	for _, bound := range bounds {
+		minValue, err := mapToPrice(query, bound.min, searchParams.Currency)
+		if err != nil {
+			return err
+		}
+		maxValue, err := mapToPrice(query, bound.max, searchParams.Currency)
+		if err != nil {
+			return err
+		}
+		if minValue != nil && maxValue != nil && *minValue > *maxValue {
+			return fmt.Errorf("%s should not be greater than %s", bound.min, bound.max)
+		}
+		*bound.minTarget, *bound.maxTarget = minValue, maxValue
+	}
+
+	sort := query.Get("sort")
+	if sort == "" {
+		return nil
+	}
+	if searchParams.Currency == "" {
+		return errors.New("sort needs the currency param")
+	}
+	searchParams.SortDescending = strings.HasPrefix(sort, "-")
+	switch field := properties.SortField(strings.TrimPrefix(sort, "-")); field {
+	case properties.SortByPricePerSquareMeter, properties.SortByMonthlyCost:
+		searchParams.SortBy = field
+	default:
+		return fmt.Errorf("invalid sort [%v]", sort)
+	}
+	return nil
+}
+
+func mapToOptionalInt(query url.Values, name string) (*int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s [%v]", name, value)
+	}
+	return &number, nil
+}
+
+// mapToPrice parses a price filter written in units of the currency of the search into minor units
+func mapToPrice(query url.Values, name string, currency model.Currency) (*int64, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	if currency == "" {
+		return nil, fmt.Errorf("%s needs the currency param", name)
+	}
+	amount, err := model.ParseAmount(value)
+	if err != nil {
+		return nil, err
+	}
+	price := int64(amount)
+	return &price, nil
+}
+
+// mapToPolygon parses the vertices of a polygon written as "lng,lat,lng,lat,...", the polygon is closed implicitly
+func mapToPolygon(polygon string) ([]model.Location, error) {
+	values := strings.Split(strings.ReplaceAll(polygon, " ", ""), ",")
+	if len(values)%2 != 0 || len(values)/2 < model.MinPolygonVertices || len(values)/2 > model.MaxPolygonVertices {
+		return nil, fmt.Errorf("invalid polygon format [%v], it must have between %d and %d vertices", polygon, model.MinPolygonVertices, model.MaxPolygonVertices)
+	}
+
+	vertices := make([]model.Location, 0, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		longitude, err := strconv.ParseFloat(values[i], 64)
+		if err != nil {
+			return nil, err
+		}
+		latitude, err := strconv.ParseFloat(values[i+1], 64)
+		if err != nil {
+			return nil, err
+		}
+		if longitude < minLongitudeValue || longitude > maxLongitudeValue || latitude < minLatitudeValue || latitude > maxLatitudeValue {
+			return nil, errors.New("location is not valid")
+		}
+		vertices = append(vertices, model.Location{Longitude: longitude, Latitude: latitude})
+	}
+	return vertices, nil
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"github.com/bitly/go-simplejson"
 	"github.com/go-chi/chi/v5"
@@ -8,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 	"lahaus/domain/model"
+	"lahaus/domain/usecases/properties"
 	"lahaus/infrastructure/api/mocks"
 	"net/http"
 	"net/http/httptest"
@@ -22,6 +24,11 @@ type PropertySuite struct {
 	propertyCreateExecutor *mocks.MockPropertyExecutor
 	propertyUpdateExecutor *mocks.MockPropertyExecutor
 	propertySearchExecutor *mocks.MockSearchPropertyExecutor
+	propertyEvalExecutor   *mocks.MockEvaluatePropertyExecutor
+	priceHistoryExecutor   *mocks.MockPriceHistoryExecutor
+	getPropertyExecutor    *mocks.MockGetPropertyExecutor
+	idempotencyExecutor    *mocks.MockIdempotentRequestExecutor
+	renewExecutor          *mocks.MockRenewPropertyExecutor
 	propertyHandler        *PropertyHandler
 	chiRouter              *chi.Mux
 	httpTest               *httptest.Server
@@ -36,7 +43,13 @@ func (suite *PropertySuite) SetupTest() {
 	suite.propertyCreateExecutor = mocks.NewMockPropertyExecutor(suite.mockCtrl)
 	suite.propertyUpdateExecutor = mocks.NewMockPropertyExecutor(suite.mockCtrl)
 	suite.propertySearchExecutor = mocks.NewMockSearchPropertyExecutor(suite.mockCtrl)
-	suite.propertyHandler = NewPropertyHandler(suite.propertyCreateExecutor, suite.propertyUpdateExecutor, suite.propertySearchExecutor)
+	suite.propertyEvalExecutor = mocks.NewMockEvaluatePropertyExecutor(suite.mockCtrl)
+	suite.priceHistoryExecutor = mocks.NewMockPriceHistoryExecutor(suite.mockCtrl)
+	suite.getPropertyExecutor = mocks.NewMockGetPropertyExecutor(suite.mockCtrl)
+	suite.idempotencyExecutor = mocks.NewMockIdempotentRequestExecutor(suite.mockCtrl)
+	suite.renewExecutor = mocks.NewMockRenewPropertyExecutor(suite.mockCtrl)
+	suite.propertyHandler = NewPropertyHandler(suite.propertyCreateExecutor, suite.propertyUpdateExecutor, suite.propertySearchExecutor, suite.propertyEvalExecutor,
+		suite.priceHistoryExecutor, suite.getPropertyExecutor, suite.idempotencyExecutor, suite.renewExecutor)
 
 	suite.chiRouter = chi.NewRouter()
 	suite.chiRouter.Use(middleware.RequestID)
@@ -44,13 +57,28 @@ func (suite *PropertySuite) SetupTest() {
 		r.Route("/properties", func(r chi.Router) {
 			r.Post("/", suite.propertyHandler.CreateProperty)
 			r.Put("/{id}", suite.propertyHandler.UpdateProperty)
+			r.Patch("/{id}", suite.propertyHandler.PatchProperty)
 			r.Get("/", suite.propertyHandler.SearchProperties)
+			r.Post("/{id}/evaluate", suite.propertyHandler.EvaluateProperty)
+			r.Get("/{id}", suite.propertyHandler.GetProperty)
+			r.Get("/{id}/price-history", suite.propertyHandler.GetPriceHistory)
+			r.Post("/{id}/renew", suite.propertyHandler.RenewProperty)
 		})
+		r.Get("/users/me/properties", suite.propertyHandler.ListOwnProperties)
 	})
 
 	suite.httpTest = httptest.NewServer(suite.chiRouter)
 }
 
+func withUser(req *http.Request, role model.Role) *http.Request {
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+		"role":   role,
+	})
+	return req.WithContext(ctx)
+}
+
 func (suite *PropertySuite) TearDownSuite() {
 	suite.httpTest.Close()
 	suite.mockCtrl.Finish()
@@ -251,8 +279,8 @@ func (suite *PropertySuite) TestCreateProperty_Error() {
 	suite.NoError(err)
 
 	rr := httptest.NewRecorder()
-	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any()).Return(nil, errors.New("fail to save"))
-	suite.chiRouter.ServeHTTP(rr, req)
+	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("fail to save"))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
 
 	suite.Equal(http.StatusInternalServerError, rr.Code)
 }
@@ -304,8 +332,8 @@ func (suite *PropertySuite) TestCreateProperty_Success() {
 		UpdatedAt: time.Now(),
 		Status:    model.INACTIVE,
 	}
-	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any()).Return(propertySaved, nil)
-	suite.chiRouter.ServeHTTP(rr, req)
+	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(propertySaved, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
 
 	suite.Equal(http.StatusOK, rr.Code)
 	js, err := simplejson.NewJson(rr.Body.Bytes())
@@ -325,6 +353,338 @@ func (suite *PropertySuite) TestCreateProperty_Success() {
 	suite.Equal(string(propertySaved.Status), status)
 }
 
+func (suite *PropertySuite) TestCreateProperty_RentSuccess() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"operationType": "RENT",
+			"pricing": {
+				"rent": {
+					"monthlyRent": 2500000,
+					"depositMonths": 2,
+					"minContractMonths": 12
+				}
+			},
+			"propertyType": "APARTMENT",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(property *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(model.RENT, property.OperationType)
+		suite.Equal(model.Amount(0), property.Pricing.SalePrice)
+		suite.Equal(&model.RentPricing{MonthlyRent: 250000000, DepositMonths: 2, MinContractMonths: 12}, property.Pricing.Rent)
+		property.ID = 1
+		return property, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	js, err := simplejson.NewJson(rr.Body.Bytes())
+	suite.NoError(err)
+	operationType, err := js.Get("operationType").String()
+	suite.NoError(err)
+	suite.Equal("RENT", operationType)
+	monthlyRent, err := js.GetPath("pricing", "rent", "monthlyRent").Int()
+	suite.NoError(err)
+	suite.Equal(2500000, monthlyRent)
+}
+
+func (suite *PropertySuite) TestCreateProperty_SuccessAmountInUnits() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+		{
+			"title": "Casa en dólares",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"currency": "USD",
+				"salePrice": 250000.5,
+				"administrativeFee": 120
+			},
+			"propertyType": "HOUSE",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(property *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(model.Amount(25000050), property.Pricing.SalePrice)
+		suite.Equal(model.Amount(12000), *property.Pricing.AdministrativeFee)
+		property.ID = 1
+		return property, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"salePrice":250000.5,"administrativeFee":120`)
+}
+
+func (suite *PropertySuite) TestCreateProperty_BadRequestAmountDecimals() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+		{
+			"title": "Casa",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"salePrice": 250000.505
+			},
+			"propertyType": "HOUSE",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestCreateProperty_RentNotDeclared() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"operationType": "SALE_AND_RENT",
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "APARTMENT",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestCreateProperty_AttributesSuccess() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+		{
+			"title": "Apartamento con piscina",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "APARTMENT",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60,
+			"amenities": ["pool", "ELEVATOR", "POOL"],
+			"floor": 7,
+			"totalFloors": 12,
+			"yearBuilt": 2015,
+			"condition": "good",
+			"orientation": "NORTHEAST"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(property *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal([]model.Amenity{model.POOL, model.ELEVATOR}, property.Amenities)
+		suite.Equal(7, *property.Floor)
+		suite.Equal(12, *property.TotalFloors)
+		suite.Equal(2015, *property.YearBuilt)
+		suite.Equal(model.GOOD, property.Condition)
+		suite.Equal(model.NORTHEAST, property.Orientation)
+		property.ID = 1
+		return property, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	js, err := simplejson.NewJson(rr.Body.Bytes())
+	suite.NoError(err)
+	condition, err := js.Get("condition").String()
+	suite.NoError(err)
+	suite.Equal("GOOD", condition)
+	amenities, err := js.Get("amenities").StringArray()
+	suite.NoError(err)
+	suite.Equal([]string{"POOL", "ELEVATOR"}, amenities)
+}
+
+func (suite *PropertySuite) TestCreateProperty_AttributesError() {
+	for _, attribute := range []string{`"amenities": ["SAUNA"]`, `"condition": "USED"`, `"orientation": "UP"`} {
+		req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+			{
+				"title": "Apartamento con piscina",
+				"location": {
+					"longitude": -94.0665887,
+					"latitude": 4.6371593
+				},
+				"pricing": {
+					"salePrice": 450000000
+				},
+				"propertyType": "APARTMENT",
+				"bedrooms": 3,
+				"bathrooms": 2,
+				"area": 60,
+				`+attribute+`
+			}
+		`))
+		suite.NoError(err)
+
+		rr := httptest.NewRecorder()
+		suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+		suite.Equal(http.StatusBadRequest, rr.Code, attribute)
+	}
+}
+
+func (suite *PropertySuite) TestCreateProperty_OperationTypeError() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"operationType": "LEASE",
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "APARTMENT",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+const idempotentPropertyBody = `{
+	"title": "Apartamento cerca a la estación",
+	"location": {
+		"longitude": -94.0665887,
+		"latitude": 4.6371593
+	},
+	"pricing": {
+		"salePrice": 450000000
+	},
+	"propertyType": "HOUSE",
+	"bedrooms": 3,
+	"bathrooms": 2,
+	"area": 60
+}`
+
+func (suite *PropertySuite) TestCreateProperty_IdempotencyKeyFirstRequest() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(idempotentPropertyBody))
+	suite.NoError(err)
+	req.Header.Set("Idempotency-Key", "8e03978e-40d5-43e8-bc93-6894a57f9324")
+
+	rr := httptest.NewRecorder()
+	suite.idempotencyExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(request *model.IdempotentRequest, handler func() *model.StoredResponse) (*model.StoredResponse, bool, error) {
+			suite.Equal(int64(1), request.UserID)
+			suite.Equal("8e03978e-40d5-43e8-bc93-6894a57f9324", request.Key)
+			suite.Equal("POST", request.Method)
+			suite.Equal(idempotentPropertyBody, string(request.Body))
+			return handler(), false, nil
+		})
+	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&model.Property{ID: 7, Version: 1}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(`"1"`, rr.Header().Get("ETag"))
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Empty(rr.Header().Get("Idempotent-Replayed"))
+	suite.Contains(rr.Body.String(), `"id":7`)
+}
+
+func (suite *PropertySuite) TestCreateProperty_IdempotencyKeyReplayed() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(idempotentPropertyBody))
+	suite.NoError(err)
+	req.Header.Set("Idempotency-Key", "8e03978e-40d5-43e8-bc93-6894a57f9324")
+
+	rr := httptest.NewRecorder()
+	suite.idempotencyExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&model.StoredResponse{
+		StatusCode: http.StatusOK,
+		Header:     map[string]string{"Content-Type": "application/json", "Etag": `"1"`},
+		Body:       []byte(`{"id":7}`),
+	}, true, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(`"1"`, rr.Header().Get("ETag"))
+	suite.Equal("true", rr.Header().Get("Idempotent-Replayed"))
+	suite.JSONEq(`{"id":7}`, rr.Body.String())
+}
+
+func (suite *PropertySuite) TestCreateProperty_IdempotencyKeyAnotherBody() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(idempotentPropertyBody))
+	suite.NoError(err)
+	req.Header.Set("Idempotency-Key", "8e03978e-40d5-43e8-bc93-6894a57f9324")
+
+	rr := httptest.NewRecorder()
+	suite.idempotencyExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, false,
+		model.NewUnprocessableEntityError(errors.New("the idempotency key was used with another request")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusUnprocessableEntity, rr.Code)
+	suite.Contains(rr.Body.String(), `"code":80`)
+}
+
+func (suite *PropertySuite) TestCreateProperty_IdempotencyKeyTooLong() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(idempotentPropertyBody))
+	suite.NoError(err)
+	req.Header.Set("Idempotency-Key", strings.Repeat("k", 256))
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestCreateProperty_Duplicate() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(idempotentPropertyBody))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertyCreateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, model.NewDuplicatePropertyError(
+		errors.New("the property looks like a property already listed"), []*model.Property{{ID: 9, Title: "Apartamento cerca de la estación"}}))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+
+	suite.Equal(http.StatusConflict, rr.Code)
+	js, err := simplejson.NewJson(rr.Body.Bytes())
+	suite.NoError(err)
+	code, err := js.Get("code").Int64()
+	suite.NoError(err)
+	suite.Equal(int64(90), code)
+	candidateID, err := js.Get("candidates").GetIndex(0).Get("id").Int64()
+	suite.NoError(err)
+	suite.Equal(int64(9), candidateID)
+}
+
 func (suite *PropertySuite) TestUpdateProperty_InvalidParam() {
 	req, err := http.NewRequest("PUT", "/v1/properties/A", strings.NewReader(`
 		{
@@ -408,9 +768,167 @@ func (suite *PropertySuite) TestUpdateProperty_Success() {
 	suite.NoError(err)
 
 	rr := httptest.NewRecorder()
-	suite.propertyUpdateExecutor.EXPECT().Execute(gomock.Any()).Return(&model.Property{}, nil)
+	req.Header.Set("If-Match", `"3"`)
+	suite.propertyUpdateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(property *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(int64(1), property.ID)
+		suite.Equal(int64(3), property.Version)
+		return &model.Property{ID: 1, Version: 4}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(`"4"`, rr.Header().Get("ETag"))
+}
+
+func (suite *PropertySuite) TestUpdateProperty_IfMatchRequired() {
+	req, err := http.NewRequest("PUT", "/v1/properties/1", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "HOUSE",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusPreconditionRequired, rr.Code)
+}
+
+func (suite *PropertySuite) TestUpdateProperty_InvalidIfMatch() {
+	req, err := http.NewRequest("PUT", "/v1/properties/1", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "HOUSE",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+	req.Header.Set("If-Match", "*")
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusPreconditionFailed, rr.Code)
+}
+
+func (suite *PropertySuite) TestUpdateProperty_VersionMismatch() {
+	req, err := http.NewRequest("PUT", "/v1/properties/1", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "HOUSE",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+	req.Header.Set("If-Match", `"2"`)
+
+	rr := httptest.NewRecorder()
+	suite.propertyUpdateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, model.NewPreconditionFailedError(errors.New("the property has version 3")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusPreconditionFailed, rr.Code)
+	suite.Contains(rr.Body.String(), `"code":60`)
+}
+
+func (suite *PropertySuite) TestPatchProperty_Success() {
+	req, err := http.NewRequest("PATCH", "/v1/properties/1", strings.NewReader(`{"title": "Casa con jardín", "pricing": {"salePrice": 400000000}, "floor": null}`))
+	suite.NoError(err)
+	req.Header.Set("If-Match", `"3"`)
+	floor, description := 2, "Casa cerca al parque"
+
+	rr := httptest.NewRecorder()
+	suite.getPropertyExecutor.EXPECT().Execute(int64(1), &model.User{ID: 1, Email: "nn@nn.com", Role: model.AGENT}).Return(&model.Property{
+		ID: 1, Title: "Casa", Description: &description, Location: model.Location{Longitude: -74.0665887, Latitude: 4.6371593},
+		Pricing: model.Pricing{Currency: "COP", SalePrice: 450000000}, PropertyType: model.HOUSE, OperationType: model.SALE,
+		Bedrooms: 3, Bathrooms: 2, Area: 60, Floor: &floor, Version: 3, Status: model.ACTIVE,
+	}, nil)
+	suite.propertyUpdateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(property *model.Property, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(int64(1), property.ID)
+		suite.Equal(int64(3), property.Version)
+		suite.Equal("Casa con jardín", property.Title)
+		suite.Equal("Casa cerca al parque", *property.Description)
+		suite.Equal(model.Amount(40000000000), property.Pricing.SalePrice)
+		suite.Equal(model.Currency("COP"), property.Pricing.Currency)
+		suite.Equal(3, property.Bedrooms)
+		suite.Nil(property.Floor)
+		return &model.Property{ID: 1, Version: 4}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(`"4"`, rr.Header().Get("ETag"))
+}
+
+func (suite *PropertySuite) TestPatchProperty_IfMatchRequired() {
+	req, err := http.NewRequest("PATCH", "/v1/properties/1", strings.NewReader(`{"title": "Casa con jardín"}`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusPreconditionRequired, rr.Code)
+}
+
+func (suite *PropertySuite) TestPatchProperty_VersionMismatch() {
+	req, err := http.NewRequest("PATCH", "/v1/properties/1", strings.NewReader(`{"title": "Casa con jardín"}`))
+	suite.NoError(err)
+	req.Header.Set("If-Match", `"2"`)
+
+	rr := httptest.NewRecorder()
+	suite.getPropertyExecutor.EXPECT().Execute(int64(1), gomock.Any()).Return(&model.Property{
+		ID: 1, Title: "Casa", Location: model.Location{Longitude: -74.0665887, Latitude: 4.6371593},
+		Pricing: model.Pricing{SalePrice: 450000000}, PropertyType: model.HOUSE, Bedrooms: 3, Bathrooms: 2, Area: 60, Version: 3,
+	}, nil)
+	suite.propertyUpdateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, model.NewPreconditionFailedError(errors.New("the property has version 3")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusPreconditionFailed, rr.Code)
+}
+
+func (suite *PropertySuite) TestGetProperty_Success() {
+	req, err := http.NewRequest("GET", "/v1/properties/1", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.getPropertyExecutor.EXPECT().Execute(int64(1), nil).Return(&model.Property{ID: 1, Title: "Casa", Version: 2, Status: model.ACTIVE}, nil)
 	suite.chiRouter.ServeHTTP(rr, req)
 	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(`"2"`, rr.Header().Get("ETag"))
+	suite.Contains(rr.Body.String(), `"title":"Casa"`)
+	suite.NotContains(rr.Body.String(), "version")
+}
+
+func (suite *PropertySuite) TestGetProperty_NotFound() {
+	req, err := http.NewRequest("GET", "/v1/properties/1", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.getPropertyExecutor.EXPECT().Execute(int64(1), &model.User{ID: 1, Email: "nn@nn.com", Role: model.BUYER}).Return(nil, model.NewEntityNotFoundError(errors.New("property not found")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNotFound, rr.Code)
+	suite.Empty(rr.Header().Get("ETag"))
 }
 
 func (suite *PropertySuite) TestListProperty_BadRequestStatus() {
@@ -477,3 +995,384 @@ func (suite *PropertySuite) TestListProperty_Success() {
 	suite.chiRouter.ServeHTTP(rr, req)
 	suite.Equal(http.StatusOK, rr.Code)
 }
+
+func (suite *PropertySuite) TestListProperty_SuccessPolygon() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&polygon=-99.21,19.42,-99.18,19.42,-99.18,19.44", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal([]model.Location{{Longitude: -99.21, Latitude: 19.42}, {Longitude: -99.18, Latitude: 19.42}, {Longitude: -99.18, Latitude: 19.44}},
+			search.Polygon)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_BadRequestPolygonVertices() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&polygon=-99.21,19.42,-99.18,19.42", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_SuccessOperation() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&operation=RENT", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal(model.RENT, search.Operation)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_BadRequestOperation() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&operation=LEASE", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_SuccessCurrency() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&currency=usd&minPrice=1000&maxPrice=5000.5", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal(model.USD, search.Currency)
+		suite.Equal(int64(100000), *search.MinPrice)
+		suite.Equal(int64(500050), *search.MaxPrice)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_BadRequestCurrency() {
+	for _, query := range []string{"currency=dollars", "minPrice=100000", "currency=USD&maxPrice=abc", "currency=USD&maxPrice=10.001", "currency=USD&minPrice=500&maxPrice=100"} {
+		req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&"+query, nil)
+		suite.NoError(err)
+
+		rr := httptest.NewRecorder()
+		suite.chiRouter.ServeHTTP(rr, req)
+		suite.Equal(http.StatusBadRequest, rr.Code, query)
+	}
+}
+
+func (suite *PropertySuite) TestListProperty_SuccessAttributes() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&amenities=pool,GYM&minFloor=2&maxFloor=10&minYearBuilt=2000&condition=NEW&orientation=south", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal([]model.Amenity{model.POOL, model.GYM}, search.Amenities)
+		suite.Equal(2, *search.MinFloor)
+		suite.Equal(10, *search.MaxFloor)
+		suite.Equal(2000, *search.MinYearBuilt)
+		suite.Nil(search.MaxYearBuilt)
+		suite.Equal(model.NEW, search.Condition)
+		suite.Equal(model.SOUTH, search.Orientation)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_BadRequestAttributes() {
+	for _, query := range []string{"amenities=SAUNA", "minFloor=abc", "minFloor=5&maxFloor=2", "minYearBuilt=2020&maxYearBuilt=2000", "condition=USED", "orientation=UP"} {
+		req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&"+query, nil)
+		suite.NoError(err)
+
+		rr := httptest.NewRecorder()
+		suite.chiRouter.ServeHTTP(rr, req)
+		suite.Equal(http.StatusBadRequest, rr.Code, query)
+	}
+}
+
+func (suite *PropertySuite) TestListProperty_SuccessMetrics() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&currency=MXN&minPricePerSquareMeter=10&maxPricePerSquareMeter=50&maxMonthlyCost=2000&sort=-pricePerSquareMeter", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal(int64(1000), *search.MinPricePerSquareMeter)
+		suite.Equal(int64(5000), *search.MaxPricePerSquareMeter)
+		suite.Nil(search.MinMonthlyCost)
+		suite.Equal(int64(200000), *search.MaxMonthlyCost)
+		suite.Equal(properties.SortByPricePerSquareMeter, search.SortBy)
+		suite.True(search.SortDescending)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_BadRequestMetrics() {
+	for _, query := range []string{"minPricePerSquareMeter=1000", "sort=monthlyCost", "currency=MXN&sort=area",
+		"currency=MXN&minMonthlyCost=500&maxMonthlyCost=100"} {
+		req, err := http.NewRequest("GET", "/v1/properties/?status=ACTIVE&"+query, nil)
+		suite.NoError(err)
+
+		rr := httptest.NewRecorder()
+		suite.chiRouter.ServeHTTP(rr, req)
+		suite.Equal(http.StatusBadRequest, rr.Code, query)
+	}
+}
+
+func (suite *PropertySuite) TestListProperty_InvalidForbidden() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=INVALID", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_DuplicateSuspectForbidden() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=DUPLICATE_SUSPECT", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_DuplicateSuspectAdmin() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=DUPLICATE_SUSPECT", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal("DUPLICATE_SUSPECT", search.Status)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_InvalidAdmin() {
+	req, err := http.NewRequest("GET", "/v1/properties/?status=INVALID", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.True(search.IncludeHidden)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestListProperty_AllExcludesInvalidForAnonymous() {
+	req, err := http.NewRequest("GET", "/v1/properties/", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.False(search.IncludeHidden)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestEvaluateProperty_InvalidParam() {
+	req, err := http.NewRequest("POST", "/v1/properties/A/evaluate", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestEvaluateProperty_NotFound() {
+	req, err := http.NewRequest("POST", "/v1/properties/1/evaluate", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertyEvalExecutor.EXPECT().Execute(int64(1), gomock.Any()).Return(nil, model.NewEntityNotFoundError(errors.New("property not found")))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *PropertySuite) TestEvaluateProperty_Success() {
+	req, err := http.NewRequest("POST", "/v1/properties/1/evaluate", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertyEvalExecutor.EXPECT().Execute(int64(1), gomock.Any()).Return(&model.Property{ID: 1, Status: model.ACTIVE}, nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *PropertySuite) TestRenewProperty_Success() {
+	req, err := http.NewRequest("POST", "/v1/properties/1/renew", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.renewExecutor.EXPECT().Execute(int64(1), gomock.Any()).DoAndReturn(func(propertyID int64, actor *model.Actor) (*model.Property, error) {
+		suite.Equal(int64(1), actor.UserID)
+		return &model.Property{ID: 1, Status: model.ACTIVE, Version: 3}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(`"3"`, rr.Header().Get("ETag"))
+}
+
+func (suite *PropertySuite) TestRenewProperty_NotRenewable() {
+	req, err := http.NewRequest("POST", "/v1/properties/1/renew", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.renewExecutor.EXPECT().Execute(int64(1), gomock.Any()).Return(nil, model.NewDomainError(errors.New("the property is INVALID")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestRenewProperty_Forbidden() {
+	req, err := http.NewRequest("POST", "/v1/properties/1/renew", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.renewExecutor.EXPECT().Execute(int64(1), gomock.Any()).Return(nil, model.NewForbiddenError(errors.New("the property belongs to another user")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (suite *PropertySuite) TestGetPriceHistory_Success() {
+	req, err := http.NewRequest("GET", "/v1/properties/1/price-history", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	fee := model.Amount(300000)
+	suite.priceHistoryExecutor.EXPECT().Execute(int64(1), nil).Return([]*model.PriceHistoryEntry{{
+		ID:         1,
+		PropertyID: 1,
+		Previous:   model.Pricing{SalePrice: 450000000, AdministrativeFee: &fee},
+		Current:    model.Pricing{SalePrice: 400000000, AdministrativeFee: &fee},
+	}}, nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"previous":{"salePrice":4500000,"administrativeFee":3000}`)
+}
+
+func (suite *PropertySuite) TestGetPriceHistory_WithUser() {
+	req, err := http.NewRequest("GET", "/v1/properties/1/price-history", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.priceHistoryExecutor.EXPECT().Execute(int64(1), &model.User{ID: 1, Email: "nn@nn.com", Role: model.ADMIN}).Return([]*model.PriceHistoryEntry{}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.JSONEq(`[]`, rr.Body.String())
+}
+
+func (suite *PropertySuite) TestGetPriceHistory_NotFound() {
+	req, err := http.NewRequest("GET", "/v1/properties/1/price-history", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.priceHistoryExecutor.EXPECT().Execute(int64(1), nil).Return(nil, model.NewEntityNotFoundError(errors.New("property not found")))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *PropertySuite) TestGetPriceHistory_InvalidParam() {
+	req, err := http.NewRequest("GET", "/v1/properties/A/price-history", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestCreateProperty_Unauthenticated() {
+	req, err := http.NewRequest("POST", "/v1/properties/", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "HOUSE",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (suite *PropertySuite) TestUpdateProperty_NotOwner() {
+	req, err := http.NewRequest("PUT", "/v1/properties/1", strings.NewReader(`
+		{
+			"title": "Apartamento cerca a la estación",
+			"location": {
+				"longitude": -94.0665887,
+				"latitude": 4.6371593
+			},
+			"pricing": {
+				"salePrice": 450000000
+			},
+			"propertyType": "HOUSE",
+			"bedrooms": 3,
+			"bathrooms": 2,
+			"area": 60
+		}
+	`))
+	suite.NoError(err)
+	req.Header.Set("If-Match", `"1"`)
+
+	rr := httptest.NewRecorder()
+	suite.propertyUpdateExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, model.NewForbiddenError(errors.New("the property belongs to another user")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (suite *PropertySuite) TestListOwnProperties_Unauthenticated() {
+	req, err := http.NewRequest("GET", "/v1/users/me/properties", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (suite *PropertySuite) TestListOwnProperties_BadRequest() {
+	req, err := http.NewRequest("GET", "/v1/users/me/properties?status=A", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PropertySuite) TestListOwnProperties_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/me/properties?status=INVALID&page=1&pageSize=15", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.propertySearchExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search properties.PropertySearchParams) (*model.PropertiesPaging, error) {
+		suite.Equal(int64(1), *search.OwnerID)
+		suite.Equal("INVALID", search.Status)
+		suite.Equal(int64(15), search.PageSize)
+		return &model.PropertiesPaging{}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.AGENT))
+	suite.Equal(http.StatusOK, rr.Code)
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/logger"
+	"net/http"
+)
+
+//go:generate mockgen -destination=./mocks/mock_saved_search.go -package=mocks -source=./saved_search.go
+
+type CreateSavedSearchExecutor interface {
+	Execute(search *model.SavedSearch) (*model.SavedSearch, error)
+}
+
+type ListSavedSearchesExecutor interface {
+	Execute(userID int64) ([]*model.SavedSearch, error)
+}
+
+type GetSavedSearchExecutor interface {
+	Execute(userID, savedSearchID int64) (*model.SavedSearch, error)
+}
+
+type UpdateSavedSearchExecutor interface {
+	Execute(search *model.SavedSearch) (*model.SavedSearch, error)
+}
+
+type DeleteSavedSearchExecutor interface {
+	Execute(userID, savedSearchID int64) error
+}
+
+// SavedSearchHandler struct
+type SavedSearchHandler struct {
+	createExecutor CreateSavedSearchExecutor
+	listExecutor   ListSavedSearchesExecutor
+	getExecutor    GetSavedSearchExecutor
+	updateExecutor UpdateSavedSearchExecutor
+	deleteExecutor DeleteSavedSearchExecutor
+}
+
+// NewSavedSearchHandler creates a new SavedSearchHandler
+func NewSavedSearchHandler(createExecutor CreateSavedSearchExecutor, listExecutor ListSavedSearchesExecutor, getExecutor GetSavedSearchExecutor,
+	updateExecutor UpdateSavedSearchExecutor, deleteExecutor DeleteSavedSearchExecutor) *SavedSearchHandler {
+	return &SavedSearchHandler{
+		createExecutor: createExecutor,
+		listExecutor:   listExecutor,
+		getExecutor:    getExecutor,
+		updateExecutor: updateExecutor,
+		deleteExecutor: deleteExecutor,
+	}
+}
+
+type savedSearchRequest struct {
+	Name      string               `json:"name"`
+	Filters   model.SearchFilters  `json:"filters"`
+	Frequency model.AlertFrequency `json:"frequency"`
+}
+
+// CreateSavedSearch handler the request
+func (handler *SavedSearchHandler) CreateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	var request savedSearchRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	search, err := handler.createExecutor.Execute(&model.SavedSearch{
+		UserID:    user.ID,
+		Name:      request.Name,
+		Filters:   request.Filters,
+		Frequency: request.Frequency,
+	})
+	if err != nil {
+		logger.GetInstance().Error("error creating saved search", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, search, http.StatusCreated)
+}
+
+// ListSavedSearches handler the request
+func (handler *SavedSearchHandler) ListSavedSearches(w http.ResponseWriter, r *http.Request) {
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	results, err := handler.listExecutor.Execute(user.ID)
+	if err != nil {
+		logger.GetInstance().Error("error listing saved searches", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, results, http.StatusOK)
+}
+
+// GetSavedSearch handler the request
+func (handler *SavedSearchHandler) GetSavedSearch(w http.ResponseWriter, r *http.Request) {
+	savedSearchID, ok := urlParamID(w, r, "searchId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	search, err := handler.getExecutor.Execute(user.ID, savedSearchID)
+	if err != nil {
+		logger.GetInstance().Error("error getting saved search", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, search, http.StatusOK)
+}
+
+// UpdateSavedSearch handler the request
+func (handler *SavedSearchHandler) UpdateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	var request savedSearchRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	savedSearchID, ok := urlParamID(w, r, "searchId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	search, err := handler.updateExecutor.Execute(&model.SavedSearch{
+		ID:        savedSearchID,
+		UserID:    user.ID,
+		Name:      request.Name,
+		Filters:   request.Filters,
+		Frequency: request.Frequency,
+	})
+	if err != nil {
+		logger.GetInstance().Error("error updating saved search", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, search, http.StatusOK)
+}
+
+// DeleteSavedSearch handler the request
+func (handler *SavedSearchHandler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	savedSearchID, ok := urlParamID(w, r, "searchId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	err := handler.deleteExecutor.Execute(user.ID, savedSearchID)
+	if err != nil {
+		logger.GetInstance().Error("error deleting saved search", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type SavedSearchSuite struct {
+	suite.Suite
+	mockCtrl           *gomock.Controller
+	createExecutor     *mocks.MockCreateSavedSearchExecutor
+	listExecutor       *mocks.MockListSavedSearchesExecutor
+	getExecutor        *mocks.MockGetSavedSearchExecutor
+	updateExecutor     *mocks.MockUpdateSavedSearchExecutor
+	deleteExecutor     *mocks.MockDeleteSavedSearchExecutor
+	savedSearchHandler *SavedSearchHandler
+	chiRouter          *chi.Mux
+}
+
+func TestSavedSearchSuite(t *testing.T) {
+	suite.Run(t, new(SavedSearchSuite))
+}
+
+func (suite *SavedSearchSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.createExecutor = mocks.NewMockCreateSavedSearchExecutor(suite.mockCtrl)
+	suite.listExecutor = mocks.NewMockListSavedSearchesExecutor(suite.mockCtrl)
+	suite.getExecutor = mocks.NewMockGetSavedSearchExecutor(suite.mockCtrl)
+	suite.updateExecutor = mocks.NewMockUpdateSavedSearchExecutor(suite.mockCtrl)
+	suite.deleteExecutor = mocks.NewMockDeleteSavedSearchExecutor(suite.mockCtrl)
+	suite.savedSearchHandler = NewSavedSearchHandler(suite.createExecutor, suite.listExecutor, suite.getExecutor,
+		suite.updateExecutor, suite.deleteExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Route("/v1/users/me/searches", func(r chi.Router) {
+		r.Post("/", suite.savedSearchHandler.CreateSavedSearch)
+		r.Get("/", suite.savedSearchHandler.ListSavedSearches)
+		r.Get("/{searchId}", suite.savedSearchHandler.GetSavedSearch)
+		r.Put("/{searchId}", suite.savedSearchHandler.UpdateSavedSearch)
+		r.Delete("/{searchId}", suite.savedSearchHandler.DeleteSavedSearch)
+	})
+}
+
+func (suite *SavedSearchSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *SavedSearchSuite) TestCreateSavedSearch_Success() {
+	req, err := http.NewRequest("POST", "/v1/users/me/searches/", strings.NewReader(`{"name":"Polanco","frequency":"INSTANT",
+		"filters":{"polygon":[{"longitude":-99.21,"latitude":19.42},{"longitude":-99.18,"latitude":19.42},{"longitude":-99.18,"latitude":19.44}]}}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.createExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(search *model.SavedSearch) (*model.SavedSearch, error) {
+		suite.Equal(int64(1), search.UserID)
+		suite.Equal(model.INSTANT, search.Frequency)
+		suite.Len(search.Filters.Polygon, 3)
+		search.ID = 4
+		return search, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusCreated, rr.Code)
+	suite.Contains(rr.Body.String(), `"id":4`)
+}
+
+func (suite *SavedSearchSuite) TestCreateSavedSearch_BadRequest() {
+	req, err := http.NewRequest("POST", "/v1/users/me/searches/", strings.NewReader(`{"name":`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *SavedSearchSuite) TestCreateSavedSearch_DomainError() {
+	req, err := http.NewRequest("POST", "/v1/users/me/searches/", strings.NewReader(`{"name":""}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.createExecutor.EXPECT().Execute(gomock.Any()).Return(nil, model.NewDomainError(errors.New("name field is a must")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *SavedSearchSuite) TestListSavedSearches_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/me/searches/", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listExecutor.EXPECT().Execute(int64(1)).Return([]*model.SavedSearch{{ID: 4, UserID: 1, Name: "Polanco"}}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"name":"Polanco"`)
+}
+
+func (suite *SavedSearchSuite) TestGetSavedSearch_NotFound() {
+	req, err := http.NewRequest("GET", "/v1/users/me/searches/4", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.getExecutor.EXPECT().Execute(int64(1), int64(4)).Return(nil, model.NewEntityNotFoundError(errors.New("saved search not found")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *SavedSearchSuite) TestUpdateSavedSearch_Success() {
+	req, err := http.NewRequest("PUT", "/v1/users/me/searches/4", strings.NewReader(`{"name":"Polanco","frequency":"WEEKLY"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(&model.SavedSearch{ID: 4, UserID: 1, Name: "Polanco", Frequency: model.WEEKLY}).
+		Return(&model.SavedSearch{ID: 4, UserID: 1, Name: "Polanco", Frequency: model.WEEKLY}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *SavedSearchSuite) TestDeleteSavedSearch_Success() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/searches/4", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.deleteExecutor.EXPECT().Execute(int64(1), int64(4)).Return(nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *SavedSearchSuite) TestDeleteSavedSearch_BadID() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/searches/abc", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
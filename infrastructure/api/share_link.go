@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"io"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/logger"
+	"net/http"
+	"time"
+)
+
+//go:generate mockgen -destination=./mocks/mock_share_link.go -package=mocks -source=./share_link.go
+
+type CreateShareLinkExecutor interface {
+	Execute(userID, collectionID int64, expiresAt *time.Time) (*model.ShareLink, error)
+}
+
+type ListShareLinksExecutor interface {
+	Execute(userID, collectionID int64) ([]*model.ShareLink, error)
+}
+
+type RevokeShareLinkExecutor interface {
+	Execute(userID, collectionID, shareLinkID int64) error
+}
+
+type GetSharedCollectionExecutor interface {
+	Execute(search collections.SharedCollectionSearchParams) (*model.PropertiesPaging, error)
+}
+
+// ShareLinkHandler struct
+type ShareLinkHandler struct {
+	createExecutor    CreateShareLinkExecutor
+	listExecutor      ListShareLinksExecutor
+	revokeExecutor    RevokeShareLinkExecutor
+	getSharedExecutor GetSharedCollectionExecutor
+}
+
+// NewShareLinkHandler creates a new ShareLinkHandler
+func NewShareLinkHandler(createExecutor CreateShareLinkExecutor, listExecutor ListShareLinksExecutor, revokeExecutor RevokeShareLinkExecutor,
+	getSharedExecutor GetSharedCollectionExecutor) *ShareLinkHandler {
+	return &ShareLinkHandler{
+		createExecutor:    createExecutor,
+		listExecutor:      listExecutor,
+		revokeExecutor:    revokeExecutor,
+		getSharedExecutor: getSharedExecutor,
+	}
+}
+
+type shareLinkRequest struct {
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// CreateShareLink handler the request
+func (handler *ShareLinkHandler) CreateShareLink(w http.ResponseWriter, r *http.Request) {
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	handler.createShareLink(w, r, collectionID)
+}
+
+// CreateFavouritesShareLink handler the request, shares the default collection
+func (handler *ShareLinkHandler) CreateFavouritesShareLink(w http.ResponseWriter, r *http.Request) {
+	handler.createShareLink(w, r, 0)
+}
+
+func (handler *ShareLinkHandler) createShareLink(w http.ResponseWriter, r *http.Request, collectionID int64) {
+	var request shareLinkRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil && err != io.EOF {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	link, err := handler.createExecutor.Execute(user.ID, collectionID, request.ExpiresAt)
+	if err != nil {
+		logger.GetInstance().Error("error creating share link", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, link, http.StatusCreated)
+}
+
+// ListShareLinks handler the request
+func (handler *ShareLinkHandler) ListShareLinks(w http.ResponseWriter, r *http.Request) {
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	links, err := handler.listExecutor.Execute(user.ID, collectionID)
+	if err != nil {
+		logger.GetInstance().Error("error listing share links", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, links, http.StatusOK)
+}
+
+// RevokeShareLink handler the request
+func (handler *ShareLinkHandler) RevokeShareLink(w http.ResponseWriter, r *http.Request) {
+	collectionID, ok := urlParamID(w, r, "collectionId")
+	if !ok {
+		return
+	}
+	shareLinkID, ok := urlParamID(w, r, "shareId")
+	if !ok {
+		return
+	}
+	user, ok := collectionUser(w, r)
+	if !ok {
+		return
+	}
+
+	err := handler.revokeExecutor.Execute(user.ID, collectionID, shareLinkID)
+	if err != nil {
+		logger.GetInstance().Error("error revoking share link", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetSharedCollection handler the request, it does not require authentication
+func (handler *ShareLinkHandler) GetSharedCollection(w http.ResponseWriter, r *http.Request) {
+	pageParams, err := mapToFavouriteSearchParams(r.URL.Query())
+	if err != nil {
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	results, err := handler.getSharedExecutor.Execute(collections.SharedCollectionSearchParams{
+		Page:     pageParams.Page,
+		PageSize: pageParams.PageSize,
+		Token:    chi.URLParam(r, "token"),
+	})
+	if err != nil {
+		logger.GetInstance().Error("error getting shared collection", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, results, http.StatusOK)
+}
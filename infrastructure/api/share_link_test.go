@@ -0,0 +1,133 @@
+package api
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/collections"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ShareLinkSuite struct {
+	suite.Suite
+	mockCtrl          *gomock.Controller
+	createExecutor    *mocks.MockCreateShareLinkExecutor
+	listExecutor      *mocks.MockListShareLinksExecutor
+	revokeExecutor    *mocks.MockRevokeShareLinkExecutor
+	getSharedExecutor *mocks.MockGetSharedCollectionExecutor
+	shareLinkHandler  *ShareLinkHandler
+	chiRouter         *chi.Mux
+}
+
+func TestShareLinkSuite(t *testing.T) {
+	suite.Run(t, new(ShareLinkSuite))
+}
+
+func (suite *ShareLinkSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.createExecutor = mocks.NewMockCreateShareLinkExecutor(suite.mockCtrl)
+	suite.listExecutor = mocks.NewMockListShareLinksExecutor(suite.mockCtrl)
+	suite.revokeExecutor = mocks.NewMockRevokeShareLinkExecutor(suite.mockCtrl)
+	suite.getSharedExecutor = mocks.NewMockGetSharedCollectionExecutor(suite.mockCtrl)
+	suite.shareLinkHandler = NewShareLinkHandler(suite.createExecutor, suite.listExecutor, suite.revokeExecutor, suite.getSharedExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Route("/v1", func(r chi.Router) {
+		r.Post("/users/me/favourites/shares", suite.shareLinkHandler.CreateFavouritesShareLink)
+		r.Post("/users/me/collections/{collectionId}/shares", suite.shareLinkHandler.CreateShareLink)
+		r.Get("/users/me/collections/{collectionId}/shares", suite.shareLinkHandler.ListShareLinks)
+		r.Delete("/users/me/collections/{collectionId}/shares/{shareId}", suite.shareLinkHandler.RevokeShareLink)
+		r.Get("/shared/{token}", suite.shareLinkHandler.GetSharedCollection)
+	})
+}
+
+func (suite *ShareLinkSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *ShareLinkSuite) TestCreateShareLink_Success() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/2/shares", strings.NewReader(`{"expiresAt":"2030-01-02T15:04:05Z"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	expiresAt := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	suite.createExecutor.EXPECT().Execute(int64(1), int64(2), gomock.Any()).
+		DoAndReturn(func(userID, collectionID int64, value *time.Time) (*model.ShareLink, error) {
+			suite.True(expiresAt.Equal(*value))
+			return &model.ShareLink{ID: 5, CollectionID: 2, Token: "abc", TokenHash: "hash", ExpiresAt: value}, nil
+		})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusCreated, rr.Code)
+	suite.Contains(rr.Body.String(), `"token":"abc"`)
+	suite.NotContains(rr.Body.String(), "hash")
+}
+
+func (suite *ShareLinkSuite) TestCreateFavouritesShareLink_WithoutBody() {
+	req, err := http.NewRequest("POST", "/v1/users/me/favourites/shares", http.NoBody)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.createExecutor.EXPECT().Execute(int64(1), int64(0), nil).Return(&model.ShareLink{ID: 5, CollectionID: 1, Token: "abc"}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusCreated, rr.Code)
+}
+
+func (suite *ShareLinkSuite) TestCreateShareLink_BadRequest() {
+	req, err := http.NewRequest("POST", "/v1/users/me/collections/2/shares", strings.NewReader(`{"expiresAt":"tomorrow"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *ShareLinkSuite) TestListShareLinks_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/me/collections/2/shares", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.listExecutor.EXPECT().Execute(int64(1), int64(2)).Return([]*model.ShareLink{{ID: 5, CollectionID: 2}}, nil)
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *ShareLinkSuite) TestRevokeShareLink_NotFound() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/collections/2/shares/5", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.revokeExecutor.EXPECT().Execute(int64(1), int64(2), int64(5)).Return(model.NewEntityNotFoundError(errors.New("share link not found")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *ShareLinkSuite) TestGetSharedCollection_Success() {
+	req, err := http.NewRequest("GET", "/v1/shared/abc?pageSize=20", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.getSharedExecutor.EXPECT().Execute(collections.SharedCollectionSearchParams{Page: 1, PageSize: 20, Token: "abc"}).
+		Return(&model.PropertiesPaging{Page: 1, PageSize: 20, Data: []*model.Property{{ID: 3}}}, nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.NotContains(rr.Body.String(), "ownerId")
+}
+
+func (suite *ShareLinkSuite) TestGetSharedCollection_NotFound() {
+	req, err := http.NewRequest("GET", "/v1/shared/abc", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.getSharedExecutor.EXPECT().Execute(gomock.Any()).Return(nil, model.NewEntityNotFoundError(errors.New("share link not found")))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/stats"
+	"lahaus/infrastructure/api/middlewares"
+	"lahaus/logger"
+	"net/http"
+	"strconv"
+)
+
+//go:generate mockgen -destination=./mocks/mock_stats.go -package=mocks -source=./stats.go
+
+type MarketStatsExecutor interface {
+	Execute(params stats.MarketStatsParams) (*model.MarketReport, error)
+}
+
+// StatsHandler struct
+type StatsHandler struct {
+	marketStatsExecutor MarketStatsExecutor
+}
+
+// NewStatsHandler creates a new StatsHandler
+func NewStatsHandler(marketStatsExecutor MarketStatsExecutor) *StatsHandler {
+	return &StatsHandler{
+		marketStatsExecutor: marketStatsExecutor,
+	}
+}
+
+// MarketStats handler the request, without a status it aggregates the ACTIVE properties
+func (handler *StatsHandler) MarketStats(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	searchParams, err := mapToPropertySearchParams(query)
+	if err != nil {
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	if query.Get("status") == "" {
+		searchParams.Status = string(model.ACTIVE)
+	}
+
+	isAdmin := middlewares.RoleFromContext(r) == model.ADMIN
+	if model.PropertyStatus(searchParams.Status).Hidden() && !isAdmin {
+		err = model.NewForbiddenError(fmt.Errorf("only admins can aggregate %s properties", searchParams.Status))
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusForbidden)
+		return
+	}
+	searchParams.IncludeHidden = isAdmin
+
+	params := stats.MarketStatsParams{
+		PropertySearchParams: searchParams,
+		GroupBy:              model.MarketGroupBy(query.Get("groupBy")),
+	}
+	if precision := query.Get("precision"); precision != "" {
+		params.GeohashPrecision, err = strconv.Atoi(precision)
+		if err != nil {
+			err = fmt.Errorf("invalid precision [%v]", precision)
+			logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+			wrapError(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+
+	report, err := handler.marketStatsExecutor.Execute(params)
+	if err != nil {
+		logger.GetInstance().Error("error getting market stats", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSONResponse(w, r, report, http.StatusOK)
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/domain/usecases/stats"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type StatsSuite struct {
+	suite.Suite
+	mockCtrl            *gomock.Controller
+	marketStatsExecutor *mocks.MockMarketStatsExecutor
+	statsHandler        *StatsHandler
+	chiRouter           *chi.Mux
+}
+
+func TestStatsSuite(t *testing.T) {
+	suite.Run(t, new(StatsSuite))
+}
+
+func (suite *StatsSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.marketStatsExecutor = mocks.NewMockMarketStatsExecutor(suite.mockCtrl)
+	suite.statsHandler = NewStatsHandler(suite.marketStatsExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Get("/v1/stats/market", suite.statsHandler.MarketStats)
+}
+
+func (suite *StatsSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *StatsSuite) TestMarketStats_Success() {
+	req, err := http.NewRequest("GET", "/v1/stats/market?groupBy=bedrooms&currency=cop&operation=SALE", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	generatedAt := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+	suite.marketStatsExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(params stats.MarketStatsParams) (*model.MarketReport, error) {
+		suite.Equal(model.GROUP_BY_BEDROOMS, params.GroupBy)
+		suite.Equal(model.COP, params.Currency)
+		suite.Equal(model.SALE, params.Operation)
+		suite.Equal("ACTIVE", params.Status)
+		suite.False(params.IncludeHidden)
+		return &model.MarketReport{
+			GroupBy:  model.GROUP_BY_BEDROOMS,
+			Currency: model.COP,
+			Groups: []*model.MarketStats{{Group: "3", Count: 2, Price: &model.Distribution{Mean: 300, Median: 300, P10: 220, P90: 380},
+				AverageArea: 75}},
+			GeneratedAt: generatedAt,
+		}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.JSONEq(`{"groupBy":"bedrooms","currency":"COP","generatedAt":"2021-05-01T10:00:00Z","groups":[
+		{"group":"3","count":2,"price":{"mean":300,"median":300,"p10":220,"p90":380},"averageArea":75}]}`, rr.Body.String())
+}
+
+func (suite *StatsSuite) TestMarketStats_SuccessGeohashAdmin() {
+	req, err := http.NewRequest("GET", "/v1/stats/market?groupBy=geohash&precision=6&currency=USD&status=PENDING_REVIEW", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.marketStatsExecutor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(params stats.MarketStatsParams) (*model.MarketReport, error) {
+		suite.Equal(6, params.GeohashPrecision)
+		suite.Equal("PENDING_REVIEW", params.Status)
+		suite.True(params.IncludeHidden)
+		return &model.MarketReport{GroupBy: model.GROUP_BY_GEOHASH, Currency: model.USD, Groups: []*model.MarketStats{}}, nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *StatsSuite) TestMarketStats_BadRequest() {
+	for _, query := range []string{"groupBy=zone&currency=dollars", "groupBy=geohash&currency=USD&precision=abc", "groupBy=zone&status=SOLD"} {
+		req, err := http.NewRequest("GET", fmt.Sprintf("/v1/stats/market?%s", query), nil)
+		suite.NoError(err)
+		rr := httptest.NewRecorder()
+
+		suite.chiRouter.ServeHTTP(rr, req)
+		suite.Equal(http.StatusBadRequest, rr.Code, query)
+	}
+
+	req, err := http.NewRequest("GET", "/v1/stats/market?groupBy=city&currency=USD", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+	suite.marketStatsExecutor.EXPECT().Execute(gomock.Any()).Return(nil, model.NewDomainError(errors.New("invalid groupBy [city]")))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *StatsSuite) TestMarketStats_Forbidden() {
+	req, err := http.NewRequest("GET", "/v1/stats/market?groupBy=zone&currency=USD&status=INVALID", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.BUYER))
+	suite.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (suite *StatsSuite) TestMarketStats_InternalServerError() {
+	req, err := http.NewRequest("GET", "/v1/stats/market?groupBy=zone&currency=USD", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.marketStatsExecutor.EXPECT().Execute(gomock.Any()).Return(nil, errors.New("fail"))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -2,18 +2,25 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 	"lahaus/domain/model"
 	"lahaus/domain/usecases/users"
+	"lahaus/infrastructure/api/middlewares"
 	"lahaus/logger"
+	"net"
 	"net/http"
+	"net/mail"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 type createUserRequest struct {
 	Email string `json:"email"`
+	Role  string `json:"role"`
 }
 
 type signUpUserRequest struct {
@@ -25,37 +32,88 @@ type signUpUserResponse struct {
 	Token string `json:"token"`
 }
 
+type forgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type resendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+type resetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
 //go:generate mockgen -destination=./mocks/mock_user.go -package=mocks -source=./user.go
 
 type SignInUserExecutor interface {
-	Execute(user *model.User) error
+	Execute(user *model.User, actor *model.Actor) error
 }
 
 type SignUpUserExecutor interface {
-	Execute(email, password string) (string, error)
+	Execute(email, password, ip string, actor *model.Actor) (string, error)
 }
 
 type AddFavouriteExecutor interface {
-	Execute(userID int64, property int64) error
+	Execute(actor *model.Actor, property int64) (bool, error)
+}
+
+type RemoveFavouriteExecutor interface {
+	Execute(actor *model.Actor, property int64) error
+}
+
+type FavouriteIDsExecutor interface {
+	Execute(userID int64, propertyIDs []int64) ([]int64, error)
 }
 
 type ListFavouritesExecutor interface {
-	Execute(search users.FavouritesSearchParams) (*model.PropertiesPaging, error)
+	Execute(search users.FavouritesSearchParams) (*model.FavouritesPaging, error)
+}
+
+type ForgotPasswordExecutor interface {
+	Execute(email string) error
+}
+
+type ResetPasswordExecutor interface {
+	Execute(token, password string) error
+}
+
+type VerifyEmailExecutor interface {
+	Execute(token string) error
+}
+
+type ResendVerificationExecutor interface {
+	Execute(email string) error
 }
 
 type UserHandler struct {
-	createUserExecutor     SignInUserExecutor
-	signUpUserExecutor     SignUpUserExecutor
-	addFavouriteExecutor   AddFavouriteExecutor
-	listFavouritesExecutor ListFavouritesExecutor
+	createUserExecutor      SignInUserExecutor
+	signUpUserExecutor      SignUpUserExecutor
+	addFavouriteExecutor    AddFavouriteExecutor
+	removeFavouriteExecutor RemoveFavouriteExecutor
+	favouriteIDsExecutor    FavouriteIDsExecutor
+	listFavouritesExecutor  ListFavouritesExecutor
+	forgotPasswordExecutor  ForgotPasswordExecutor
+	resetPasswordExecutor   ResetPasswordExecutor
+	verifyEmailExecutor     VerifyEmailExecutor
+	resendVerifyExecutor    ResendVerificationExecutor
 }
 
-func NewUserHandler(createUserExecutor SignInUserExecutor, signUpUserExecutor SignUpUserExecutor, addFavouriteExecutor AddFavouriteExecutor, listFavouritesExecutor ListFavouritesExecutor) *UserHandler {
+func NewUserHandler(createUserExecutor SignInUserExecutor, signUpUserExecutor SignUpUserExecutor, addFavouriteExecutor AddFavouriteExecutor,
+	removeFavouriteExecutor RemoveFavouriteExecutor, favouriteIDsExecutor FavouriteIDsExecutor, listFavouritesExecutor ListFavouritesExecutor,
+	forgotPasswordExecutor ForgotPasswordExecutor, resetPasswordExecutor ResetPasswordExecutor, verifyEmailExecutor VerifyEmailExecutor, resendVerifyExecutor ResendVerificationExecutor) *UserHandler {
 	return &UserHandler{
-		createUserExecutor:     createUserExecutor,
-		signUpUserExecutor:     signUpUserExecutor,
-		addFavouriteExecutor:   addFavouriteExecutor,
-		listFavouritesExecutor: listFavouritesExecutor,
+		createUserExecutor:      createUserExecutor,
+		signUpUserExecutor:      signUpUserExecutor,
+		addFavouriteExecutor:    addFavouriteExecutor,
+		removeFavouriteExecutor: removeFavouriteExecutor,
+		favouriteIDsExecutor:    favouriteIDsExecutor,
+		listFavouritesExecutor:  listFavouritesExecutor,
+		forgotPasswordExecutor:  forgotPasswordExecutor,
+		resetPasswordExecutor:   resetPasswordExecutor,
+		verifyEmailExecutor:     verifyEmailExecutor,
+		resendVerifyExecutor:    resendVerifyExecutor,
 	}
 }
 
@@ -77,7 +135,7 @@ func (handler *UserHandler) SignInUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = handler.createUserExecutor.Execute(user)
+	err = handler.createUserExecutor.Execute(user, middlewares.ActorFromContext(r))
 	if err != nil {
 		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
 		wrapError(w, err, http.StatusInternalServerError)
@@ -99,7 +157,12 @@ func (handler *UserHandler) SignUpUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := handler.signUpUserExecutor.Execute(request.Email, request.Password)
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	token, err := handler.signUpUserExecutor.Execute(request.Email, request.Password, ip, middlewares.ActorFromContext(r))
 	if err != nil {
 		logger.GetInstance().Error("error in login", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
 		wrapError(w, err, http.StatusUnauthorized)
@@ -124,6 +187,110 @@ func (handler *UserHandler) SignUpUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// ForgotPassword  handler the request, answers the same for registered and unknown emails
+func (handler *UserHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var request forgotPasswordRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	_, err = mail.ParseAddress(request.Email)
+	if err != nil {
+		logger.GetInstance().Error("error validating email", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	err = handler.forgotPasswordExecutor.Execute(request.Email)
+	if err != nil {
+		logger.GetInstance().Error("error requesting password reset", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ResetPassword  handler the request
+func (handler *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var request resetPasswordRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if request.Token == "" {
+		err = errors.New("token field is a must")
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	err = handler.resetPasswordExecutor.Execute(request.Token, request.Password)
+	if err != nil {
+		logger.GetInstance().Error("error resetting password", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// VerifyEmail  handler the request
+func (handler *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		err := errors.New("token param is a must")
+		logger.GetInstance().Error("error validating input", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	err := handler.verifyEmailExecutor.Execute(token)
+	if err != nil {
+		logger.GetInstance().Error("error verifying email", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ResendVerification  handler the request, answers the same for registered and unknown emails
+func (handler *UserHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	var request resendVerificationRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	_, err = mail.ParseAddress(request.Email)
+	if err != nil {
+		logger.GetInstance().Error("error validating email", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	err = handler.resendVerifyExecutor.Execute(request.Email)
+	if err != nil {
+		logger.GetInstance().Error("error resending verification", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
 type AddFavouriteToUserRequest struct {
 	PropertyID int64 `json:"propertyId"`
 }
@@ -139,21 +306,92 @@ func (handler *UserHandler) AddFavourite(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	ctxUser := r.Context().Value("user")
-	values := ctxUser.(map[string]interface{})
-	id := values["userId"].(int64)
-
-	err = handler.addFavouriteExecutor.Execute(id, request.PropertyID)
+	created, err := handler.addFavouriteExecutor.Execute(middlewares.ActorFromContext(r), request.PropertyID)
 	if err != nil {
 		logger.GetInstance().Error("error adding favourite", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
 		wrapError(w, err, http.StatusInternalServerError)
 		return
 	}
 
+	if !created {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
 	w.WriteHeader(http.StatusCreated)
 
 }
 
+// RemoveFavourite  handler the request
+func (handler *UserHandler) RemoveFavourite(w http.ResponseWriter, r *http.Request) {
+	propertyID, err := strconv.ParseInt(chi.URLParam(r, "propertyId"), 10, 64)
+	if err != nil {
+		logger.GetInstance().Error("error in parsing property id", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	err = handler.removeFavouriteExecutor.Execute(middlewares.ActorFromContext(r), propertyID)
+	if err != nil {
+		logger.GetInstance().Error("error removing favourite", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type favouriteIDsResponse struct {
+	PropertyIDs []int64 `json:"propertyIds"`
+}
+
+// FavouriteIDs  handler the request, returns which of the requested properties are favourites of the user
+func (handler *UserHandler) FavouriteIDs(w http.ResponseWriter, r *http.Request) {
+	propertyIDs, err := mapToPropertyIDs(r.URL.Query().Get("propertyIds"))
+	if err != nil {
+		logger.GetInstance().Error("error in parsing property ids", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value("user").(map[string]interface{})["userId"].(int64)
+	ids, err := handler.favouriteIDsExecutor.Execute(userID, propertyIDs)
+	if err != nil {
+		logger.GetInstance().Error("error listing favourite ids", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	responseJson, err := json.Marshal(favouriteIDsResponse{PropertyIDs: ids})
+	if err != nil {
+		logger.GetInstance().Error("error in marshalling favourite ids response", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(responseJson)
+	if err != nil {
+		logger.GetInstance().Error("error in write favourite ids response", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+}
+
+func mapToPropertyIDs(value string) ([]int64, error) {
+	var propertyIDs []int64
+	for _, idValue := range strings.Split(value, ",") {
+		idValue = strings.TrimSpace(idValue)
+		if idValue == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(idValue, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		propertyIDs = append(propertyIDs, id)
+	}
+	return propertyIDs, nil
+}
+
 // ListFavourites  handler the request
 func (handler *UserHandler) ListFavourites(w http.ResponseWriter, r *http.Request) {
 	userId := r.Context().Value("user").(map[string]interface{})["userId"].(int64)
@@ -217,6 +455,15 @@ func mapToFavouriteSearchParams(query url.Values) (users.FavouritesSearchParams,
 		searchParams.PageSize = 10
 	}
 
+	includeUnavailable := query.Get("includeUnavailable")
+	if includeUnavailable != "" {
+		includeUnavailableValue, err := strconv.ParseBool(includeUnavailable)
+		if err != nil {
+			return searchParams, err
+		}
+		searchParams.IncludeUnavailable = includeUnavailableValue
+	}
+
 	return searchParams, nil
 
 }
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/middlewares"
+	"lahaus/logger"
+	"net/http"
+	"strings"
+)
+
+//go:generate mockgen -destination=./mocks/mock_user_role.go -package=mocks -source=./user_role.go
+
+type UpdateUserRoleExecutor interface {
+	Execute(userID int64, role model.Role, actor *model.Actor) error
+}
+
+// UserRoleHandler struct
+type UserRoleHandler struct {
+	updateExecutor UpdateUserRoleExecutor
+}
+
+// NewUserRoleHandler creates a new UserRoleHandler
+func NewUserRoleHandler(updateExecutor UpdateUserRoleExecutor) *UserRoleHandler {
+	return &UserRoleHandler{
+		updateExecutor: updateExecutor,
+	}
+}
+
+type userRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// UpdateUserRole handler the request
+func (handler *UserRoleHandler) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	id, ok := urlParamID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request userRoleRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.GetInstance().Error("json decode error", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+	if request.Role == "" {
+		err = errors.New("role field is a must")
+		logger.GetInstance().Error("error in user role", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	err = handler.updateExecutor.Execute(id, model.Role(strings.ToUpper(request.Role)), middlewares.ActorFromContext(r))
+	if err != nil {
+		logger.GetInstance().Error("error updating user role", zap.Error(err), zap.String(middleware.RequestIDHeader, r.Context().Value(middleware.RequestIDKey).(string)))
+		wrapError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
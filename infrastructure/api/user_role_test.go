@@ -0,0 +1,84 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"lahaus/domain/model"
+	"lahaus/infrastructure/api/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type UserRoleSuite struct {
+	suite.Suite
+	mockCtrl        *gomock.Controller
+	updateExecutor  *mocks.MockUpdateUserRoleExecutor
+	userRoleHandler *UserRoleHandler
+	chiRouter       *chi.Mux
+}
+
+func TestUserRoleSuite(t *testing.T) {
+	suite.Run(t, new(UserRoleSuite))
+}
+
+func (suite *UserRoleSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.updateExecutor = mocks.NewMockUpdateUserRoleExecutor(suite.mockCtrl)
+	suite.userRoleHandler = NewUserRoleHandler(suite.updateExecutor)
+
+	suite.chiRouter = chi.NewRouter()
+	suite.chiRouter.Use(middleware.RequestID)
+	suite.chiRouter.Put("/v1/admin/users/{id}/role", suite.userRoleHandler.UpdateUserRole)
+}
+
+func (suite *UserRoleSuite) TearDownSuite() {
+	suite.mockCtrl.Finish()
+}
+
+func (suite *UserRoleSuite) TestUpdateUserRole_Success() {
+	req, err := http.NewRequest("PUT", "/v1/admin/users/1/role", bytes.NewBufferString(`{"role": "agent"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(int64(1), model.AGENT, gomock.Any()).DoAndReturn(func(userID int64, role model.Role, actor *model.Actor) error {
+		suite.Equal(int64(1), actor.UserID)
+		suite.Equal(model.ADMIN, actor.Role)
+		return nil
+	})
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *UserRoleSuite) TestUpdateUserRole_BadRequest() {
+	req, err := http.NewRequest("PUT", "/v1/admin/users/1/role", bytes.NewBufferString(`{}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserRoleSuite) TestUpdateUserRole_RoleNotAllowed() {
+	req, err := http.NewRequest("PUT", "/v1/admin/users/1/role", bytes.NewBufferString(`{"role": "ADMIN"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(int64(1), model.ADMIN, gomock.Any()).Return(model.NewDomainError(errors.New("role not allowed [ADMIN]")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserRoleSuite) TestUpdateUserRole_UserNotFound() {
+	req, err := http.NewRequest("PUT", "/v1/admin/users/5/role", bytes.NewBufferString(`{"role": "AGENT"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	suite.updateExecutor.EXPECT().Execute(int64(5), model.AGENT, gomock.Any()).Return(model.NewEntityNotFoundError(errors.New("user not found")))
+	suite.chiRouter.ServeHTTP(rr, withUser(req, model.ADMIN))
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 	"lahaus/domain/model"
+	"lahaus/domain/usecases/users"
 	"lahaus/infrastructure/api/mocks"
 	"net/http"
 	"net/http/httptest"
@@ -23,6 +24,12 @@ type UserSuite struct {
 	signInExecutor *mocks.MockSignInUserExecutor
 	listExecutor   *mocks.MockListFavouritesExecutor
 	addExecutor    *mocks.MockAddFavouriteExecutor
+	removeExecutor *mocks.MockRemoveFavouriteExecutor
+	idsExecutor    *mocks.MockFavouriteIDsExecutor
+	forgotExecutor *mocks.MockForgotPasswordExecutor
+	resetExecutor  *mocks.MockResetPasswordExecutor
+	verifyExecutor *mocks.MockVerifyEmailExecutor
+	resendExecutor *mocks.MockResendVerificationExecutor
 	chiRouter      *chi.Mux
 	httpTest       *httptest.Server
 }
@@ -37,7 +44,14 @@ func (suite *UserSuite) SetupTest() {
 	suite.signInExecutor = mocks.NewMockSignInUserExecutor(suite.mockCtrl)
 	suite.listExecutor = mocks.NewMockListFavouritesExecutor(suite.mockCtrl)
 	suite.addExecutor = mocks.NewMockAddFavouriteExecutor(suite.mockCtrl)
-	suite.userHandler = NewUserHandler(suite.signInExecutor, suite.signUpExecutor, suite.addExecutor, suite.listExecutor)
+	suite.removeExecutor = mocks.NewMockRemoveFavouriteExecutor(suite.mockCtrl)
+	suite.idsExecutor = mocks.NewMockFavouriteIDsExecutor(suite.mockCtrl)
+	suite.forgotExecutor = mocks.NewMockForgotPasswordExecutor(suite.mockCtrl)
+	suite.resetExecutor = mocks.NewMockResetPasswordExecutor(suite.mockCtrl)
+	suite.verifyExecutor = mocks.NewMockVerifyEmailExecutor(suite.mockCtrl)
+	suite.resendExecutor = mocks.NewMockResendVerificationExecutor(suite.mockCtrl)
+	suite.userHandler = NewUserHandler(suite.signInExecutor, suite.signUpExecutor, suite.addExecutor, suite.removeExecutor, suite.idsExecutor, suite.listExecutor,
+		suite.forgotExecutor, suite.resetExecutor, suite.verifyExecutor, suite.resendExecutor)
 
 	suite.chiRouter = chi.NewRouter()
 	suite.chiRouter.Use(middleware.RequestID)
@@ -45,9 +59,15 @@ func (suite *UserSuite) SetupTest() {
 		r.Route("/users", func(r chi.Router) {
 			r.Post("/", suite.userHandler.SignInUser)
 			r.Post("/login", suite.userHandler.SignUpUser)
+			r.Post("/password/forgot", suite.userHandler.ForgotPassword)
+			r.Post("/password/reset", suite.userHandler.ResetPassword)
+			r.Get("/verify", suite.userHandler.VerifyEmail)
+			r.Post("/verify/resend", suite.userHandler.ResendVerification)
 			r.Route("/me/favourites", func(r chi.Router) {
 				r.Post("/", suite.userHandler.AddFavourite)
 				r.Get("/", suite.userHandler.ListFavourites)
+				r.Get("/ids", suite.userHandler.FavouriteIDs)
+				r.Delete("/{propertyId}", suite.userHandler.RemoveFavourite)
 			})
 		})
 	})
@@ -84,6 +104,34 @@ func (suite *UserSuite) TestSignInUser_InvalidEmail() {
 	suite.Equal(http.StatusBadRequest, rr.Code)
 }
 
+func (suite *UserSuite) TestSignInUser_AdminRoleNotAllowed() {
+	req, err := http.NewRequest("POST", "/v1/users", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaus@test.lh",
+			"role": "ADMIN"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestSignInUser_AgentRoleNotAllowed() {
+	req, err := http.NewRequest("POST", "/v1/users", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaus@test.lh",
+			"role": "AGENT"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
 func (suite *UserSuite) TestSignInUser_Success() {
 	req, err := http.NewRequest("POST", "/", strings.NewReader(`
 		{
@@ -94,7 +142,7 @@ func (suite *UserSuite) TestSignInUser_Success() {
 
 	rr := httptest.NewRecorder()
 
-	suite.signInExecutor.EXPECT().Execute(gomock.Any()).Return(nil)
+	suite.signInExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil)
 	handler := http.HandlerFunc(suite.userHandler.SignInUser)
 	ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test")
 	handler.ServeHTTP(rr, req.WithContext(ctx))
@@ -111,7 +159,7 @@ func (suite *UserSuite) TestSignInUser_Error() {
 	suite.NoError(err)
 
 	rr := httptest.NewRecorder()
-	suite.signInExecutor.EXPECT().Execute(gomock.Any()).Return(errors.New("fail to save"))
+	suite.signInExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("fail to save"))
 	suite.chiRouter.ServeHTTP(rr, req)
 	suite.Equal(http.StatusInternalServerError, rr.Code)
 }
@@ -127,7 +175,7 @@ func (suite *UserSuite) TestSignUpUser_Error() {
 
 	rr := httptest.NewRecorder()
 
-	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return("", errors.New("fail to get"))
+	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("fail to get"))
 	suite.chiRouter.ServeHTTP(rr, req)
 
 	suite.Equal(http.StatusUnauthorized, rr.Code)
@@ -144,7 +192,7 @@ func (suite *UserSuite) TestSignUpUser_Success() {
 
 	rr := httptest.NewRecorder()
 
-	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return("token", nil)
+	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("token", nil)
 	suite.chiRouter.ServeHTTP(rr, req)
 
 	suite.Equal(http.StatusOK, rr.Code)
@@ -161,7 +209,7 @@ func (suite *UserSuite) TestSignUpUser_UserNotFound() {
 
 	rr := httptest.NewRecorder()
 
-	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return("", model.NewUnauthorizedError(errors.New("user not found")))
+	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", model.NewUnauthorizedError(errors.New("user not found")))
 	suite.chiRouter.ServeHTTP(rr, req)
 
 	suite.Equal(http.StatusUnauthorized, rr.Code)
@@ -178,12 +226,32 @@ func (suite *UserSuite) TestSignUpUser_IncorrectPassword() {
 
 	rr := httptest.NewRecorder()
 
-	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return("", model.NewUnauthorizedError(errors.New("invalid credentials")))
+	suite.signUpExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", model.NewUnauthorizedError(errors.New("invalid credentials")))
 	suite.chiRouter.ServeHTTP(rr, req)
 
 	suite.Equal(http.StatusUnauthorized, rr.Code)
 }
 
+func (suite *UserSuite) TestSignUpUser_TooManyRequests() {
+	req, err := http.NewRequest("POST", "/v1/users/login", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaus@test",
+			"password": "code-challenge-lahaus@test"
+		}
+	`))
+	suite.NoError(err)
+	req.RemoteAddr = "10.0.0.1:5555"
+
+	rr := httptest.NewRecorder()
+
+	suite.signUpExecutor.EXPECT().Execute("code-challenge-lahaus@test", "code-challenge-lahaus@test", "10.0.0.1", gomock.Any()).
+		Return("", model.NewTooManyRequestsError(errors.New("too many failed login attempts"), 30))
+	suite.chiRouter.ServeHTTP(rr, req)
+
+	suite.Equal(http.StatusTooManyRequests, rr.Code)
+	suite.Equal("30", rr.Header().Get("Retry-After"))
+}
+
 func (suite *UserSuite) TestAddFavourites_BadRequest() {
 	req, err := http.NewRequest("POST", "/v1/users/me/favourites", strings.NewReader(`
 		{
@@ -208,7 +276,7 @@ func (suite *UserSuite) TestAddFavourite_Success() {
 
 	rr := httptest.NewRecorder()
 
-	suite.addExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil)
+	suite.addExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(true, nil)
 	handler := http.HandlerFunc(suite.userHandler.AddFavourite)
 	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
 		"email":  "nn@nn.com",
@@ -231,7 +299,7 @@ func (suite *UserSuite) TestAddFavourite_PropertyNotFound() {
 
 	rr := httptest.NewRecorder()
 
-	suite.addExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(model.NewEntityNotFoundError(errors.New("fail to save favourite")))
+	suite.addExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(false, model.NewEntityNotFoundError(errors.New("fail to save favourite")))
 	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
 		"email":  "nn@nn.com",
 		"userId": int64(1),
@@ -251,7 +319,7 @@ func (suite *UserSuite) TestAddFavourite_FailToSave() {
 
 	rr := httptest.NewRecorder()
 
-	suite.addExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("fail to save favourite"))
+	suite.addExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(false, errors.New("fail to save favourite"))
 	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
 		"email":  "nn@nn.com",
 		"userId": int64(1),
@@ -278,6 +346,50 @@ func (suite *UserSuite) TestListFavourites_IncorrectQueryParams() {
 	suite.Equal(http.StatusBadRequest, rr.Code)
 }
 
+func (suite *UserSuite) TestListFavourites_IncludeUnavailable() {
+	req, err := http.NewRequest("GET", "/v1/users/me/favourites/?includeUnavailable=true", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	salePrice := model.Amount(1050)
+	suite.listExecutor.EXPECT().Execute(users.FavouritesSearchParams{
+		Page: 1, PageSize: 10, UserID: 1, IncludeUnavailable: true,
+	}).Return(&model.FavouritesPaging{
+		Total: 1,
+		Data: []*model.Favourite{{
+			Property:     &model.Property{ID: 2, Status: model.INVALID, Pricing: model.Pricing{SalePrice: 900}},
+			SavedPrice:   &salePrice,
+			PriceChanged: true,
+		}},
+	}, nil)
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+	})
+	req = req.WithContext(ctx)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"status":"INVALID"`)
+	suite.Contains(rr.Body.String(), `"savedPrice":10.5`)
+	suite.Contains(rr.Body.String(), `"priceChanged":true`)
+}
+
+func (suite *UserSuite) TestListFavourites_IncorrectIncludeUnavailable() {
+	req, err := http.NewRequest("GET", "/v1/users/me/favourites/?includeUnavailable=maybe", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+	})
+	req = req.WithContext(ctx)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
 func (suite *UserSuite) TestListFavourites_FailToGet() {
 	req, err := http.NewRequest("GET", "/v1/users/me/favourites/", nil)
 	suite.NoError(err)
@@ -300,7 +412,7 @@ func (suite *UserSuite) TestListFavourites_Success() {
 
 	rr := httptest.NewRecorder()
 
-	suite.listExecutor.EXPECT().Execute(gomock.Any()).Return(&model.PropertiesPaging{}, nil)
+	suite.listExecutor.EXPECT().Execute(gomock.Any()).Return(&model.FavouritesPaging{}, nil)
 	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
 		"email":  "nn@nn.com",
 		"userId": int64(1),
@@ -309,3 +421,258 @@ func (suite *UserSuite) TestListFavourites_Success() {
 	suite.chiRouter.ServeHTTP(rr, req)
 	suite.Equal(http.StatusOK, rr.Code)
 }
+
+func (suite *UserSuite) TestForgotPassword_InvalidEmail() {
+	req, err := http.NewRequest("POST", "/v1/users/password/forgot", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaustest.lh"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestForgotPassword_Accepted() {
+	req, err := http.NewRequest("POST", "/v1/users/password/forgot", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaus@test.lh"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.forgotExecutor.EXPECT().Execute("code-challenge-lahaus@test.lh").Return(nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusAccepted, rr.Code)
+}
+
+func (suite *UserSuite) TestForgotPassword_Error() {
+	req, err := http.NewRequest("POST", "/v1/users/password/forgot", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaus@test.lh"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.forgotExecutor.EXPECT().Execute(gomock.Any()).Return(errors.New("fail to get user"))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *UserSuite) TestResetPassword_TokenNotDeclared() {
+	req, err := http.NewRequest("POST", "/v1/users/password/reset", strings.NewReader(`
+		{
+			"password": "new-password"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestResetPassword_InvalidToken() {
+	req, err := http.NewRequest("POST", "/v1/users/password/reset", strings.NewReader(`
+		{
+			"token": "token",
+			"password": "new-password"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.resetExecutor.EXPECT().Execute("token", "new-password").Return(model.NewDomainError(errors.New("the reset token is invalid or expired")))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestResetPassword_Success() {
+	req, err := http.NewRequest("POST", "/v1/users/password/reset", strings.NewReader(`
+		{
+			"token": "token",
+			"password": "new-password"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.resetExecutor.EXPECT().Execute("token", "new-password").Return(nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *UserSuite) TestVerifyEmail_TokenNotDeclared() {
+	req, err := http.NewRequest("GET", "/v1/users/verify", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestVerifyEmail_InvalidToken() {
+	req, err := http.NewRequest("GET", "/v1/users/verify?token=token", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.verifyExecutor.EXPECT().Execute("token").Return(model.NewDomainError(errors.New("the verification token is invalid or expired")))
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestVerifyEmail_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/verify?token=token", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.verifyExecutor.EXPECT().Execute("token").Return(nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *UserSuite) TestResendVerification_InvalidEmail() {
+	req, err := http.NewRequest("POST", "/v1/users/verify/resend", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaustest.lh"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestResendVerification_Accepted() {
+	req, err := http.NewRequest("POST", "/v1/users/verify/resend", strings.NewReader(`
+		{
+			"email": "code-challenge-lahaus@test.lh"
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.resendExecutor.EXPECT().Execute("code-challenge-lahaus@test.lh").Return(nil)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusAccepted, rr.Code)
+}
+
+func (suite *UserSuite) TestAddFavourite_EmailNotVerified() {
+	req, err := http.NewRequest("POST", "/v1/users/me/favourites", strings.NewReader(`
+		{
+			"propertyId":1
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	suite.addExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(false, model.NewForbiddenError(errors.New("the email must be verified")))
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+	})
+	req = req.WithContext(ctx)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (suite *UserSuite) TestAddFavourite_AlreadyFavourite() {
+	req, err := http.NewRequest("POST", "/v1/users/me/favourites", strings.NewReader(`
+		{
+			"propertyId":1
+		}
+	`))
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	suite.addExecutor.EXPECT().Execute(gomock.Any(), int64(1)).Do(func(actor *model.Actor, propertyID int64) {
+		suite.Equal(int64(1), actor.UserID)
+		suite.NotEmpty(actor.RequestID)
+	}).Return(false, nil)
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+	})
+	req = req.WithContext(ctx)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *UserSuite) TestRemoveFavourite_Success() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/favourites/3", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	suite.removeExecutor.EXPECT().Execute(gomock.Any(), int64(3)).Do(func(actor *model.Actor, propertyID int64) {
+		suite.Equal(int64(1), actor.UserID)
+		suite.NotEmpty(actor.RequestID)
+	}).Return(nil)
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+	})
+	req = req.WithContext(ctx)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *UserSuite) TestRemoveFavourite_BadRequest() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/favourites/abc", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *UserSuite) TestRemoveFavourite_FailToRemove() {
+	req, err := http.NewRequest("DELETE", "/v1/users/me/favourites/3", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	suite.removeExecutor.EXPECT().Execute(gomock.Any(), int64(3)).Return(errors.New("fail to remove"))
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+	})
+	req = req.WithContext(ctx)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *UserSuite) TestFavouriteIDs_Success() {
+	req, err := http.NewRequest("GET", "/v1/users/me/favourites/ids?propertyIds=1,2,3", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	suite.idsExecutor.EXPECT().Execute(int64(1), []int64{1, 2, 3}).Return([]int64{2}, nil)
+	ctx := context.WithValue(req.Context(), "user", map[string]interface{}{
+		"email":  "nn@nn.com",
+		"userId": int64(1),
+	})
+	req = req.WithContext(ctx)
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.JSONEq(`{"propertyIds":[2]}`, rr.Body.String())
+}
+
+func (suite *UserSuite) TestFavouriteIDs_BadRequest() {
+	req, err := http.NewRequest("GET", "/v1/users/me/favourites/ids?propertyIds=1,a", nil)
+	suite.NoError(err)
+
+	rr := httptest.NewRecorder()
+
+	suite.chiRouter.ServeHTTP(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,4 @@
+CREATE TYPE user_role as enum ('BUYER','AGENT', 'ADMIN');
+
+ALTER TABLE users
+    ADD COLUMN role user_role NOT NULL DEFAULT 'BUYER';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS properties_owner_idx;
+ALTER TABLE properties DROP CONSTRAINT IF EXISTS fk_properties_users;
+ALTER TABLE properties DROP COLUMN IF EXISTS owner_id;
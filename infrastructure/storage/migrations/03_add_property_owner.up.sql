@@ -0,0 +1,9 @@
+ALTER TABLE properties
+    ADD COLUMN owner_id BIGINT NULL;
+
+ALTER TABLE properties
+    ADD CONSTRAINT fk_properties_users
+        FOREIGN KEY (owner_id)
+            REFERENCES users (id);
+
+CREATE INDEX properties_owner_idx ON properties (owner_id);
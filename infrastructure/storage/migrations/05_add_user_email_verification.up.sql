@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN email_verified_at TIMESTAMP WITHOUT TIME ZONE NULL;
+
+-- The users that signed up before the verification existed are not locked out
+UPDATE users SET email_verified_at = now();
@@ -0,0 +1,6 @@
+CREATE TABLE login_attempts (
+    key CHARACTER VARYING(400) PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    locked_until TIMESTAMP WITHOUT TIME ZONE NULL
+);
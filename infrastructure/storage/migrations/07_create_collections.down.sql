@@ -0,0 +1,23 @@
+CREATE TABLE favourites (
+   user_id BIGINT NOT NULL,
+   property_id BIGINT NOT NULL
+);
+
+CREATE UNIQUE INDEX favourites_user_property_idx ON favourites (user_id,property_id);
+
+ALTER TABLE favourites
+    ADD CONSTRAINT fk_favorites_users
+        FOREIGN KEY (user_id)
+            REFERENCES users (id);
+
+ALTER TABLE favourites
+    ADD CONSTRAINT fk_favorites_properties
+        FOREIGN KEY (property_id)
+            REFERENCES properties (id);
+
+INSERT INTO favourites (user_id, property_id)
+SELECT c.user_id, i.property_id FROM collection_items i
+INNER JOIN collections c ON c.id = i.collection_id AND c.is_default;
+
+DROP TABLE IF EXISTS collection_items CASCADE;
+DROP TABLE IF EXISTS collections CASCADE;
@@ -0,0 +1,49 @@
+CREATE TABLE collections (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name CHARACTER VARYING(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX collections_user_name_idx ON collections (user_id, lower(name));
+CREATE UNIQUE INDEX collections_user_default_idx ON collections (user_id) WHERE is_default;
+
+ALTER TABLE collections
+    ADD CONSTRAINT fk_collections_users
+        FOREIGN KEY (user_id)
+            REFERENCES users (id);
+
+CREATE TRIGGER set_update_at_timestamp
+    BEFORE UPDATE ON collections
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE collection_items (
+    collection_id BIGINT NOT NULL,
+    property_id BIGINT NOT NULL,
+    note TEXT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (collection_id, property_id)
+);
+
+ALTER TABLE collection_items
+    ADD CONSTRAINT fk_collection_items_collections
+        FOREIGN KEY (collection_id)
+            REFERENCES collections (id) ON DELETE CASCADE;
+
+ALTER TABLE collection_items
+    ADD CONSTRAINT fk_collection_items_properties
+        FOREIGN KEY (property_id)
+            REFERENCES properties (id);
+
+-- Every user gets a default collection, it backs the favourites endpoints
+INSERT INTO collections (user_id, name, is_default)
+SELECT id, 'Favourites', true FROM users;
+
+INSERT INTO collection_items (collection_id, property_id)
+SELECT c.id, f.property_id FROM favourites f
+INNER JOIN collections c ON c.user_id = f.user_id AND c.is_default;
+
+DROP TABLE favourites;
@@ -0,0 +1,16 @@
+CREATE TABLE share_links (
+    id BIGSERIAL PRIMARY KEY,
+    collection_id BIGINT NOT NULL,
+    token_hash CHARACTER VARYING(256) NOT NULL,
+    expires_at TIMESTAMP WITHOUT TIME ZONE NULL,
+    revoked_at TIMESTAMP WITHOUT TIME ZONE NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX share_links_hash_idx ON share_links (token_hash);
+CREATE INDEX share_links_collection_idx ON share_links (collection_id);
+
+ALTER TABLE share_links
+    ADD CONSTRAINT fk_share_links_collections
+        FOREIGN KEY (collection_id)
+            REFERENCES collections (id) ON DELETE CASCADE;
@@ -0,0 +1 @@
+ALTER TABLE collection_items DROP COLUMN IF EXISTS saved_price;
@@ -0,0 +1,5 @@
+ALTER TABLE collection_items ADD COLUMN saved_price INTEGER NULL;
+
+UPDATE collection_items i SET saved_price = p.sale_price
+FROM properties p
+WHERE p.id = i.property_id;
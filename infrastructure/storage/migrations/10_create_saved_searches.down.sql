@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS search_matches CASCADE;
+DROP TABLE IF EXISTS saved_searches CASCADE;
@@ -0,0 +1,42 @@
+CREATE TABLE saved_searches (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name CHARACTER VARYING(100) NOT NULL,
+    filters JSONB NOT NULL DEFAULT '{}',
+    frequency CHARACTER VARYING(20) NOT NULL,
+    last_alerted_at TIMESTAMP WITHOUT TIME ZONE NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX saved_searches_user_name_idx ON saved_searches (user_id, lower(name));
+
+ALTER TABLE saved_searches
+    ADD CONSTRAINT fk_saved_searches_users
+        FOREIGN KEY (user_id)
+            REFERENCES users (id);
+
+CREATE TRIGGER set_update_at_timestamp
+    BEFORE UPDATE ON saved_searches
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE search_matches (
+    saved_search_id BIGINT NOT NULL,
+    property_id BIGINT NOT NULL,
+    matched_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now(),
+    delivered_at TIMESTAMP WITHOUT TIME ZONE NULL,
+    PRIMARY KEY (saved_search_id, property_id)
+);
+
+CREATE INDEX search_matches_pending_idx ON search_matches (saved_search_id) WHERE delivered_at IS NULL;
+
+ALTER TABLE search_matches
+    ADD CONSTRAINT fk_search_matches_saved_searches
+        FOREIGN KEY (saved_search_id)
+            REFERENCES saved_searches (id) ON DELETE CASCADE;
+
+ALTER TABLE search_matches
+    ADD CONSTRAINT fk_search_matches_properties
+        FOREIGN KEY (property_id)
+            REFERENCES properties (id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS price_drop_notifications CASCADE;
+DROP TABLE IF EXISTS price_drop_settings CASCADE;
+DROP TABLE IF EXISTS property_price_history CASCADE;
@@ -0,0 +1,50 @@
+CREATE TABLE property_price_history (
+    id BIGSERIAL PRIMARY KEY,
+    property_id BIGINT NOT NULL,
+    previous_sale_price INTEGER NOT NULL,
+    new_sale_price INTEGER NOT NULL,
+    previous_administrative_fee INTEGER NULL,
+    new_administrative_fee INTEGER NULL,
+    changed_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX property_price_history_property_idx ON property_price_history (property_id, changed_at);
+
+ALTER TABLE property_price_history
+    ADD CONSTRAINT fk_property_price_history_properties
+        FOREIGN KEY (property_id)
+            REFERENCES properties (id);
+
+CREATE TABLE price_drop_settings (
+    user_id BIGINT PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    min_drop_percent INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+ALTER TABLE price_drop_settings
+    ADD CONSTRAINT fk_price_drop_settings_users
+        FOREIGN KEY (user_id)
+            REFERENCES users (id);
+
+CREATE TRIGGER set_update_at_timestamp
+    BEFORE UPDATE ON price_drop_settings
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE price_drop_notifications (
+    user_id BIGINT NOT NULL,
+    price_change_id BIGINT NOT NULL,
+    notified_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, price_change_id)
+);
+
+ALTER TABLE price_drop_notifications
+    ADD CONSTRAINT fk_price_drop_notifications_users
+        FOREIGN KEY (user_id)
+            REFERENCES users (id);
+
+ALTER TABLE price_drop_notifications
+    ADD CONSTRAINT fk_price_drop_notifications_price_history
+        FOREIGN KEY (price_change_id)
+            REFERENCES property_price_history (id);
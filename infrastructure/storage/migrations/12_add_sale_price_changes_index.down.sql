@@ -0,0 +1 @@
+DROP INDEX IF EXISTS property_price_history_sale_price_idx;
@@ -0,0 +1,3 @@
+-- The search results summarize the last change of the sale price of every property
+CREATE INDEX property_price_history_sale_price_idx ON property_price_history (property_id, changed_at DESC, id DESC)
+    WHERE previous_sale_price <> new_sale_price;
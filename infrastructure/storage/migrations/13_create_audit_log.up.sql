@@ -0,0 +1,25 @@
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    entity VARCHAR(20) NOT NULL,
+    entity_id BIGINT NOT NULL,
+    action VARCHAR(30) NOT NULL,
+    actor_id BIGINT NULL,
+    actor_role VARCHAR(10) NULL,
+    request_id VARCHAR(100) NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_log_entity_idx ON audit_log (entity, entity_id, created_at);
+
+CREATE OR REPLACE FUNCTION trigger_reject_audit_log_change()
+    RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reject_audit_log_change
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_reject_audit_log_change();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS increment_version ON properties;
+DROP FUNCTION IF EXISTS trigger_increment_version();
+ALTER TABLE properties DROP COLUMN IF EXISTS version;
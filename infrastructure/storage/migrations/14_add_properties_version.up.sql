@@ -0,0 +1,14 @@
+ALTER TABLE properties ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION trigger_increment_version()
+    RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER increment_version
+    BEFORE UPDATE ON properties
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_increment_version();
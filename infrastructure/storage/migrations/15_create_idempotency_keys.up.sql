@@ -0,0 +1,18 @@
+CREATE TABLE idempotency_keys (
+    user_id BIGINT NOT NULL,
+    key CHARACTER VARYING(255) NOT NULL,
+    request_hash CHARACTER VARYING(64) NOT NULL,
+    status_code INTEGER NOT NULL,
+    headers JSONB NOT NULL,
+    body BYTEA NOT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+ALTER TABLE idempotency_keys
+    ADD CONSTRAINT fk_idempotency_keys_users
+        FOREIGN KEY (user_id)
+            REFERENCES users (id) ON DELETE CASCADE;
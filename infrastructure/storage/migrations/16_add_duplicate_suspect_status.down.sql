@@ -0,0 +1,6 @@
+UPDATE properties SET status = 'INACTIVE' WHERE status = 'DUPLICATE_SUSPECT';
+
+ALTER TYPE property_status RENAME TO property_status_old;
+CREATE TYPE property_status as enum ('ACTIVE','INACTIVE', 'INVALID');
+ALTER TABLE properties ALTER COLUMN status TYPE property_status USING status::text::property_status;
+DROP TYPE property_status_old;
@@ -0,0 +1 @@
+ALTER TYPE property_status ADD VALUE IF NOT EXISTS 'DUPLICATE_SUSPECT';
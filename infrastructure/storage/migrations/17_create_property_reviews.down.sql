@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS property_reviews;
+DROP TYPE IF EXISTS review_decision;
+
+UPDATE properties SET status = 'INVALID' WHERE status IN ('PENDING_REVIEW', 'REJECTED');
+
+ALTER TYPE property_status RENAME TO property_status_old;
+CREATE TYPE property_status as enum ('ACTIVE','INACTIVE', 'INVALID', 'DUPLICATE_SUSPECT');
+ALTER TABLE properties ALTER COLUMN status TYPE property_status USING status::text::property_status;
+DROP TYPE property_status_old;
@@ -0,0 +1,26 @@
+ALTER TYPE property_status ADD VALUE IF NOT EXISTS 'PENDING_REVIEW';
+ALTER TYPE property_status ADD VALUE IF NOT EXISTS 'REJECTED';
+
+CREATE TYPE review_decision as enum ('APPROVE', 'REJECT');
+
+CREATE TABLE property_reviews (
+    id SERIAL PRIMARY KEY,
+    property_id BIGINT NOT NULL,
+    reviewer_id BIGINT NOT NULL,
+    decision review_decision NOT NULL,
+    reason TEXT,
+    status property_status NOT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX property_reviews_property_id_idx ON property_reviews (property_id);
+
+ALTER TABLE property_reviews
+    ADD CONSTRAINT fk_property_reviews_properties
+        FOREIGN KEY (property_id)
+            REFERENCES properties (id) ON DELETE CASCADE;
+
+ALTER TABLE property_reviews
+    ADD CONSTRAINT fk_property_reviews_users
+        FOREIGN KEY (reviewer_id)
+            REFERENCES users (id);
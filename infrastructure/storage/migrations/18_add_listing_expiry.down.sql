@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS listing_expiry_reminders;
+DROP TRIGGER IF EXISTS set_listed_at ON properties;
+DROP FUNCTION IF EXISTS trigger_set_listed_at();
+ALTER TABLE properties DROP COLUMN IF EXISTS listed_at;
+
+UPDATE properties SET status = 'INACTIVE' WHERE status = 'EXPIRED';
+
+ALTER TYPE property_status RENAME TO property_status_old;
+CREATE TYPE property_status as enum ('ACTIVE','INACTIVE', 'INVALID', 'DUPLICATE_SUSPECT', 'PENDING_REVIEW', 'REJECTED');
+ALTER TABLE properties ALTER COLUMN status TYPE property_status USING status::text::property_status;
+ALTER TABLE property_reviews ALTER COLUMN status TYPE property_status USING status::text::property_status;
+DROP TYPE property_status_old;
@@ -0,0 +1,32 @@
+ALTER TYPE property_status ADD VALUE IF NOT EXISTS 'EXPIRED';
+
+-- The listings already stored start their lifetime now, so they do not expire at once
+ALTER TABLE properties ADD COLUMN listed_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now();
+CREATE INDEX properties_listed_at_idx ON properties (listed_at);
+
+CREATE OR REPLACE FUNCTION trigger_set_listed_at()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status::text = 'ACTIVE' AND OLD.status::text <> 'ACTIVE' THEN
+        NEW.listed_at = NOW();
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER set_listed_at
+    BEFORE UPDATE ON properties
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_listed_at();
+
+CREATE TABLE listing_expiry_reminders (
+    property_id BIGINT NOT NULL,
+    listed_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (property_id, listed_at)
+);
+
+ALTER TABLE listing_expiry_reminders
+    ADD CONSTRAINT fk_listing_expiry_reminders_properties
+        FOREIGN KEY (property_id)
+            REFERENCES properties (id) ON DELETE CASCADE;
@@ -0,0 +1,22 @@
+CREATE TABLE property_photos (
+    id BIGSERIAL PRIMARY KEY,
+    property_id BIGINT NOT NULL,
+    position INTEGER NOT NULL,
+    is_cover BOOLEAN NOT NULL DEFAULT false,
+    content_type CHARACTER VARYING(50) NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    url TEXT NOT NULL,
+    thumbnail_url TEXT NOT NULL,
+    original_url TEXT NOT NULL,
+    keys TEXT[] NOT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX property_photos_property_id_idx ON property_photos (property_id, position);
+CREATE UNIQUE INDEX property_photos_cover_idx ON property_photos (property_id) WHERE is_cover;
+
+ALTER TABLE property_photos
+    ADD CONSTRAINT fk_property_photos_properties
+        FOREIGN KEY (property_id)
+            REFERENCES properties (id) ON DELETE CASCADE;
@@ -0,0 +1 @@
+ALTER TABLE properties ADD COLUMN invalid_reason TEXT;
@@ -0,0 +1,7 @@
+ALTER TABLE properties
+    DROP COLUMN IF EXISTS operation_type,
+    DROP COLUMN IF EXISTS monthly_rent,
+    DROP COLUMN IF EXISTS deposit_months,
+    DROP COLUMN IF EXISTS min_contract_months;
+
+DROP TYPE IF EXISTS operation_type;
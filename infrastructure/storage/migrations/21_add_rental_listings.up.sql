@@ -0,0 +1,10 @@
+CREATE TYPE operation_type AS ENUM ('SALE', 'RENT', 'SALE_AND_RENT');
+
+-- The properties already stored were listed for sale
+ALTER TABLE properties
+    ADD COLUMN operation_type operation_type NOT NULL DEFAULT 'SALE',
+    ADD COLUMN monthly_rent INTEGER,
+    ADD COLUMN deposit_months INTEGER,
+    ADD COLUMN min_contract_months INTEGER;
+
+CREATE INDEX properties_operation_type_idx ON properties (operation_type);
@@ -0,0 +1,16 @@
+ALTER TABLE properties DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE collection_items ALTER COLUMN saved_price TYPE INTEGER USING saved_price / 100;
+
+ALTER TABLE property_price_history
+    ALTER COLUMN previous_sale_price TYPE INTEGER USING previous_sale_price / 100,
+    ALTER COLUMN new_sale_price TYPE INTEGER USING new_sale_price / 100,
+    ALTER COLUMN previous_administrative_fee TYPE INTEGER USING previous_administrative_fee / 100,
+    ALTER COLUMN new_administrative_fee TYPE INTEGER USING new_administrative_fee / 100;
+
+ALTER TABLE properties
+    ALTER COLUMN sale_price TYPE INTEGER USING sale_price / 100,
+    ALTER COLUMN administrative_fee TYPE INTEGER USING administrative_fee / 100,
+    ALTER COLUMN monthly_rent TYPE INTEGER USING monthly_rent / 100;
+
+DROP TABLE IF EXISTS exchange_rates;
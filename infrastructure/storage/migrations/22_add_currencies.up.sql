@@ -0,0 +1,26 @@
+CREATE TABLE exchange_rates (
+    id BIGSERIAL PRIMARY KEY,
+    currency VARCHAR(3) NOT NULL UNIQUE,
+    rate DOUBLE PRECISION NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- The amounts were whole units, they are kept in minor units from now on
+ALTER TABLE properties
+    ALTER COLUMN sale_price TYPE BIGINT USING sale_price * 100::BIGINT,
+    ALTER COLUMN administrative_fee TYPE BIGINT USING administrative_fee * 100::BIGINT,
+    ALTER COLUMN monthly_rent TYPE BIGINT USING monthly_rent * 100::BIGINT;
+
+ALTER TABLE property_price_history
+    ALTER COLUMN previous_sale_price TYPE BIGINT USING previous_sale_price * 100::BIGINT,
+    ALTER COLUMN new_sale_price TYPE BIGINT USING new_sale_price * 100::BIGINT,
+    ALTER COLUMN previous_administrative_fee TYPE BIGINT USING previous_administrative_fee * 100::BIGINT,
+    ALTER COLUMN new_administrative_fee TYPE BIGINT USING new_administrative_fee * 100::BIGINT;
+
+ALTER TABLE collection_items ALTER COLUMN saved_price TYPE BIGINT USING saved_price * 100::BIGINT;
+
+-- The existing listings were priced in USD, the operator relabels the ones priced in another currency
+ALTER TABLE properties ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE properties ALTER COLUMN currency DROP DEFAULT;
+
+CREATE INDEX properties_currency_idx ON properties (currency);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS properties_amenities_idx;
+DROP INDEX IF EXISTS properties_floor_idx;
+DROP INDEX IF EXISTS properties_year_built_idx;
+
+ALTER TABLE properties
+    DROP COLUMN IF EXISTS amenities,
+    DROP COLUMN IF EXISTS floor,
+    DROP COLUMN IF EXISTS total_floors,
+    DROP COLUMN IF EXISTS year_built,
+    DROP COLUMN IF EXISTS condition,
+    DROP COLUMN IF EXISTS orientation;
+
+DROP TYPE IF EXISTS property_condition;
+DROP TYPE IF EXISTS orientation;
@@ -0,0 +1,15 @@
+CREATE TYPE property_condition AS ENUM ('NEW', 'REFURBISHED', 'GOOD', 'NEEDS_RENOVATION');
+CREATE TYPE orientation AS ENUM ('NORTH', 'NORTHEAST', 'EAST', 'SOUTHEAST', 'SOUTH', 'SOUTHWEST', 'WEST', 'NORTHWEST');
+
+ALTER TABLE properties
+    ADD COLUMN amenities TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN floor INTEGER,
+    ADD COLUMN total_floors INTEGER,
+    ADD COLUMN year_built INTEGER,
+    ADD COLUMN condition property_condition,
+    ADD COLUMN orientation orientation;
+
+-- The amenities filter looks for the properties whose amenities contain the wanted ones
+CREATE INDEX properties_amenities_idx ON properties USING GIN (amenities);
+CREATE INDEX properties_floor_idx ON properties (floor);
+CREATE INDEX properties_year_built_idx ON properties (year_built);
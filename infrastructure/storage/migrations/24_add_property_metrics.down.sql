@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS properties_price_per_square_meter_idx;
+DROP INDEX IF EXISTS properties_monthly_cost_idx;
+
+ALTER TABLE properties
+    DROP COLUMN IF EXISTS price_per_square_meter,
+    DROP COLUMN IF EXISTS monthly_cost,
+    DROP COLUMN IF EXISTS suspect_reason;
@@ -0,0 +1,24 @@
+ALTER TABLE properties
+    ADD COLUMN price_per_square_meter BIGINT,
+    ADD COLUMN monthly_cost BIGINT,
+    ADD COLUMN suspect_reason TEXT;
+
+-- Deriving the metrics is not an edit of the listings, the triggers would bump their updated_at and version and the clients
+-- holding an ETag would get a 412 on their next write
+ALTER TABLE properties DISABLE TRIGGER set_update_at_timestamp;
+ALTER TABLE properties DISABLE TRIGGER increment_version;
+
+UPDATE properties SET
+    price_per_square_meter = CASE
+        WHEN operation_type IN ('SALE', 'SALE_AND_RENT') AND area > 0 AND sale_price > 0 THEN (sale_price + area / 2) / area
+    END,
+    monthly_cost = CASE
+        WHEN operation_type IN ('RENT', 'SALE_AND_RENT') AND monthly_rent IS NOT NULL THEN monthly_rent + COALESCE(administrative_fee, 0)
+        ELSE administrative_fee
+    END;
+
+ALTER TABLE properties ENABLE TRIGGER set_update_at_timestamp;
+ALTER TABLE properties ENABLE TRIGGER increment_version;
+
+CREATE INDEX properties_price_per_square_meter_idx ON properties (price_per_square_meter);
+CREATE INDEX properties_monthly_cost_idx ON properties (monthly_cost);
@@ -0,0 +1,46 @@
+-- Encodes the location as a geohash of hash_length characters, the market statistics group the properties by it
+CREATE OR REPLACE FUNCTION geohash_encode(lat DOUBLE PRECISION, lon DOUBLE PRECISION, hash_length INTEGER)
+    RETURNS TEXT AS $$
+DECLARE
+    base32 CONSTANT TEXT := '0123456789bcdefghjkmnpqrstuvwxyz';
+    min_lat DOUBLE PRECISION := -90;
+    max_lat DOUBLE PRECISION := 90;
+    min_lon DOUBLE PRECISION := -180;
+    max_lon DOUBLE PRECISION := 180;
+    middle DOUBLE PRECISION;
+    hash TEXT := '';
+    bits INTEGER := 0;
+    character_index INTEGER := 0;
+    even_bit BOOLEAN := true;
+BEGIN
+    WHILE length(hash) < hash_length LOOP
+        IF even_bit THEN
+            middle := (min_lon + max_lon) / 2;
+            IF lon >= middle THEN
+                character_index := character_index * 2 + 1;
+                min_lon := middle;
+            ELSE
+                character_index := character_index * 2;
+                max_lon := middle;
+            END IF;
+        ELSE
+            middle := (min_lat + max_lat) / 2;
+            IF lat >= middle THEN
+                character_index := character_index * 2 + 1;
+                min_lat := middle;
+            ELSE
+                character_index := character_index * 2;
+                max_lat := middle;
+            END IF;
+        END IF;
+        even_bit := NOT even_bit;
+        bits := bits + 1;
+        IF bits = 5 THEN
+            hash := hash || substr(base32, character_index + 1, 1);
+            bits := 0;
+            character_index := 0;
+        END IF;
+    END LOOP;
+    RETURN hash;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE STRICT;